@@ -0,0 +1,131 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command backupcheck validates a backup before it is restored, and prints
+// a report of it. It exits with status 1 if the backup is invalid.
+//
+// Usage:
+//
+//	backupcheck -meta /backup/backupmeta [-storage /backup] [-start key] [-end key] [-key-format escaped|hex]
+//
+// The keys are escaped like Go string literals by default, as in -start
+// 't\x80\x00', or hex encoded with -key-format hex.
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/checksum"
+)
+
+var (
+	metaPath    = flag.String("meta", "", "file holding the encoded BackupMeta")
+	storagePath = flag.String("storage", "", "where the files are, defaults to the path of the meta")
+	startKey    = flag.String("start", "", "start key of the backed up range")
+	endKey      = flag.String("end", "", "end key of the backed up range, empty for +inf")
+	keyFormat   = flag.String("key-format", "escaped", "format of -start and -end, escaped or hex")
+)
+
+func main() {
+	flag.Parse()
+	ok, err := run(os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "backupcheck:", err)
+		os.Exit(2)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer) (bool, error) {
+	if *metaPath == "" {
+		return false, errors.New("-meta is needed")
+	}
+	start, err := parseKey(*startKey)
+	if err != nil {
+		return false, fmt.Errorf("-start: %v", err)
+	}
+	end, err := parseKey(*endKey)
+	if err != nil {
+		return false, fmt.Errorf("-end: %v", err)
+	}
+	data, err := os.ReadFile(*metaPath)
+	if err != nil {
+		return false, err
+	}
+	meta := &backup.BackupMeta{}
+	if err = meta.Unmarshal(data); err != nil {
+		return false, err
+	}
+	path := *storagePath
+	if path == "" {
+		path = meta.GetPath()
+	}
+	storage, err := backup.NewStorage(path)
+	if err != nil {
+		return false, err
+	}
+
+	var sum checksum.Checksum
+	for _, f := range meta.GetFiles() {
+		sum.Merge(checksum.Checksum{Crc64Xor: f.GetCrc64Xor(), TotalKvs: f.GetTotalKvs(), TotalBytes: f.GetTotalBytes()})
+	}
+	fmt.Fprintf(w, "cluster:  %d %s\n", meta.GetClusterId(), meta.GetClusterVersion())
+	fmt.Fprintf(w, "path:     %s\n", path)
+	fmt.Fprintf(w, "range:    [%q, %q)\n", start, end)
+	fmt.Fprintf(w, "versions: %d to %d\n", meta.GetStartVersion(), meta.GetEndVersion())
+	fmt.Fprintf(w, "files:    %d\n", len(meta.GetFiles()))
+	fmt.Fprintf(w, "schemas:  %d\n", len(meta.GetSchemas()))
+	fmt.Fprintf(w, "kvs:      %d\n", sum.TotalKvs)
+	fmt.Fprintf(w, "bytes:    %d\n", sum.TotalBytes)
+	fmt.Fprintf(w, "crc64xor: %#016x\n", sum.Crc64Xor)
+
+	err = backup.Validate(meta, start, end, storage)
+	if err == nil {
+		fmt.Fprintln(w, "\nOK")
+		return true, nil
+	}
+	var invalid *backup.ValidationError
+	if !errors.As(err, &invalid) {
+		return false, err
+	}
+	fmt.Fprintf(w, "\nINVALID, %d problems:\n", len(invalid.Problems))
+	for _, p := range invalid.Problems {
+		fmt.Fprintf(w, "  - %s\n", p)
+	}
+	return false, nil
+}
+
+// parseKey decodes a key given in -key-format.
+func parseKey(key string) ([]byte, error) {
+	switch *keyFormat {
+	case "hex":
+		return hex.DecodeString(key)
+	case "escaped":
+		s, err := strconv.Unquote(`"` + key + `"`)
+		if err != nil {
+			return nil, fmt.Errorf("invalid escaped key %q", key)
+		}
+		return []byte(s), nil
+	default:
+		return nil, fmt.Errorf("unknown key format %q", *keyFormat)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command waitgraph prints the wait-for graph of the pessimistic
+// transactions of a cluster, with its deadlocks and longest wait chains.
+//
+// Usage:
+//
+//	waitgraph -pd 127.0.0.1:2379 [-format dot|json] [-chains 10]
+//	waitgraph -stores 127.0.0.1:20160,127.0.0.1:20161
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+	"github.com/pingcap/kvproto/pkg/deadlock/waitgraph"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"google.golang.org/grpc"
+)
+
+var (
+	pdAddr    = flag.String("pd", "", "PD address to discover the stores from")
+	clusterID = flag.Uint64("cluster-id", 0, "cluster ID, asked from PD if 0")
+	stores    = flag.String("stores", "", "comma separated store addresses, instead of -pd")
+	format    = flag.String("format", "dot", "output format, dot or json")
+	chains    = flag.Int("chains", 10, "number of longest wait chains in json, 0 for all")
+	timeout   = flag.Duration("timeout", 10*time.Second, "timeout of the collection")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "waitgraph:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if *format != "dot" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	addrs, err := storeAddrs(ctx)
+	if err != nil {
+		return err
+	}
+	clients := make(map[string]deadlock.DeadlockClient, len(addrs))
+	for _, addr := range addrs {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		clients[addr] = deadlock.NewDeadlockClient(conn)
+	}
+
+	g := waitgraph.Collect(ctx, clients)
+	if len(addrs) > 0 && len(g.Errors()) == len(addrs) {
+		return fmt.Errorf("no store answered: %v", g.Errors())
+	}
+	if *format == "json" {
+		return g.WriteJSON(os.Stdout, *chains)
+	}
+	return g.WriteDOT(os.Stdout)
+}
+
+func storeAddrs(ctx context.Context) ([]string, error) {
+	if *stores != "" {
+		return strings.Split(*stores, ","), nil
+	}
+	if *pdAddr == "" {
+		return nil, fmt.Errorf("either -pd or -stores is needed")
+	}
+	conn, err := grpc.Dial(*pdAddr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := pdpb.NewPDClient(conn)
+	id := *clusterID
+	if id == 0 {
+		resp, err := client.GetMembers(ctx, &pdpb.GetMembersRequest{})
+		if err != nil {
+			return nil, err
+		}
+		id = resp.GetHeader().GetClusterId()
+	}
+	return waitgraph.StoreAddrs(ctx, client, id)
+}
@@ -1,12 +1,18 @@
 module github.com/pingcap/kvproto
 
+go 1.19
+
 require (
 	github.com/gogo/protobuf v0.0.0-20180717141946-636bf0302bc9
-	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b // indirect
 	github.com/golang/protobuf v0.0.0-20180814211427-aa810b61a9c7
+	github.com/google/btree v1.0.0
 	golang.org/x/net v0.0.0-20181005035420-146acd28ed58
+	google.golang.org/grpc v0.0.0-20180607172857-7a6a684ca69e
+)
+
+require (
+	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b // indirect
 	golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4 // indirect
 	golang.org/x/text v0.3.0 // indirect
 	google.golang.org/genproto v0.0.0-20181004005441-af9cb2a35e7f // indirect
-	google.golang.org/grpc v0.0.0-20180607172857-7a6a684ca69e
 )
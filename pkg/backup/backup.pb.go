@@ -59,7 +59,7 @@ func (m *BackupMeta) Reset()         { *m = BackupMeta{} }
 func (m *BackupMeta) String() string { return proto.CompactTextString(m) }
 func (*BackupMeta) ProtoMessage()    {}
 func (*BackupMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{0}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{0}
 }
 func (m *BackupMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,6 +138,10 @@ func (m *BackupMeta) GetSchemas() []*Schema {
 }
 
 type File struct {
+	// An empty name marks a range without keys, for which a store sends no
+	// file. There is nothing to read or restore for it, and its sha256 and
+	// checksums are empty; it only keeps the files of a BackupMeta tiling
+	// the backed up range.
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Sha256               []byte   `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	StartKey             []byte   `protobuf:"bytes,3,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
@@ -156,7 +160,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{1}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{1}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +267,7 @@ func (m *Schema) Reset()         { *m = Schema{} }
 func (m *Schema) String() string { return proto.CompactTextString(m) }
 func (*Schema) ProtoMessage()    {}
 func (*Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{2}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{2}
 }
 func (m *Schema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +343,7 @@ func (m *ClusterIDError) Reset()         { *m = ClusterIDError{} }
 func (m *ClusterIDError) String() string { return proto.CompactTextString(m) }
 func (*ClusterIDError) ProtoMessage()    {}
 func (*ClusterIDError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{3}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{3}
 }
 func (m *ClusterIDError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +402,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{4}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{4}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +600,7 @@ func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
 func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()    {}
 func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{5}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{5}
 }
 func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +699,7 @@ func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
 func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()    {}
 func (*BackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_backup_e9bbe39a88057486, []int{6}
+	return fileDescriptor_backup_3889b50b8d8fd3e1, []int{6}
 }
 func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2974,10 +2978,10 @@ var (
 	ErrIntOverflowBackup   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("backup.proto", fileDescriptor_backup_e9bbe39a88057486) }
+func init() { proto.RegisterFile("backup.proto", fileDescriptor_backup_3889b50b8d8fd3e1) }
 
-var fileDescriptor_backup_e9bbe39a88057486 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_backup_3889b50b8d8fd3e1 = []byte{
+	// 683 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
 	0x10, 0xee, 0x26, 0xb6, 0x93, 0x4c, 0x7e, 0x5a, 0x56, 0xa5, 0x58, 0x41, 0x0d, 0x91, 0x2b, 0x41,
 	0x4e, 0x01, 0xa5, 0xd0, 0x0b, 0xb7, 0xd0, 0x22, 0xaa, 0xc2, 0xc5, 0x48, 0x5c, 0x23, 0xc7, 0x5e,
@@ -3020,5 +3024,5 @@ var fileDescriptor_backup_e9bbe39a88057486 = []byte{
 	0x6b, 0x97, 0xd5, 0xde, 0xf9, 0x1d, 0xd6, 0x1d, 0x78, 0x1b, 0x0f, 0xc8, 0xf0, 0xee, 0x97, 0x0f,
 	0x55, 0xf2, 0xf1, 0xbc, 0x43, 0x3e, 0x9f, 0x77, 0xc8, 0xd7, 0xf3, 0x0e, 0x79, 0xff, 0xad, 0xb3,
 	0x01, 0x5b, 0x19, 0x9f, 0xf4, 0x65, 0x9c, 0xe4, 0xfd, 0x24, 0xc7, 0xc7, 0x7a, 0xec, 0xe0, 0x67,
-	0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x98, 0x75, 0x99, 0x16, 0x10, 0x06, 0x00, 0x00,
+	0xff, 0xe7, 0x00, 0x98, 0x75, 0x99, 0x16, 0x10, 0x06, 0x00, 0x00,
 }
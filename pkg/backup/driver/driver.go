@@ -0,0 +1,356 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package driver runs a backup across the stores of a cluster. It splits
+// the range by the leaders of its regions, streams the backup of every part
+// from its store, retries the parts that failed, and assembles the
+// backup.BackupMeta once the returned ranges tile the whole range. A range
+// without keys gets a file without a name, so that the files of the
+// BackupMeta tile the range too.
+package driver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/errorpb/retry"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+)
+
+// ClientFunc returns a backup client of the store with storeID.
+type ClientFunc func(ctx context.Context, storeID uint64) (backup.BackupClient, error)
+
+// Options configures a backup.
+type Options struct {
+	ClusterID      uint64
+	ClusterVersion string
+	// StartVersion and EndVersion are the time range of the backup. They
+	// are equal for a full backup.
+	StartVersion uint64
+	EndVersion   uint64
+	// Path is where the stores save the files.
+	Path string
+	// RateLimit and Concurrency are passed to every store.
+	RateLimit   uint64
+	Concurrency uint32
+	// Backoff spaces out the rounds retrying failed ranges, and decides
+	// when to give up. A nil Backoff means retry.DefaultBackoff.
+	Backoff retry.BackoffPolicy
+	// ResolveLocks resolves the locks the backup met before their ranges
+	// are retried. If nil, the ranges are retried until the locks are gone.
+	ResolveLocks func(ctx context.Context, locks []*kvrpcpb.LockInfo) error
+}
+
+// Driver backs up key ranges of a cluster.
+type Driver struct {
+	cache   *regioncache.RegionCache
+	clients ClientFunc
+	opts    Options
+}
+
+// NewDriver creates a Driver finding the leaders of the regions in cache,
+// and sending requests to the stores through clients.
+func NewDriver(cache *regioncache.RegionCache, clients ClientFunc, opts Options) *Driver {
+	if opts.Backoff == nil {
+		opts.Backoff = retry.DefaultBackoff
+	}
+	return &Driver{cache: cache, clients: clients, opts: opts}
+}
+
+// keyRange is [start, end), where an empty end means +inf.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) String() string {
+	return fmt.Sprintf("[%q, %q)", r.start, r.end)
+}
+
+// endLess reports whether the range end a comes before the range end b.
+func endLess(a, b []byte) bool {
+	return len(a) != 0 && (len(b) == 0 || bytes.Compare(a, b) < 0)
+}
+
+// beforeEnd reports whether key comes before the range end.
+func beforeEnd(key, end []byte) bool {
+	return len(end) == 0 || bytes.Compare(key, end) < 0
+}
+
+// contains reports whether r contains other.
+func (r keyRange) contains(other keyRange) bool {
+	return bytes.Compare(other.start, r.start) >= 0 && !endLess(r.end, other.end)
+}
+
+// progress is the state of a backup shared by the requests of a round.
+type progress struct {
+	mu sync.Mutex
+	// done are the responses without error, sorted by start key.
+	done  []*backup.BackupResponse
+	locks []*kvrpcpb.LockInfo
+	// lastErr is the last retryable error, and kind its region error kind.
+	lastErr error
+	kind    retry.Kind
+}
+
+// add records a successful response to a request for req.
+func (p *progress) add(req keyRange, resp *backup.BackupResponse) error {
+	r := keyRange{start: resp.GetStartKey(), end: resp.GetEndKey()}
+	if !req.contains(r) {
+		return fmt.Errorf("backup: store returned %v outside of the request %v", r, req)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	i := sort.Search(len(p.done), func(i int) bool {
+		return bytes.Compare(p.done[i].GetStartKey(), r.start) >= 0
+	})
+	if i > 0 && beforeEnd(r.start, p.done[i-1].GetEndKey()) {
+		return fmt.Errorf("backup: range %v overlaps %v", r, responseRange(p.done[i-1]))
+	}
+	if i < len(p.done) && beforeEnd(p.done[i].GetStartKey(), r.end) {
+		return fmt.Errorf("backup: range %v overlaps %v", r, responseRange(p.done[i]))
+	}
+	p.done = append(p.done, nil)
+	copy(p.done[i+1:], p.done[i:])
+	p.done[i] = resp
+	return nil
+}
+
+func responseRange(resp *backup.BackupResponse) keyRange {
+	return keyRange{start: resp.GetStartKey(), end: resp.GetEndKey()}
+}
+
+// fail records a retryable error.
+func (p *progress) fail(err error, kind retry.Kind, locks ...*kvrpcpb.LockInfo) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastErr, p.kind = err, kind
+	p.locks = append(p.locks, locks...)
+}
+
+// gaps returns the parts of r that no response covers.
+func (p *progress) gaps(r keyRange) []keyRange {
+	var gaps []keyRange
+	cur := r.start
+	for _, resp := range p.done {
+		if bytes.Compare(cur, resp.GetStartKey()) < 0 {
+			gaps = append(gaps, keyRange{start: cur, end: resp.GetStartKey()})
+		}
+		if len(resp.GetEndKey()) == 0 {
+			return gaps
+		}
+		cur = resp.GetEndKey()
+	}
+	if beforeEnd(cur, r.end) {
+		gaps = append(gaps, keyRange{start: cur, end: r.end})
+	}
+	return gaps
+}
+
+// Backup backs up [startKey, endKey), where an empty endKey means +inf. It
+// retries the ranges that fail with a region or key error, or whose store
+// does not lead their region anymore, until the responses tile the range
+// or the backoff gives up.
+func (d *Driver) Backup(ctx context.Context, startKey, endKey []byte) (*backup.BackupMeta, error) {
+	whole := keyRange{start: startKey, end: endKey}
+	p := &progress{}
+	pending := []keyRange{whole}
+	for round := 1; ; round++ {
+		if err := d.round(ctx, pending, p); err != nil {
+			return nil, err
+		}
+		if pending = p.gaps(whole); len(pending) == 0 {
+			break
+		}
+		if len(p.locks) > 0 && d.opts.ResolveLocks != nil {
+			if err := d.opts.ResolveLocks(ctx, p.locks); err != nil {
+				return nil, err
+			}
+		}
+		p.locks = nil
+		backoff, ok := d.opts.Backoff.Backoff(p.kind, round)
+		if !ok {
+			cause := p.lastErr
+			if cause == nil {
+				cause = errors.New("no store returned them")
+			}
+			return nil, fmt.Errorf("backup: %d ranges left after %d rounds, first %v: %w", len(pending), round, pending[0], cause)
+		}
+		if err := sleep(ctx, backoff); err != nil {
+			return nil, err
+		}
+	}
+
+	meta := &backup.BackupMeta{
+		ClusterId:      d.opts.ClusterID,
+		ClusterVersion: d.opts.ClusterVersion,
+		Path:           d.opts.Path,
+		StartVersion:   d.opts.StartVersion,
+		EndVersion:     d.opts.EndVersion,
+	}
+	for _, resp := range p.done {
+		if len(resp.GetFiles()) == 0 {
+			meta.Files = append(meta.Files, d.emptyFile(resp))
+			continue
+		}
+		meta.Files = append(meta.Files, resp.GetFiles()...)
+	}
+	return meta, nil
+}
+
+// emptyFile returns the file covering the range of a response without files,
+// which a store sends for a range without keys. It has no name, as there is
+// nothing to restore from it, but keeps the files tiling the backed up range.
+func (d *Driver) emptyFile(resp *backup.BackupResponse) *backup.File {
+	return &backup.File{
+		StartKey:     resp.GetStartKey(),
+		EndKey:       resp.GetEndKey(),
+		StartVersion: d.opts.StartVersion,
+		EndVersion:   d.opts.EndVersion,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// round backs up the pending ranges once, sending the requests of every
+// store concurrently. Failures that can be retried are recorded in p; the
+// returned error cannot be.
+func (d *Driver) round(ctx context.Context, pending []keyRange, p *progress) error {
+	tasks, err := d.split(ctx, pending)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for storeID, ranges := range tasks {
+		wg.Add(1)
+		go func(storeID uint64, ranges []keyRange) {
+			defer wg.Done()
+			for _, r := range ranges {
+				if err := d.backupRange(ctx, storeID, r, p); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}(storeID, ranges)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// split divides the ranges by the leaders of their regions, merging the
+// adjacent regions led by the same store. The regions are loaded afresh,
+// so that a range whose leader moved goes to the new leader.
+func (d *Driver) split(ctx context.Context, ranges []keyRange) (map[uint64][]keyRange, error) {
+	tasks := make(map[uint64][]keyRange)
+	for _, r := range ranges {
+		regions, err := d.cache.LoadRange(ctx, r.start, r.end, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, region := range regions {
+			storeID := region.Leader.GetStoreId()
+			if region.Leader == nil && len(region.Meta.GetPeers()) > 0 {
+				storeID = region.Meta.GetPeers()[0].GetStoreId()
+			}
+			part := r
+			if bytes.Compare(region.Meta.GetStartKey(), part.start) > 0 {
+				part.start = region.Meta.GetStartKey()
+			}
+			if endLess(region.Meta.GetEndKey(), part.end) {
+				part.end = region.Meta.GetEndKey()
+			}
+			parts := tasks[storeID]
+			if n := len(parts); n > 0 && len(parts[n-1].end) > 0 && bytes.Equal(parts[n-1].end, part.start) {
+				parts[n-1].end = part.end
+				continue
+			}
+			tasks[storeID] = append(parts, part)
+		}
+	}
+	return tasks, nil
+}
+
+// backupRange streams the backup of r from the store. Region and key
+// errors, and failures of the stream, are recorded in p to be retried.
+func (d *Driver) backupRange(ctx context.Context, storeID uint64, r keyRange, p *progress) error {
+	client, err := d.clients(ctx, storeID)
+	if err != nil {
+		return err
+	}
+	stream, err := client.Backup(ctx, &backup.BackupRequest{
+		ClusterId:    d.opts.ClusterID,
+		StartKey:     r.start,
+		EndKey:       r.end,
+		StartVersion: d.opts.StartVersion,
+		EndVersion:   d.opts.EndVersion,
+		Path:         d.opts.Path,
+		RateLimit:    d.opts.RateLimit,
+		Concurrency:  d.opts.Concurrency,
+	})
+	for err == nil {
+		var resp *backup.BackupResponse
+		if resp, err = stream.Recv(); err != nil {
+			break
+		}
+		if e := resp.GetError(); e != nil {
+			switch {
+			case e.GetRegionError() != nil:
+				p.fail(kverror.FromBackupError(e), retry.Classify(e.GetRegionError()))
+			case e.GetKvError().GetLocked() != nil:
+				p.fail(kverror.FromBackupError(e), retry.KindUnknown, e.GetKvError().GetLocked())
+			case e.GetKvError() != nil:
+				p.fail(kverror.FromBackupError(e), retry.KindUnknown)
+			default:
+				return kverror.FromBackupError(e)
+			}
+			continue
+		}
+		if err = p.add(r, resp); err != nil {
+			return err
+		}
+	}
+	if err == io.EOF {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	p.fail(err, retry.KindUnknown)
+	return nil
+}
@@ -0,0 +1,274 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/checksum"
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/errorpb/retry"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/mocktikv/mocktikvtest"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"google.golang.org/grpc"
+)
+
+type testCluster struct {
+	*mocktikvtest.Cluster
+	cache *regioncache.RegionCache
+}
+
+// newTestCluster starts a mock PD and mock TiKVs on stores 1 and 2, with
+// the regions split at "c", "f" and "m", all led by store 1, and the letters
+// a to z committed at 10.
+func newTestCluster(t *testing.T) *testCluster {
+	c := &testCluster{Cluster: mocktikvtest.NewCluster(t, 2)}
+	c.Split(t, "c", "f", "m")
+	c.cache = c.NewRegionCache()
+
+	var mutations []*kvrpcpb.Mutation
+	for l := 'a'; l <= 'z'; l++ {
+		mutations = append(mutations, &kvrpcpb.Mutation{Op: kvrpcpb.Op_Put, Key: []byte{byte(l)}, Value: []byte{byte(l)}})
+	}
+	c.MVCC.Import(mutations, 10)
+	return c
+}
+
+func (c *testCluster) client(ctx context.Context, storeID uint64) (backup.BackupClient, error) {
+	return backup.NewBackupClient(c.Conn(storeID)), nil
+}
+
+type backupFunc func(ctx context.Context, in *backup.BackupRequest, opts ...grpc.CallOption) (backup.Backup_BackupClient, error)
+
+func (f backupFunc) Backup(ctx context.Context, in *backup.BackupRequest, opts ...grpc.CallOption) (backup.Backup_BackupClient, error) {
+	return f(ctx, in, opts...)
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	resps []*backup.BackupResponse
+}
+
+func (s *fakeStream) Recv() (*backup.BackupResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
+
+var testBackoff = retry.ExponentialBackoff{Base: time.Millisecond, MaxAttempts: 3}
+
+func checkMeta(t *testing.T, c *testCluster, meta *backup.BackupMeta, dir string) {
+	t.Helper()
+	expectRanges := []string{"b-c", "c-f", "f-m", "m-x"}
+	if len(meta.GetFiles()) != len(expectRanges) {
+		t.Fatalf("expect %d files, got %v", len(expectRanges), meta.GetFiles())
+	}
+	var sum checksum.Checksum
+	for i, f := range meta.GetFiles() {
+		if got := string(f.GetStartKey()) + "-" + string(f.GetEndKey()); got != expectRanges[i] {
+			t.Fatalf("expect file %d on %s, got %s", i, expectRanges[i], got)
+		}
+		if f.GetStartVersion() != 100 || f.GetEndVersion() != 100 {
+			t.Fatalf("unexpected versions of %v", f)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, f.GetName()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hash := sha256.Sum256(data); !bytes.Equal(hash[:], f.GetSha256()) {
+			t.Fatalf("sha256 mismatch of %s", f.GetName())
+		}
+		sum.Merge(checksum.Checksum{Crc64Xor: f.GetCrc64Xor(), TotalKvs: f.GetTotalKvs(), TotalBytes: f.GetTotalBytes()})
+	}
+	expect, err := c.MVCC.Checksum(nil, []byte("b"), []byte("x"), 100)
+	if err != nil || sum != expect {
+		t.Fatalf("expect checksum %+v, got %+v %v", expect, sum, err)
+	}
+	if meta.GetClusterId() != mocktikvtest.ClusterID || meta.GetStartVersion() != 100 || meta.GetEndVersion() != 100 || meta.GetPath() != dir {
+		t.Fatalf("unexpected meta %v", meta)
+	}
+	if err = backup.Validate(meta, []byte("b"), []byte("x"), backup.LocalStorage(dir)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBackup(t *testing.T) {
+	c := newTestCluster(t)
+	defer c.Close()
+	c.ChangeLeader(t, "f", 2)
+	ctx := context.Background()
+	dir := t.TempDir()
+	opts := Options{ClusterID: mocktikvtest.ClusterID, StartVersion: 100, EndVersion: 100, Path: dir, Backoff: testBackoff}
+
+	meta, err := NewDriver(c.cache, c.client, opts).Backup(ctx, []byte("b"), []byte("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMeta(t, c, meta, dir)
+
+	// The leader of [f, m) moves back to store 1 before store 2 backs it
+	// up, and the first request to store 1 fails with a region error.
+	var moved, failed int32
+	meta, err = NewDriver(c.cache, func(ctx context.Context, storeID uint64) (backup.BackupClient, error) {
+		if storeID == 2 && atomic.CompareAndSwapInt32(&moved, 0, 1) {
+			c.ChangeLeader(t, "f", 1)
+		}
+		if storeID == 1 && atomic.CompareAndSwapInt32(&failed, 0, 1) {
+			return backupFunc(func(ctx context.Context, in *backup.BackupRequest, opts ...grpc.CallOption) (backup.Backup_BackupClient, error) {
+				return &fakeStream{resps: []*backup.BackupResponse{{
+					Error:    &backup.Error{Detail: &backup.Error_RegionError{RegionError: &errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{}}}},
+					StartKey: in.GetStartKey(),
+					EndKey:   []byte("c"),
+				}}}, nil
+			}), nil
+		}
+		return backup.NewBackupClient(c.Conn(storeID)), nil
+	}, opts).Backup(ctx, []byte("b"), []byte("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved != 1 || failed != 1 {
+		t.Fatal("expect the leader to move and a request to fail")
+	}
+	checkMeta(t, c, meta, dir)
+}
+
+func TestBackupEmptyRange(t *testing.T) {
+	c := newTestCluster(t)
+	defer c.Close()
+	opts := Options{ClusterID: mocktikvtest.ClusterID, StartVersion: 100, EndVersion: 100, Path: t.TempDir(), Backoff: testBackoff}
+
+	// Like TiKV, the store sends no file for a range without keys.
+	meta, err := NewDriver(c.cache, func(ctx context.Context, storeID uint64) (backup.BackupClient, error) {
+		return backupFunc(func(ctx context.Context, in *backup.BackupRequest, opts ...grpc.CallOption) (backup.Backup_BackupClient, error) {
+			return &fakeStream{resps: []*backup.BackupResponse{{StartKey: in.GetStartKey(), EndKey: in.GetEndKey()}}}, nil
+		}), nil
+	}, opts).Backup(context.Background(), []byte("b"), []byte("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := meta.GetFiles()
+	if len(files) != 1 || files[0].GetName() != "" || string(files[0].GetStartKey()) != "b" || string(files[0].GetEndKey()) != "x" ||
+		files[0].GetStartVersion() != 100 || files[0].GetEndVersion() != 100 || files[0].GetTotalKvs() != 0 {
+		t.Fatalf("expect one empty file covering the range, got %v", files)
+	}
+	if err = backup.Validate(meta, []byte("b"), []byte("x"), backup.LocalStorage(opts.Path)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBackupLocks(t *testing.T) {
+	c := newTestCluster(t)
+	defer c.Close()
+	ctx := context.Background()
+	dir := t.TempDir()
+	errs := c.MVCC.Prewrite(&kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Put, Key: []byte("d"), Value: []byte("D")}},
+		PrimaryLock:  []byte("d"),
+		StartVersion: 90,
+		LockTtl:      3000,
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	opts := Options{ClusterID: mocktikvtest.ClusterID, StartVersion: 100, EndVersion: 100, Path: dir, Backoff: testBackoff}
+
+	_, err := NewDriver(c.cache, c.client, opts).Backup(ctx, []byte("b"), []byte("x"))
+	if !errors.Is(err, kverror.ErrLocked) || !strings.Contains(err.Error(), `first ["c", "f")`) {
+		t.Fatalf("expect the lock to stop the backup, got %v", err)
+	}
+
+	var resolved []string
+	opts.ResolveLocks = func(ctx context.Context, locks []*kvrpcpb.LockInfo) error {
+		for _, lock := range locks {
+			resolved = append(resolved, string(lock.GetKey()))
+			if err := c.MVCC.Rollback([][]byte{lock.GetKey()}, lock.GetLockVersion()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	meta, err := NewDriver(c.cache, c.client, opts).Backup(ctx, []byte("b"), []byte("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resolved) != 1 || resolved[0] != "d" {
+		t.Fatalf("expect the lock on d to be resolved, got %v", resolved)
+	}
+	checkMeta(t, c, meta, dir)
+}
+
+func TestBackupErrors(t *testing.T) {
+	c := newTestCluster(t)
+	defer c.Close()
+	ctx := context.Background()
+	opts := Options{ClusterID: mocktikvtest.ClusterID, StartVersion: 100, EndVersion: 100, Path: t.TempDir(), Backoff: testBackoff}
+	respond := func(resps ...*backup.BackupResponse) ClientFunc {
+		return func(context.Context, uint64) (backup.BackupClient, error) {
+			return backupFunc(func(ctx context.Context, in *backup.BackupRequest, opts ...grpc.CallOption) (backup.Backup_BackupClient, error) {
+				return &fakeStream{resps: resps}, nil
+			}), nil
+		}
+	}
+
+	_, err := NewDriver(c.cache, respond(&backup.BackupResponse{
+		Error: &backup.Error{Detail: &backup.Error_ClusterIdError{ClusterIdError: &backup.ClusterIDError{Current: 2, Request: 1}}},
+	}), opts).Backup(ctx, []byte("b"), []byte("x"))
+	if !errors.Is(err, kverror.ErrClusterIDMismatch) {
+		t.Fatalf("expect cluster ID mismatch, got %v", err)
+	}
+
+	_, err = NewDriver(c.cache, respond(
+		&backup.BackupResponse{StartKey: []byte("b"), EndKey: []byte("e")},
+		&backup.BackupResponse{StartKey: []byte("d"), EndKey: []byte("x")},
+	), opts).Backup(ctx, []byte("b"), []byte("x"))
+	if err == nil || !strings.Contains(err.Error(), "overlaps") {
+		t.Fatalf("expect an overlap, got %v", err)
+	}
+
+	_, err = NewDriver(c.cache, respond(&backup.BackupResponse{StartKey: []byte("a"), EndKey: []byte("c")}), opts).Backup(ctx, []byte("b"), []byte("x"))
+	if err == nil || !strings.Contains(err.Error(), "outside") {
+		t.Fatalf("expect a range outside of the request, got %v", err)
+	}
+
+	// A range no store returns is retried until the backoff gives up.
+	_, err = NewDriver(c.cache, func(context.Context, uint64) (backup.BackupClient, error) {
+		return backupFunc(func(ctx context.Context, in *backup.BackupRequest, opts ...grpc.CallOption) (backup.Backup_BackupClient, error) {
+			stream := &fakeStream{}
+			if string(in.GetStartKey()) == "b" {
+				stream.resps = append(stream.resps, &backup.BackupResponse{StartKey: []byte("b"), EndKey: []byte("c")})
+			}
+			return stream, nil
+		}), nil
+	}, opts).Backup(ctx, []byte("b"), []byte("x"))
+	if err == nil || !strings.Contains(err.Error(), `first ["c", "x")`) {
+		t.Fatalf("expect a gap, got %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/kvproto/pkg/checksum"
+)
+
+// Storage reads the files of a backup.
+type Storage interface {
+	// Open opens the file with name.
+	Open(name string) (io.ReadCloser, error)
+}
+
+// LocalStorage is a Storage on a local directory.
+type LocalStorage string
+
+// Open implements Storage.
+func (dir LocalStorage) Open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(dir), name))
+}
+
+// NewStorage returns the Storage of a backup path. Only local paths are
+// supported, with or without the "local://" scheme.
+func NewStorage(path string) (Storage, error) {
+	if i := strings.Index(path, "://"); i >= 0 && path[:i] != "local" {
+		return nil, fmt.Errorf("backup: unsupported storage %q", path)
+	}
+	return LocalStorage(strings.TrimPrefix(path, "local://")), nil
+}
+
+// ValidationError lists the problems Validate found in a backup.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("backup: invalid backup: %s", strings.Join(e.Problems, "; "))
+}
+
+// Validate checks that a backup of [startKey, endKey), where an empty endKey
+// means +inf, can be restored:
+//   - sorted by start key, the file ranges tile the backed up range, each
+//     starting where the previous ends, without holes or overlaps, from
+//     startKey to endKey;
+//   - start_version is not after end_version, and every file carries both;
+//   - the sha256 of every file matches its content in storage, except for
+//     the files without a name, which cover ranges without keys and must
+//     not hold any;
+//   - the checksums of every schema equal the sum over the files in the key
+//     range of its table. The table, or its partitions, are found by the ID
+//     in the JSON table info of the schema, and a file holding keys across
+//     the bounds of a table, which cannot be split between tables, is a
+//     problem. The checksums of all the schemas also add up to the sum over
+//     all the files, so that no keys are outside the tables.
+//
+// It returns a *ValidationError with every problem found.
+func Validate(meta *BackupMeta, startKey, endKey []byte, storage Storage) error {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if meta.GetStartVersion() > meta.GetEndVersion() {
+		addf("start_version %d is after end_version %d", meta.GetStartVersion(), meta.GetEndVersion())
+	}
+	files := append([]*File(nil), meta.GetFiles()...)
+	if len(files) == 0 {
+		addf("no files")
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return bytes.Compare(files[i].GetStartKey(), files[j].GetStartKey()) < 0
+	})
+	names := make(map[string]bool)
+	var sum checksum.Checksum
+	for i, f := range files {
+		if f.GetName() != "" && names[f.GetName()] {
+			addf("file %s is listed twice", fileName(f))
+		}
+		names[f.GetName()] = true
+		if len(f.GetEndKey()) != 0 && bytes.Compare(f.GetStartKey(), f.GetEndKey()) >= 0 {
+			addf("file %s has an empty range [%q, %q)", fileName(f), f.GetStartKey(), f.GetEndKey())
+		}
+		if i > 0 {
+			prev := files[i-1]
+			switch end := prev.GetEndKey(); {
+			case len(end) == 0 || bytes.Compare(f.GetStartKey(), end) < 0:
+				addf("file %s overlaps file %s at %q", fileName(f), fileName(prev), f.GetStartKey())
+			case bytes.Compare(f.GetStartKey(), end) > 0:
+				addf("hole [%q, %q) between files %s and %s", end, f.GetStartKey(), fileName(prev), fileName(f))
+			}
+		}
+		if f.GetStartVersion() != meta.GetStartVersion() || f.GetEndVersion() != meta.GetEndVersion() {
+			addf("file %s has versions (%d, %d], the backup (%d, %d]", fileName(f),
+				f.GetStartVersion(), f.GetEndVersion(), meta.GetStartVersion(), meta.GetEndVersion())
+		}
+		switch {
+		case f.GetName() != "":
+			if err := checkSha256(storage, f); err != nil {
+				addf("file %s: %v", f.GetName(), err)
+			}
+		case f.GetTotalKvs() != 0 || f.GetTotalBytes() != 0 || f.GetCrc64Xor() != 0:
+			addf("file %s has no name but holds %d kvs", fileName(f), f.GetTotalKvs())
+		}
+		sum.Merge(checksum.Checksum{Crc64Xor: f.GetCrc64Xor(), TotalKvs: f.GetTotalKvs(), TotalBytes: f.GetTotalBytes()})
+	}
+	if len(files) > 0 {
+		first, last := files[0], files[len(files)-1]
+		switch start := first.GetStartKey(); {
+		case bytes.Compare(start, startKey) > 0:
+			addf("hole [%q, %q) before file %s", startKey, start, fileName(first))
+		case bytes.Compare(start, startKey) < 0:
+			addf("file %s starts at %q, before the backed up range [%q, %q)", fileName(first), start, startKey, endKey)
+		}
+		switch end := last.GetEndKey(); {
+		case bytes.Equal(end, endKey):
+		case len(end) != 0 && (len(endKey) == 0 || bytes.Compare(end, endKey) < 0):
+			addf("hole [%q, %q) after file %s", end, endKey, fileName(last))
+		default:
+			addf("file %s ends at %q, after the backed up range [%q, %q)", fileName(last), end, startKey, endKey)
+		}
+	}
+
+	if len(meta.GetSchemas()) > 0 {
+		for i, s := range meta.GetSchemas() {
+			id, ranges, err := tableRanges(s)
+			if err != nil {
+				addf("schema %d has an invalid table info: %v", i, err)
+				continue
+			}
+			var sum checksum.Checksum
+			for _, f := range files {
+				switch within, overlaps := inRanges(f, ranges); {
+				case within:
+					sum.Merge(checksum.Checksum{Crc64Xor: f.GetCrc64Xor(), TotalKvs: f.GetTotalKvs(), TotalBytes: f.GetTotalBytes()})
+				case overlaps && (f.GetTotalKvs() != 0 || f.GetTotalBytes() != 0 || f.GetCrc64Xor() != 0):
+					addf("file %s holds keys across the bounds of table %d", fileName(f), id)
+				}
+			}
+			if schema := (checksum.Checksum{Crc64Xor: s.GetCrc64Xor(), TotalKvs: s.GetTotalKvs(), TotalBytes: s.GetTotalBytes()}); schema != sum {
+				addf("table %d sums to crc64xor=%d total_kvs=%d total_bytes=%d, its files to crc64xor=%d total_kvs=%d total_bytes=%d",
+					id, schema.Crc64Xor, schema.TotalKvs, schema.TotalBytes, sum.Crc64Xor, sum.TotalKvs, sum.TotalBytes)
+			}
+		}
+		var schemas checksum.Checksum
+		for _, s := range meta.GetSchemas() {
+			schemas.Merge(checksum.Checksum{Crc64Xor: s.GetCrc64Xor(), TotalKvs: s.GetTotalKvs(), TotalBytes: s.GetTotalBytes()})
+		}
+		if schemas != sum {
+			addf("schemas sum to crc64xor=%d total_kvs=%d total_bytes=%d, files to crc64xor=%d total_kvs=%d total_bytes=%d",
+				schemas.Crc64Xor, schemas.TotalKvs, schemas.TotalBytes, sum.Crc64Xor, sum.TotalKvs, sum.TotalBytes)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// tableInfo is the part of the JSON table info of a Schema giving the key
+// ranges of the table.
+type tableInfo struct {
+	ID        int64 `json:"id"`
+	Partition *struct {
+		Definitions []struct {
+			ID int64 `json:"id"`
+		} `json:"definitions"`
+	} `json:"partition"`
+}
+
+// keyRange is [start, end), where an empty end means +inf.
+type keyRange struct {
+	start, end []byte
+}
+
+// tableRanges returns the table ID of s, and the key ranges of the table,
+// one per partition if it is partitioned.
+func tableRanges(s *Schema) (int64, []keyRange, error) {
+	var info tableInfo
+	if err := json.Unmarshal(s.GetTable(), &info); err != nil {
+		return 0, nil, err
+	}
+	if info.ID <= 0 {
+		return 0, nil, fmt.Errorf("no table id")
+	}
+	ids := []int64{info.ID}
+	if info.Partition != nil && len(info.Partition.Definitions) > 0 {
+		ids = ids[:0]
+		for _, def := range info.Partition.Definitions {
+			ids = append(ids, def.ID)
+		}
+	}
+	ranges := make([]keyRange, 0, len(ids))
+	for _, id := range ids {
+		r := keyRange{start: tablePrefix(id), end: []byte("u")}
+		if id < math.MaxInt64 {
+			r.end = tablePrefix(id + 1)
+		}
+		ranges = append(ranges, r)
+	}
+	return info.ID, ranges, nil
+}
+
+// tablePrefix returns the prefix of the keys of the table with id, 't'
+// followed by the id encoded to compare as a signed integer.
+func tablePrefix(id int64) []byte {
+	prefix := make([]byte, 9)
+	prefix[0] = 't'
+	binary.BigEndian.PutUint64(prefix[1:], uint64(id)^(1<<63))
+	return prefix
+}
+
+// inRanges reports whether the range of f is within one of ranges, and
+// whether it overlaps any of them.
+func inRanges(f *File, ranges []keyRange) (within, overlaps bool) {
+	start, end := f.GetStartKey(), f.GetEndKey()
+	for _, r := range ranges {
+		if bytes.Compare(start, r.end) >= 0 || (len(end) != 0 && bytes.Compare(r.start, end) >= 0) {
+			continue
+		}
+		if bytes.Compare(start, r.start) >= 0 && len(end) != 0 && bytes.Compare(end, r.end) <= 0 {
+			return true, true
+		}
+		overlaps = true
+	}
+	return false, overlaps
+}
+
+// fileName names f in problems, by its range if it has no name.
+func fileName(f *File) string {
+	if f.GetName() == "" {
+		return fmt.Sprintf("[%q, %q)", f.GetStartKey(), f.GetEndKey())
+	}
+	return f.GetName()
+}
+
+func checkSha256(storage Storage, f *File) error {
+	r, err := storage.Open(f.GetName())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, r); err != nil {
+		return err
+	}
+	if !bytes.Equal(h.Sum(nil), f.GetSha256()) {
+		return fmt.Errorf("sha256 mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap/kvproto/pkg/checksum"
+)
+
+// newTestFile writes the file name of [start, end), whose pairs are the
+// letters of keys as both key and value.
+func newTestFile(t *testing.T, dir, name, start, end, keys string) *File {
+	var sum checksum.Checksum
+	for _, k := range keys {
+		sum.Update([]byte{byte(k)}, []byte{byte(k)})
+	}
+	data := []byte(keys)
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(data)
+	return &File{
+		Name:         name,
+		Sha256:       hash[:],
+		StartKey:     []byte(start),
+		EndKey:       []byte(end),
+		StartVersion: 100,
+		EndVersion:   100,
+		Crc64Xor:     sum.Crc64Xor,
+		TotalKvs:     sum.TotalKvs,
+		TotalBytes:   sum.TotalBytes,
+	}
+}
+
+// newTestBackup writes a file for each range of keys, named after its
+// start key, and returns the meta.
+func newTestBackup(t *testing.T, dir string, ranges ...[3]string) *BackupMeta {
+	meta := &BackupMeta{Path: "local://" + dir, StartVersion: 100, EndVersion: 100}
+	for _, r := range ranges {
+		meta.Files = append(meta.Files, newTestFile(t, dir, r[0]+".kv", r[0], r[1], r[2]))
+	}
+	return meta
+}
+
+// fileSum sums the checksums of files into a schema of table.
+func fileSum(table string, files ...*File) *Schema {
+	var sum checksum.Checksum
+	for _, f := range files {
+		sum.Merge(checksum.Checksum{Crc64Xor: f.GetCrc64Xor(), TotalKvs: f.GetTotalKvs(), TotalBytes: f.GetTotalBytes()})
+	}
+	return &Schema{Db: []byte("db"), Table: []byte(table), Crc64Xor: sum.Crc64Xor, TotalKvs: sum.TotalKvs, TotalBytes: sum.TotalBytes}
+}
+
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewStorage("local://" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewStorage("s3://bucket/backup"); err == nil {
+		t.Fatal("expect s3 to be unsupported")
+	}
+	meta := newTestBackup(t, dir, [3]string{"m", "", "mnz"}, [3]string{"a", "f", "abc"}, [3]string{"f", "m", ""})
+	if err = Validate(meta, []byte("a"), nil, storage); err != nil {
+		t.Fatal(err)
+	}
+
+	mustRangeProblems := func(meta *BackupMeta, startKey, endKey string, expect ...string) {
+		t.Helper()
+		var e *ValidationError
+		if err := Validate(meta, []byte(startKey), []byte(endKey), storage); !errors.As(err, &e) || !reflect.DeepEqual(e.Problems, expect) {
+			t.Fatalf("expect %q, got %v", expect, err)
+		}
+	}
+	mustProblems := func(meta *BackupMeta, expect ...string) {
+		t.Helper()
+		mustRangeProblems(meta, "a", "", expect...)
+	}
+	mustProblems(&BackupMeta{}, "no files")
+
+	// A range without keys may be covered by a file without a name, which
+	// must not hold any.
+	empty := proto.Clone(meta).(*BackupMeta)
+	empty.Files[2] = &File{StartKey: []byte("f"), EndKey: []byte("m"), StartVersion: 100, EndVersion: 100}
+	if err = Validate(empty, []byte("a"), nil, storage); err != nil {
+		t.Fatal(err)
+	}
+	empty.Files[2].TotalKvs = 1
+	mustProblems(empty, `file ["f", "m") has no name but holds 1 kvs`)
+	mustRangeProblems(meta, "0", "z",
+		`hole ["0", "a") before file a.kv`,
+		`file m.kv ends at "", after the backed up range ["0", "z")`,
+	)
+	mustRangeProblems(meta, "b", "",
+		`file a.kv starts at "a", before the backed up range ["b", "")`,
+	)
+
+	// Without schemas, only the ranges show that the last file is missing.
+	broken := proto.Clone(meta).(*BackupMeta)
+	broken.Files = broken.Files[1:]
+	mustProblems(broken, `hole ["m", "") after file f.kv`)
+
+	broken = proto.Clone(meta).(*BackupMeta)
+	broken.Files[1].EndKey = []byte("e")
+	broken.Files[2].EndKey = []byte("n")
+	broken.Files[0].EndVersion = 99
+	mustProblems(broken,
+		`hole ["e", "f") between files a.kv and f.kv`,
+		`file m.kv overlaps file f.kv at "m"`,
+		`file m.kv has versions (100, 99], the backup (100, 100]`,
+	)
+
+	broken = proto.Clone(meta).(*BackupMeta)
+	broken.StartVersion = 101
+	broken.Files = append(broken.Files, proto.Clone(broken.Files[0]).(*File))
+	mustProblems(broken,
+		"start_version 101 is after end_version 100",
+		"file a.kv has versions (100, 100], the backup (101, 100]",
+		"file f.kv has versions (100, 100], the backup (101, 100]",
+		"file m.kv has versions (100, 100], the backup (101, 100]",
+		"file m.kv is listed twice",
+		`file m.kv overlaps file m.kv at "m"`,
+		"file m.kv has versions (100, 100], the backup (101, 100]",
+	)
+
+	// A truncated file and a missing one.
+	if err = os.WriteFile(filepath.Join(dir, "a.kv"), []byte("ab"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(dir, "m.kv")); err != nil {
+		t.Fatal(err)
+	}
+	var e *ValidationError
+	if err = Validate(meta, []byte("a"), nil, storage); !errors.As(err, &e) || len(e.Problems) != 2 || e.Problems[0] != "file a.kv: sha256 mismatch" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestValidateSchemas(t *testing.T) {
+	dir := t.TempDir()
+	storage := LocalStorage(dir)
+	table := func(id int64) string { return string(tablePrefix(id)) }
+	// Table 1 is in two files, table 2 in one, and table 3 is partitioned
+	// into 4 and 5. Table 3 itself holds no keys.
+	t1a := newTestFile(t, dir, "t1a.kv", table(1), table(1)+"m", "abc")
+	t1b := newTestFile(t, dir, "t1b.kv", table(1)+"m", table(2), "de")
+	t2 := newTestFile(t, dir, "t2.kv", table(2), table(3), "fgh")
+	p4 := newTestFile(t, dir, "p4.kv", table(4), table(5), "i")
+	p5 := newTestFile(t, dir, "p5.kv", table(5), table(6), "jk")
+	t3 := &File{StartKey: []byte(table(3)), EndKey: []byte(table(4)), StartVersion: 100, EndVersion: 100}
+	meta := &BackupMeta{
+		StartVersion: 100,
+		EndVersion:   100,
+		Files:        []*File{t1a, t1b, t2, t3, p4, p5},
+		Schemas: []*Schema{
+			fileSum(`{"id":1}`, t1a, t1b),
+			fileSum(`{"id":2}`, t2),
+			fileSum(`{"id":3,"partition":{"definitions":[{"id":4},{"id":5}]}}`, p4, p5),
+		},
+	}
+	if err := Validate(meta, []byte(table(1)), []byte(table(6)), storage); err != nil {
+		t.Fatal(err)
+	}
+
+	mustProblems := func(meta *BackupMeta, expect ...string) {
+		t.Helper()
+		var e *ValidationError
+		if err := Validate(meta, []byte(table(1)), []byte(table(6)), storage); !errors.As(err, &e) || !reflect.DeepEqual(e.Problems, expect) {
+			t.Fatalf("expect %q, got %v", expect, err)
+		}
+	}
+	sums := func(id int64, schema, files *Schema) string {
+		return fmt.Sprintf("table %d sums to crc64xor=%d total_kvs=%d total_bytes=%d, its files to crc64xor=%d total_kvs=%d total_bytes=%d",
+			id, schema.Crc64Xor, schema.TotalKvs, schema.TotalBytes, files.Crc64Xor, files.TotalKvs, files.TotalBytes)
+	}
+
+	// The totals match, but not the tables.
+	swapped := proto.Clone(meta).(*BackupMeta)
+	swapped.Schemas[0], swapped.Schemas[1] = swapped.Schemas[1], swapped.Schemas[0]
+	swapped.Schemas[0].Table, swapped.Schemas[1].Table = swapped.Schemas[1].Table, swapped.Schemas[0].Table
+	mustProblems(swapped,
+		sums(1, meta.Schemas[1], meta.Schemas[0]),
+		sums(2, meta.Schemas[0], meta.Schemas[1]),
+	)
+
+	// A file across two tables cannot be split between them.
+	across := newTestFile(t, dir, "t1b2.kv", table(1)+"m", table(3), "defgh")
+	merged := proto.Clone(meta).(*BackupMeta)
+	merged.Files = []*File{t1a, across, t3, p4, p5}
+	mustProblems(merged,
+		"file t1b2.kv holds keys across the bounds of table 1",
+		sums(1, meta.Schemas[0], fileSum("", t1a)),
+		"file t1b2.kv holds keys across the bounds of table 2",
+		sums(2, meta.Schemas[1], fileSum("")),
+	)
+
+	// The totals show a wrong schema too.
+	wrong := proto.Clone(meta).(*BackupMeta)
+	wrong.Schemas[2].TotalBytes++
+	total := fileSum("", t1a, t1b, t2, p4, p5)
+	mustProblems(wrong,
+		sums(3, wrong.Schemas[2], meta.Schemas[2]),
+		fmt.Sprintf("schemas sum to crc64xor=%d total_kvs=%d total_bytes=%d, files to crc64xor=%d total_kvs=%d total_bytes=%d",
+			total.Crc64Xor, total.TotalKvs, total.TotalBytes+1, total.Crc64Xor, total.TotalKvs, total.TotalBytes),
+	)
+
+	invalid := proto.Clone(meta).(*BackupMeta)
+	invalid.Schemas[1].Table = []byte("{}")
+	mustProblems(invalid, "schema 1 has an invalid table info: no table id")
+}
@@ -0,0 +1,345 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package batchclient multiplexes requests from many goroutines onto a single
+// Tikv.BatchCommands stream.
+package batchclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// ErrClientClosed is returned for requests sent after Close.
+var ErrClientClosed = errors.New("batchclient: client is closed")
+
+// Options tunes a Client. Zero values take the defaults.
+type Options struct {
+	// MaxBatchSize is the most requests sent in one BatchCommandsRequest.
+	MaxBatchSize int
+	// MaxBatchWaitTime is how long the client waits to fill a batch when the
+	// server reports a transport layer load above OverloadThreshold. With
+	// zero, batches are never held back.
+	MaxBatchWaitTime time.Duration
+	// OverloadThreshold is the transport_layer_load from which the server is
+	// considered busy.
+	OverloadThreshold uint64
+	// ReconnectBackoff is the delay between attempts to reopen the stream.
+	ReconnectBackoff time.Duration
+}
+
+const (
+	defaultMaxBatchSize      = 128
+	defaultOverloadThreshold = 200
+	defaultReconnectBackoff  = 100 * time.Millisecond
+)
+
+func (o *Options) adjust() {
+	if o.MaxBatchSize <= 0 {
+		o.MaxBatchSize = defaultMaxBatchSize
+	}
+	if o.OverloadThreshold == 0 {
+		o.OverloadThreshold = defaultOverloadThreshold
+	}
+	if o.ReconnectBackoff <= 0 {
+		o.ReconnectBackoff = defaultReconnectBackoff
+	}
+}
+
+type result struct {
+	resp *tikvpb.BatchCommandsResponse_Response
+	err  error
+}
+
+type entry struct {
+	req      *tikvpb.BatchCommandsRequest_Request
+	res      chan result
+	canceled int32
+}
+
+func (e *entry) isCanceled() bool {
+	return atomic.LoadInt32(&e.canceled) == 1
+}
+
+// batchStream is one BatchCommands stream and the requests waiting on it.
+type batchStream struct {
+	tikvpb.Tikv_BatchCommandsClient
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	err     error
+	pending map[uint64]*entry
+}
+
+// fail marks the stream broken and fails every request still waiting on it.
+func (s *batchStream) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return
+	}
+	s.err = err
+	for id, e := range s.pending {
+		e.res <- result{err: err}
+		delete(s.pending, id)
+	}
+	s.cancel()
+}
+
+// Client sends BatchCommandsRequest_Request values from concurrent callers
+// over one stream. It assigns request IDs, coalesces whatever requests are
+// waiting into a batch and routes each response back to its caller. A broken
+// stream fails the requests in flight and is reopened for later ones.
+type Client struct {
+	client tikvpb.TikvClient
+	opts   Options
+
+	reqCh     chan *entry
+	closed    chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+
+	mu     sync.Mutex
+	stream *batchStream
+
+	nextID             uint64
+	transportLayerLoad uint64
+}
+
+// NewClient creates a Client over client and starts its send loop.
+func NewClient(client tikvpb.TikvClient, opts Options) *Client {
+	opts.adjust()
+	c := &Client{
+		client: client,
+		opts:   opts,
+		reqCh:  make(chan *entry, opts.MaxBatchSize),
+		closed: make(chan struct{}),
+	}
+	c.wg.Add(1)
+	go c.batchSendLoop()
+	return c
+}
+
+// SendRequest sends req in the next batch and waits for its response. If ctx
+// is done first, the response is dropped when it arrives.
+func (c *Client) SendRequest(ctx context.Context, req *tikvpb.BatchCommandsRequest_Request) (*tikvpb.BatchCommandsResponse_Response, error) {
+	e := &entry{req: req, res: make(chan result, 1)}
+	select {
+	case c.reqCh <- e:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.closed:
+		return nil, ErrClientClosed
+	}
+	select {
+	case res := <-e.res:
+		return res.resp, res.err
+	case <-ctx.Done():
+		atomic.StoreInt32(&e.canceled, 1)
+		return nil, ctx.Err()
+	case <-c.closed:
+		return nil, ErrClientClosed
+	}
+}
+
+// TransportLayerLoad returns the last transport_layer_load reported by the
+// server, so that callers can tune MaxBatchWaitTime.
+func (c *Client) TransportLayerLoad() uint64 {
+	return atomic.LoadUint64(&c.transportLayerLoad)
+}
+
+// Close stops the client and closes its stream.
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		close(c.closed)
+		stream := c.stream
+		c.stream = nil
+		c.mu.Unlock()
+		if stream != nil {
+			stream.fail(ErrClientClosed)
+		}
+		c.wg.Wait()
+	})
+}
+
+func (c *Client) batchSendLoop() {
+	defer c.wg.Done()
+	for {
+		entries := c.collect()
+		if entries == nil {
+			return
+		}
+		stream := c.getStream()
+		if stream == nil {
+			return
+		}
+		c.send(stream, entries)
+	}
+}
+
+// collect blocks for the first request, then takes whatever else is waiting
+// up to MaxBatchSize. When the server is overloaded it also waits up to
+// MaxBatchWaitTime for the batch to fill up.
+func (c *Client) collect() []*entry {
+	var entries []*entry
+	select {
+	case e := <-c.reqCh:
+		entries = append(entries, e)
+	case <-c.closed:
+		return nil
+	}
+	var timeout <-chan time.Time
+	if c.opts.MaxBatchWaitTime > 0 && c.TransportLayerLoad() > c.opts.OverloadThreshold {
+		timeout = time.After(c.opts.MaxBatchWaitTime)
+	}
+	for len(entries) < c.opts.MaxBatchSize {
+		select {
+		case e := <-c.reqCh:
+			entries = append(entries, e)
+			continue
+		default:
+		}
+		if timeout == nil {
+			break
+		}
+		select {
+		case e := <-c.reqCh:
+			entries = append(entries, e)
+		case <-timeout:
+			timeout = nil
+		case <-c.closed:
+			return nil
+		}
+		if timeout == nil {
+			break
+		}
+	}
+	return entries
+}
+
+// getStream returns the current stream, reopening it until it succeeds or
+// the client is closed.
+func (c *Client) getStream() *batchStream {
+	for {
+		c.mu.Lock()
+		if c.stream != nil {
+			stream := c.stream
+			c.mu.Unlock()
+			return stream
+		}
+		c.mu.Unlock()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s, err := c.client.BatchCommands(ctx)
+		if err == nil {
+			stream := &batchStream{
+				Tikv_BatchCommandsClient: s,
+				cancel:                   cancel,
+				pending:                  make(map[uint64]*entry),
+			}
+			c.mu.Lock()
+			select {
+			case <-c.closed:
+				c.mu.Unlock()
+				cancel()
+				return nil
+			default:
+			}
+			c.stream = stream
+			c.mu.Unlock()
+			c.wg.Add(1)
+			go c.batchRecvLoop(stream)
+			return stream
+		}
+		cancel()
+		select {
+		case <-time.After(c.opts.ReconnectBackoff):
+		case <-c.closed:
+			return nil
+		}
+	}
+}
+
+func (c *Client) send(stream *batchStream, entries []*entry) {
+	req := &tikvpb.BatchCommandsRequest{
+		Requests:   make([]*tikvpb.BatchCommandsRequest_Request, 0, len(entries)),
+		RequestIds: make([]uint64, 0, len(entries)),
+	}
+	stream.mu.Lock()
+	if stream.err != nil {
+		stream.mu.Unlock()
+		for _, e := range entries {
+			e.res <- result{err: stream.err}
+		}
+		return
+	}
+	for _, e := range entries {
+		if e.isCanceled() {
+			continue
+		}
+		id := atomic.AddUint64(&c.nextID, 1)
+		stream.pending[id] = e
+		req.Requests = append(req.Requests, e.req)
+		req.RequestIds = append(req.RequestIds, id)
+	}
+	stream.mu.Unlock()
+	if len(req.Requests) == 0 {
+		return
+	}
+	if err := stream.Send(req); err != nil {
+		c.breakStream(stream, err)
+	}
+}
+
+func (c *Client) batchRecvLoop(stream *batchStream) {
+	defer c.wg.Done()
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			c.breakStream(stream, err)
+			return
+		}
+		atomic.StoreUint64(&c.transportLayerLoad, resp.GetTransportLayerLoad())
+		stream.mu.Lock()
+		for i, id := range resp.GetRequestIds() {
+			e, ok := stream.pending[id]
+			if !ok {
+				continue
+			}
+			delete(stream.pending, id)
+			if i < len(resp.GetResponses()) {
+				e.res <- result{resp: resp.GetResponses()[i]}
+			} else {
+				e.res <- result{err: errors.New("batchclient: response is missing")}
+			}
+		}
+		stream.mu.Unlock()
+	}
+}
+
+// breakStream fails the requests on stream and forgets it, so that the next
+// batch opens a new one.
+func (c *Client) breakStream(stream *batchStream, err error) {
+	stream.fail(err)
+	c.mu.Lock()
+	if c.stream == stream {
+		c.stream = nil
+	}
+	c.mu.Unlock()
+}
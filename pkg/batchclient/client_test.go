@@ -0,0 +1,240 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batchclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/mocktikv"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// breakableClient counts opened streams and can break the current one.
+type breakableClient struct {
+	tikvpb.TikvClient
+
+	mu      sync.Mutex
+	opened  int
+	cancels []context.CancelFunc
+}
+
+func (c *breakableClient) BatchCommands(ctx context.Context, opts ...grpc.CallOption) (tikvpb.Tikv_BatchCommandsClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	c.opened++
+	c.cancels = append(c.cancels, cancel)
+	c.mu.Unlock()
+	return c.TikvClient.BatchCommands(ctx, opts...)
+}
+
+func (c *breakableClient) breakStreams() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cancel := range c.cancels {
+		cancel()
+	}
+}
+
+func (c *breakableClient) streams() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opened
+}
+
+func newTestClient(t *testing.T, server *mocktikv.Server) (*breakableClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	tikvpb.RegisterTikvServer(s, server)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &breakableClient{TikvClient: tikvpb.NewTikvClient(conn)}, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+// newServer returns a mock TiKV serving requests without a region, since
+// the tests send them to no region in particular.
+func newServer() *mocktikv.Server {
+	server := mocktikv.NewServer(1, mocktikv.NewMemCluster(1), mocktikv.NewMVCCStore())
+	server.SetAllowNoRegion(true)
+	return server
+}
+
+func rawPut(key, value string) *tikvpb.BatchCommandsRequest_Request {
+	return &tikvpb.BatchCommandsRequest_Request{Cmd: &tikvpb.BatchCommandsRequest_Request_RawPut{
+		RawPut: &kvrpcpb.RawPutRequest{Key: []byte(key), Value: []byte(value)},
+	}}
+}
+
+func rawGet(key string) *tikvpb.BatchCommandsRequest_Request {
+	return &tikvpb.BatchCommandsRequest_Request{Cmd: &tikvpb.BatchCommandsRequest_Request_RawGet{
+		RawGet: &kvrpcpb.RawGetRequest{Key: []byte(key)},
+	}}
+}
+
+func empty(delayMs uint64) *tikvpb.BatchCommandsRequest_Request {
+	return &tikvpb.BatchCommandsRequest_Request{Cmd: &tikvpb.BatchCommandsRequest_Request_Empty{
+		Empty: &tikvpb.BatchCommandsEmptyRequest{TestId: delayMs, DelayTime: delayMs},
+	}}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	conn, cleanup := newTestClient(t, newServer())
+	defer cleanup()
+	client := NewClient(conn, Options{MaxBatchSize: 8})
+	defer client.Close()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key, value := fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i)
+			if _, err := client.SendRequest(ctx, rawPut(key, value)); err != nil {
+				errs <- err
+				return
+			}
+			resp, err := client.SendRequest(ctx, rawGet(key))
+			if err != nil {
+				errs <- err
+				return
+			}
+			if got := string(resp.GetRawGet().GetValue()); got != value {
+				errs <- fmt.Errorf("get %s: expect %s, got %s", key, value, got)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	if conn.streams() != 1 {
+		t.Fatalf("expect one stream, got %d", conn.streams())
+	}
+}
+
+func TestCancel(t *testing.T) {
+	conn, cleanup := newTestClient(t, newServer())
+	defer cleanup()
+	client := NewClient(conn, Options{})
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.SendRequest(ctx, empty(500)); err != context.DeadlineExceeded {
+		t.Fatalf("expect deadline exceeded, got %v", err)
+	}
+	// The late response of the canceled request must not reach others.
+	resp, err := client.SendRequest(context.Background(), empty(1))
+	if err != nil || resp.GetEmpty().GetTestId() != 1 {
+		t.Fatal(err, resp)
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	conn, cleanup := newTestClient(t, newServer())
+	defer cleanup()
+	client := NewClient(conn, Options{ReconnectBackoff: time.Millisecond})
+	defer client.Close()
+	ctx := context.Background()
+
+	if _, err := client.SendRequest(ctx, rawPut("k", "v")); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.SendRequest(ctx, empty(1000))
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	conn.breakStreams()
+	if err := <-done; err == nil {
+		t.Fatal("expect in-flight request to fail with the stream")
+	}
+
+	var resp *tikvpb.BatchCommandsResponse_Response
+	var err error
+	for i := 0; i < 10; i++ {
+		if resp, err = client.SendRequest(ctx, rawGet("k")); err == nil {
+			break
+		}
+	}
+	if err != nil || string(resp.GetRawGet().GetValue()) != "v" {
+		t.Fatal(err, resp)
+	}
+	if conn.streams() < 2 {
+		t.Fatalf("expect the stream to be reopened, got %d streams", conn.streams())
+	}
+}
+
+func TestTransportLayerLoad(t *testing.T) {
+	server := newServer()
+	conn, cleanup := newTestClient(t, server)
+	defer cleanup()
+	client := NewClient(conn, Options{MaxBatchWaitTime: time.Millisecond, OverloadThreshold: 10})
+	defer client.Close()
+
+	server.SetTransportLayerLoad(42)
+	if _, err := client.SendRequest(context.Background(), empty(0)); err != nil {
+		t.Fatal(err)
+	}
+	if load := client.TransportLayerLoad(); load != 42 {
+		t.Fatalf("expect load 42, got %d", load)
+	}
+	// An overloaded server makes the client hold batches back, but requests
+	// still go through.
+	var failed int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.SendRequest(context.Background(), empty(0)); err != nil {
+				atomic.AddInt32(&failed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if failed != 0 {
+		t.Fatalf("%d requests failed", failed)
+	}
+}
+
+func TestClose(t *testing.T) {
+	conn, cleanup := newTestClient(t, newServer())
+	defer cleanup()
+	client := NewClient(conn, Options{})
+	client.Close()
+	if _, err := client.SendRequest(context.Background(), empty(0)); err != ErrClientClosed {
+		t.Fatalf("expect closed error, got %v", err)
+	}
+}
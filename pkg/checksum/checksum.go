@@ -0,0 +1,48 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checksum computes the crc64-xor checksum of key-value pairs used
+// by the Checksum RPC, backup.File and backup.Schema.
+package checksum
+
+import (
+	"hash/crc64"
+)
+
+var table = crc64.MakeTable(crc64.ECMA)
+
+// Checksum is the checksum of a set of pairs. Since the CRCs of the pairs
+// are combined by xor, it does not depend on their order, and the checksums
+// of disjoint sets merge into the checksum of their union.
+type Checksum struct {
+	Crc64Xor   uint64
+	TotalKvs   uint64
+	TotalBytes uint64
+}
+
+// Update adds a pair to c.
+func (c *Checksum) Update(key, value []byte) {
+	digest := crc64.New(table)
+	digest.Write(key)
+	digest.Write(value)
+	c.Crc64Xor ^= digest.Sum64()
+	c.TotalKvs++
+	c.TotalBytes += uint64(len(key) + len(value))
+}
+
+// Merge adds the pairs of other, which must not overlap those of c.
+func (c *Checksum) Merge(other Checksum) {
+	c.Crc64Xor ^= other.Crc64Xor
+	c.TotalKvs += other.TotalKvs
+	c.TotalBytes += other.TotalBytes
+}
@@ -0,0 +1,51 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checksum
+
+import (
+	"hash/crc64"
+	"testing"
+)
+
+func TestChecksum(t *testing.T) {
+	var c Checksum
+	c.Update([]byte("key"), []byte("value"))
+	if expect := crc64.Checksum([]byte("keyvalue"), crc64.MakeTable(crc64.ECMA)); c.Crc64Xor != expect {
+		t.Fatalf("expect crc %x, got %x", expect, c.Crc64Xor)
+	}
+	if c.TotalKvs != 1 || c.TotalBytes != 8 {
+		t.Fatalf("unexpected totals %+v", c)
+	}
+
+	// The order of the pairs does not matter, and halves merge.
+	var forward, backward, left, right Checksum
+	keys := []string{"a", "b", "c", "d"}
+	for i, key := range keys {
+		forward.Update([]byte(key), []byte(key+key))
+		back := keys[len(keys)-1-i]
+		backward.Update([]byte(back), []byte(back+back))
+		if i < 2 {
+			left.Update([]byte(key), []byte(key+key))
+		} else {
+			right.Update([]byte(key), []byte(key+key))
+		}
+	}
+	left.Merge(right)
+	if forward != backward || forward != left {
+		t.Fatalf("checksums differ: %+v %+v %+v", forward, backward, left)
+	}
+	if forward.TotalKvs != 4 || forward.TotalBytes != 12 {
+		t.Fatalf("unexpected totals %+v", forward)
+	}
+}
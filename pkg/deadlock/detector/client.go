@@ -0,0 +1,235 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package detector
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+)
+
+// OwnerFunc returns a client of the current detector owner.
+type OwnerFunc func(ctx context.Context) (deadlock.DeadlockClient, error)
+
+// reconnectInterval is how long the client waits before it looks for the
+// owner again after a failure.
+const reconnectInterval = 100 * time.Millisecond
+
+type entryKey struct {
+	txn, waitForTxn, keyHash uint64
+}
+
+// Client keeps one Detect stream to the detector owner. Requests are queued
+// while the client reconnects, and the responses are dispatched to the
+// waiting transactions.
+//
+// The client remembers the entries it detected and did not clean up. After
+// it connects to an owner, it replaces the queued detections with these
+// entries, so that a new owner learns the whole state of the client. The
+// clean ups not sent yet are kept, as the owner may not have changed; a
+// clean up lost in flight is left to the TTL of the owner.
+type Client struct {
+	owner  OwnerFunc
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	notify chan struct{}
+
+	mu    sync.Mutex
+	queue []*deadlock.DeadlockRequest
+	// live maps the entries to the order they were detected in.
+	live    map[entryKey]uint64
+	seq     uint64
+	waiters map[uint64]chan *deadlock.DeadlockResponse
+}
+
+// NewClient creates a Client that finds the owner with owner, and starts
+// connecting to it.
+func NewClient(owner OwnerFunc) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Client{
+		owner:   owner,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		notify:  make(chan struct{}, 1),
+		live:    make(map[entryKey]uint64),
+		waiters: make(map[uint64]chan *deadlock.DeadlockResponse),
+	}
+	go c.run()
+	return c
+}
+
+// Close stops the client.
+func (c *Client) Close() {
+	c.cancel()
+	<-c.done
+}
+
+// Detect tells the owner that txn waits for waitForTxn on the key with
+// keyHash. The returned channel receives the response if the wait closes a
+// cycle, and nothing otherwise. A transaction waits once at a time: the
+// channel is abandoned by the next Detect or clean up of txn.
+func (c *Client) Detect(txn, waitForTxn, keyHash uint64) <-chan *deadlock.DeadlockResponse {
+	ch := make(chan *deadlock.DeadlockResponse, 1)
+	c.mu.Lock()
+	c.waiters[txn] = ch
+	key := entryKey{txn: txn, waitForTxn: waitForTxn, keyHash: keyHash}
+	if _, ok := c.live[key]; !ok {
+		c.seq++
+		c.live[key] = c.seq
+	}
+	c.enqueueLocked(deadlock.DeadlockRequestType_Detect, key)
+	c.mu.Unlock()
+	return ch
+}
+
+// CleanUpWaitFor tells the owner that txn no longer waits for waitForTxn
+// on the key with keyHash.
+func (c *Client) CleanUpWaitFor(txn, waitForTxn, keyHash uint64) {
+	c.mu.Lock()
+	delete(c.waiters, txn)
+	key := entryKey{txn: txn, waitForTxn: waitForTxn, keyHash: keyHash}
+	delete(c.live, key)
+	c.enqueueLocked(deadlock.DeadlockRequestType_CleanUpWaitFor, key)
+	c.mu.Unlock()
+}
+
+// CleanUp tells the owner that txn waits for nothing, as it has finished.
+func (c *Client) CleanUp(txn uint64) {
+	c.mu.Lock()
+	delete(c.waiters, txn)
+	for key := range c.live {
+		if key.txn == txn {
+			delete(c.live, key)
+		}
+	}
+	c.enqueueLocked(deadlock.DeadlockRequestType_CleanUp, entryKey{txn: txn})
+	c.mu.Unlock()
+}
+
+func (c *Client) enqueueLocked(tp deadlock.DeadlockRequestType, key entryKey) {
+	c.queue = append(c.queue, newRequest(tp, key))
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func newRequest(tp deadlock.DeadlockRequestType, key entryKey) *deadlock.DeadlockRequest {
+	return &deadlock.DeadlockRequest{
+		Tp:    tp,
+		Entry: deadlock.WaitForEntry{Txn: key.txn, WaitForTxn: key.waitForTxn, KeyHash: key.keyHash},
+	}
+}
+
+// resendLocked replaces the queued detections with the live entries in
+// detection order, after the queued clean ups.
+func (c *Client) resendLocked() {
+	keys := make([]entryKey, 0, len(c.live))
+	for key := range c.live {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return c.live[keys[i]] < c.live[keys[j]] })
+	var cleanUps []*deadlock.DeadlockRequest
+	for _, req := range c.queue {
+		if req.GetTp() != deadlock.DeadlockRequestType_Detect {
+			cleanUps = append(cleanUps, req)
+		}
+	}
+	c.queue = cleanUps
+	for _, key := range keys {
+		c.enqueueLocked(deadlock.DeadlockRequestType_Detect, key)
+	}
+}
+
+// dispatch hands resp to the transaction waiting for it.
+func (c *Client) dispatch(resp *deadlock.DeadlockResponse) {
+	entry := resp.GetEntry()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// The owner did not add the entry closing the cycle.
+	delete(c.live, entryKey{txn: entry.GetTxn(), waitForTxn: entry.GetWaitForTxn(), keyHash: entry.GetKeyHash()})
+	if ch, ok := c.waiters[entry.GetTxn()]; ok {
+		delete(c.waiters, entry.GetTxn())
+		ch <- resp
+	}
+}
+
+func (c *Client) run() {
+	defer close(c.done)
+	for {
+		c.serve()
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// serve connects to the owner and sends the queued requests until the
+// stream fails.
+func (c *Client) serve() error {
+	client, err := c.owner(c.ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+	stream, err := client.Detect(ctx)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.resendLocked()
+	c.mu.Unlock()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			c.dispatch(resp)
+		}
+	}()
+	for {
+		select {
+		case <-c.notify:
+		case err = <-recvErr:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		c.mu.Lock()
+		reqs := c.queue
+		c.queue = nil
+		c.mu.Unlock()
+		for i, req := range reqs {
+			if err = stream.Send(req); err != nil {
+				// Put back what was not sent, for the next stream.
+				c.mu.Lock()
+				c.queue = append(reqs[i:], c.queue...)
+				c.mu.Unlock()
+				return err
+			}
+		}
+	}
+}
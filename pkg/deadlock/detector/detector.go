@@ -0,0 +1,228 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package detector is a reference implementation of the deadlock detector
+// of TiKV: a wait-for graph between pessimistic transactions, served as
+// deadlock.DeadlockServer.
+package detector
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+)
+
+// DefaultTTL is how long a wait-for entry lives after it was last detected.
+const DefaultTTL = 3 * time.Second
+
+// waitFor is the edge from a transaction to one it waits for.
+type waitFor struct {
+	// keyHashes are the keys the transaction waits for, in detection order.
+	keyHashes []uint64
+	detectAt  time.Time
+}
+
+// Detector is a wait-for graph that finds deadlocks. Entries expire after a
+// TTL, so that the graph forgets waits whose clean up was lost. It is safe
+// for concurrent use.
+type Detector struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	now   func() time.Time
+	graph map[uint64]map[uint64]*waitFor
+	// size is the number of edges, and lastExpire the time all expired
+	// edges were last removed.
+	size       int
+	lastExpire time.Time
+}
+
+// NewDetector creates a Detector. A ttl of 0 means DefaultTTL.
+func NewDetector(ttl time.Duration) *Detector {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Detector{
+		ttl:   ttl,
+		now:   time.Now,
+		graph: make(map[uint64]map[uint64]*waitFor),
+	}
+}
+
+// SetClock replaces the clock the TTL is counted with, for tests.
+func (d *Detector) SetClock(now func() time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.now = now
+	d.lastExpire = now()
+}
+
+// Detect checks whether txn waiting for waitForTxn on the key with keyHash
+// closes a cycle. If it does, it returns the key hash on the edge into txn
+// closing the cycle, which is a key txn holds, and the entry is not added.
+// Otherwise the entry is added, or refreshed if it exists.
+func (d *Detector) Detect(txn, waitForTxn, keyHash uint64) (deadlockKeyHash uint64, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	if now.Sub(d.lastExpire) >= d.ttl {
+		d.expire(now)
+	}
+	if deadlockKeyHash, ok = d.findPath(waitForTxn, txn, now); ok {
+		return deadlockKeyHash, true
+	}
+	edges := d.graph[txn]
+	if edges == nil {
+		edges = make(map[uint64]*waitFor)
+		d.graph[txn] = edges
+	}
+	e := edges[waitForTxn]
+	if e == nil {
+		e = &waitFor{}
+		edges[waitForTxn] = e
+		d.size++
+	}
+	e.detectAt = now
+	for _, h := range e.keyHashes {
+		if h == keyHash {
+			return 0, false
+		}
+	}
+	e.keyHashes = append(e.keyHashes, keyHash)
+	return 0, false
+}
+
+// findPath searches a path of live edges from start to target, and returns
+// the key hash of the last edge of the path. Expired edges on the way are
+// removed.
+func (d *Detector) findPath(start, target uint64, now time.Time) (uint64, bool) {
+	if start == target {
+		// A transaction waiting for itself is not a deadlock.
+		return 0, false
+	}
+	visited := map[uint64]bool{start: true}
+	stack := []uint64{start}
+	for len(stack) > 0 {
+		txn := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for next, e := range d.graph[txn] {
+			if d.expired(e, now) {
+				d.removeEdge(txn, next)
+				continue
+			}
+			if next == target {
+				return e.keyHashes[0], true
+			}
+			if !visited[next] {
+				visited[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return 0, false
+}
+
+func (d *Detector) expired(e *waitFor, now time.Time) bool {
+	return now.Sub(e.detectAt) >= d.ttl
+}
+
+// CleanUpWaitFor removes the wait of txn for waitForTxn on keyHash.
+func (d *Detector) CleanUpWaitFor(txn, waitForTxn, keyHash uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e := d.graph[txn][waitForTxn]
+	if e == nil {
+		return
+	}
+	for i, h := range e.keyHashes {
+		if h == keyHash {
+			e.keyHashes = append(e.keyHashes[:i], e.keyHashes[i+1:]...)
+			break
+		}
+	}
+	if len(e.keyHashes) == 0 {
+		d.removeEdge(txn, waitForTxn)
+	}
+}
+
+// CleanUp removes all waits of txn.
+func (d *Detector) CleanUp(txn uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.size -= len(d.graph[txn])
+	delete(d.graph, txn)
+}
+
+func (d *Detector) removeEdge(txn, waitForTxn uint64) {
+	edges := d.graph[txn]
+	if _, ok := edges[waitForTxn]; !ok {
+		return
+	}
+	delete(edges, waitForTxn)
+	d.size--
+	if len(edges) == 0 {
+		delete(d.graph, txn)
+	}
+}
+
+// clear removes all edges.
+func (d *Detector) clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.graph = make(map[uint64]map[uint64]*waitFor)
+	d.size = 0
+}
+
+// expire removes all expired edges.
+func (d *Detector) expire(now time.Time) {
+	for txn, edges := range d.graph {
+		for waitForTxn, e := range edges {
+			if d.expired(e, now) {
+				d.removeEdge(txn, waitForTxn)
+			}
+		}
+	}
+	d.lastExpire = now
+}
+
+// Len returns the number of edges between transactions, expired or not.
+func (d *Detector) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.size
+}
+
+// Entries returns the live entries of the graph, one for each key a
+// transaction waits for, ordered by txn, wait_for_txn and detection.
+func (d *Detector) Entries() []deadlock.WaitForEntry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	d.expire(now)
+	var entries []deadlock.WaitForEntry
+	for txn, edges := range d.graph {
+		for waitForTxn, e := range edges {
+			for _, h := range e.keyHashes {
+				entries = append(entries, deadlock.WaitForEntry{Txn: txn, WaitForTxn: waitForTxn, KeyHash: h})
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Txn != entries[j].Txn {
+			return entries[i].Txn < entries[j].Txn
+		}
+		return entries[i].WaitForTxn < entries[j].WaitForTxn
+	})
+	return entries
+}
@@ -0,0 +1,284 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package detector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func formatEntries(entries []deadlock.WaitForEntry) string {
+	var s []string
+	for _, e := range entries {
+		s = append(s, formatEntry(e))
+	}
+	return strings.Join(s, " ")
+}
+
+func formatEntry(e deadlock.WaitForEntry) string {
+	return fmt.Sprintf("%d->%d:%d", e.GetTxn(), e.GetWaitForTxn(), e.GetKeyHash())
+}
+
+func TestDetect(t *testing.T) {
+	d := NewDetector(time.Second)
+	now := time.Unix(1000, 0)
+	d.SetClock(func() time.Time { return now })
+
+	mustDetect := func(txn, waitForTxn, keyHash, expect uint64) {
+		t.Helper()
+		keyHash, ok := d.Detect(txn, waitForTxn, keyHash)
+		if ok != (expect != 0) || keyHash != expect {
+			t.Fatalf("detect %d->%d: expect deadlock on %d, got %d %v", txn, waitForTxn, expect, keyHash, ok)
+		}
+	}
+	mustDetect(1, 2, 10, 0)
+	mustDetect(2, 3, 20, 0)
+	mustDetect(2, 3, 21, 0)
+	// 3 holds the keys 2 waits for.
+	mustDetect(3, 1, 30, 20)
+	mustDetect(1, 1, 11, 0)
+	if d.Len() != 3 {
+		t.Fatalf("expect 3 edges, got %d", d.Len())
+	}
+
+	d.CleanUpWaitFor(2, 3, 20)
+	mustDetect(3, 1, 30, 21)
+	d.CleanUpWaitFor(2, 3, 21)
+	mustDetect(3, 1, 30, 0)
+	mustDetect(4, 3, 40, 0)
+	if got := formatEntries(d.Entries()); got != "1->1:11 1->2:10 3->1:30 4->3:40" {
+		t.Fatalf("unexpected entries %s", got)
+	}
+	d.CleanUp(1)
+	mustDetect(2, 4, 22, 0)
+	if d.Len() != 3 {
+		t.Fatalf("expect 3 edges, got %d", d.Len())
+	}
+
+	// Edges expire a TTL after they were last detected.
+	now = now.Add(600 * time.Millisecond)
+	mustDetect(3, 1, 30, 0)
+	now = now.Add(600 * time.Millisecond)
+	if got := formatEntries(d.Entries()); got != "3->1:30" {
+		t.Fatalf("unexpected entries %s", got)
+	}
+	mustDetect(1, 2, 12, 0)
+	mustDetect(4, 3, 41, 0)
+	mustDetect(1, 4, 13, 30)
+	now = now.Add(2 * time.Second)
+	mustDetect(5, 6, 50, 0)
+	if d.Len() != 1 {
+		t.Fatalf("expect the other edges to expire, got %v", d.Entries())
+	}
+}
+
+func TestServer(t *testing.T) {
+	client, stop := startServer(t, NewServer(NewDetector(0)))
+	defer stop()
+	ctx := context.Background()
+
+	stream, err := client.Detect(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := func(tp deadlock.DeadlockRequestType, txn, waitForTxn, keyHash uint64) {
+		entry := deadlock.WaitForEntry{Txn: txn, WaitForTxn: waitForTxn, KeyHash: keyHash}
+		if err := stream.Send(&deadlock.DeadlockRequest{Tp: tp, Entry: entry}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	send(deadlock.DeadlockRequestType_Detect, 1, 2, 10)
+	send(deadlock.DeadlockRequestType_Detect, 2, 3, 20)
+	send(deadlock.DeadlockRequestType_Detect, 3, 1, 30)
+	send(deadlock.DeadlockRequestType_CleanUpWaitFor, 2, 3, 20)
+	send(deadlock.DeadlockRequestType_Detect, 2, 1, 21)
+	send(deadlock.DeadlockRequestType_CleanUp, 1, 0, 0)
+	send(deadlock.DeadlockRequestType_Detect, 2, 1, 21)
+	send(deadlock.DeadlockRequestType_Detect, 1, 2, 11)
+	for _, expect := range []string{"3->1:30/20", "2->1:21/10", "1->2:11/21"} {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprintf("%s/%d", formatEntry(resp.GetEntry()), resp.GetDeadlockKeyHash()); got != expect {
+			t.Fatalf("expect %s, got %s", expect, got)
+		}
+	}
+
+	entries, err := client.GetWaitForEntries(ctx, &deadlock.WaitForEntriesRequest{})
+	if err != nil || formatEntries(entries.GetEntries()) != "2->1:21" {
+		t.Fatal(err, entries.GetEntries())
+	}
+	if err = stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func startServer(t *testing.T, server *Server) (deadlock.DeadlockClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	deadlock.RegisterDeadlockServer(s, server)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return deadlock.NewDeadlockClient(conn), func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestClient(t *testing.T) {
+	servers := []*Server{NewServer(NewDetector(time.Minute)), NewServer(NewDetector(time.Minute))}
+	servers[1].SetOwner(false)
+	clients := make([]deadlock.DeadlockClient, len(servers))
+	stops := make([]func(), len(servers))
+	for i, server := range servers {
+		clients[i], stops[i] = startServer(t, server)
+	}
+	defer func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}()
+	var mu sync.Mutex
+	owner := 0
+	setOwner := func(i int) {
+		mu.Lock()
+		defer mu.Unlock()
+		if owner >= 0 {
+			servers[owner].SetOwner(false)
+		}
+		if owner = i; owner >= 0 {
+			servers[owner].SetOwner(true)
+		}
+	}
+	c := NewClient(func(context.Context) (deadlock.DeadlockClient, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if owner < 0 {
+			return nil, errors.New("no owner")
+		}
+		return clients[owner], nil
+	})
+	defer c.Close()
+
+	waitEntries := func(server *Server, expect string) {
+		t.Helper()
+		var got string
+		for i := 0; i < 100; i++ {
+			if got = formatEntries(server.Detector().Entries()); got == expect {
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		t.Fatalf("expect entries %s, got %s", expect, got)
+	}
+	mustDeadlock := func(ch <-chan *deadlock.DeadlockResponse, expect string) {
+		t.Helper()
+		select {
+		case resp := <-ch:
+			if got := fmt.Sprintf("%s/%d", formatEntry(resp.GetEntry()), resp.GetDeadlockKeyHash()); got != expect {
+				t.Fatalf("expect %s, got %s", expect, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expect %s, got nothing", expect)
+		}
+	}
+
+	c.Detect(1, 2, 10)
+	c.Detect(2, 3, 20)
+	mustDeadlock(c.Detect(3, 1, 30), "3->1:30/20")
+	waitEntries(servers[0], "1->2:10 2->3:20")
+
+	// The old owner refuses the next request, and the client moves its
+	// entries to the new owner.
+	setOwner(1)
+	c.Detect(4, 5, 40)
+	waitEntries(servers[1], "1->2:10 2->3:20 4->5:40")
+	mustDeadlock(c.Detect(3, 1, 31), "3->1:31/20")
+	c.CleanUp(2)
+	ch := c.Detect(3, 1, 32)
+	waitEntries(servers[1], "1->2:10 3->1:32 4->5:40")
+	if len(ch) != 0 {
+		t.Fatalf("unexpected response %v", <-ch)
+	}
+
+	// Requests are buffered while there is no owner.
+	setOwner(-1)
+	c.Detect(6, 7, 60)
+	c.CleanUp(1)
+	ch = c.Detect(7, 6, 70)
+	time.Sleep(2 * reconnectInterval)
+	setOwner(0)
+	mustDeadlock(ch, "7->6:70/60")
+	waitEntries(servers[0], "3->1:32 4->5:40 6->7:60")
+	if servers[1].Detector().Len() != 0 {
+		t.Fatal("expect the old owner to forget its entries")
+	}
+
+	// A clean up queued while the stream is broken reaches the owner, which
+	// kept its entries.
+	stops[0]()
+	c.CleanUp(4)
+	mu.Lock()
+	clients[0], stops[0] = startServer(t, servers[0])
+	mu.Unlock()
+	waitEntries(servers[0], "3->1:32 6->7:60")
+}
+
+// BenchmarkDetect detects on 100 chains of 1000 transactions, each waiting
+// for the next in its chain, so that every detection walks part of a chain:
+// a wait backwards along the chain closes a cycle, a wait forwards does not.
+func BenchmarkDetect(b *testing.B) {
+	const (
+		chains = 100
+		length = 1000
+	)
+	txn := func(chain, i int) uint64 { return uint64(chain*length + i) }
+	d := NewDetector(time.Hour)
+	for c := 0; c < chains; c++ {
+		for i := 0; i+1 < length; i++ {
+			d.Detect(txn(c, i), txn(c, i+1), 0)
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		c, i := r.Intn(chains), r.Intn(length-1)
+		j := i + 1 + r.Intn(length-1-i)
+		if _, ok := d.Detect(txn(c, j), txn(c, i), 1); !ok {
+			b.Fatalf("%d waiting for %d is not a deadlock", txn(c, j), txn(c, i))
+		}
+		if _, ok := d.Detect(txn(c, i), txn(c, j), 1); ok {
+			b.Fatalf("%d waiting for %d is a deadlock", txn(c, i), txn(c, j))
+		}
+		// Keep the chains as they are.
+		d.CleanUpWaitFor(txn(c, i), txn(c, j), 1)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package detector
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ deadlock.DeadlockServer = (*Server)(nil)
+
+// Server serves a Detector. Only the owner among the servers handles
+// Detect; the others refuse it, so that clients look for the owner again.
+type Server struct {
+	detector *Detector
+	notOwner int32
+}
+
+// NewServer creates a Server over detector. The server is the owner until
+// SetOwner says otherwise.
+func NewServer(detector *Detector) *Server {
+	return &Server{detector: detector}
+}
+
+// SetOwner makes the server the owner or not. A server that stops being the
+// owner forgets its wait-for graph, since the clients move their entries to
+// the new owner.
+func (s *Server) SetOwner(owner bool) {
+	if owner {
+		atomic.StoreInt32(&s.notOwner, 0)
+	} else if atomic.SwapInt32(&s.notOwner, 1) == 0 {
+		s.detector.clear()
+	}
+}
+
+// IsOwner reports whether the server is the owner.
+func (s *Server) IsOwner() bool {
+	return atomic.LoadInt32(&s.notOwner) == 0
+}
+
+// Detector returns the detector of the server.
+func (s *Server) Detector() *Detector {
+	return s.detector
+}
+
+// GetWaitForEntries implements deadlock.DeadlockServer.
+func (s *Server) GetWaitForEntries(ctx context.Context, req *deadlock.WaitForEntriesRequest) (*deadlock.WaitForEntriesResponse, error) {
+	return &deadlock.WaitForEntriesResponse{Entries: s.detector.Entries()}, nil
+}
+
+// Detect implements deadlock.DeadlockServer. A response is sent only for
+// the Detect requests that find a deadlock. If the server is not the owner,
+// the stream fails with FailedPrecondition at the next request.
+func (s *Server) Detect(stream deadlock.Deadlock_DetectServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !s.IsOwner() {
+			return status.Error(codes.FailedPrecondition, "detector: not the owner")
+		}
+		entry := req.GetEntry()
+		switch req.GetTp() {
+		case deadlock.DeadlockRequestType_Detect:
+			keyHash, ok := s.detector.Detect(entry.GetTxn(), entry.GetWaitForTxn(), entry.GetKeyHash())
+			if !ok {
+				continue
+			}
+			if err = stream.Send(&deadlock.DeadlockResponse{Entry: entry, DeadlockKeyHash: keyHash}); err != nil {
+				return err
+			}
+		case deadlock.DeadlockRequestType_CleanUpWaitFor:
+			s.detector.CleanUpWaitFor(entry.GetTxn(), entry.GetWaitForTxn(), entry.GetKeyHash())
+		case deadlock.DeadlockRequestType_CleanUp:
+			s.detector.CleanUp(entry.GetTxn())
+		default:
+			return status.Errorf(codes.InvalidArgument, "detector: unknown request type %v", req.GetTp())
+		}
+	}
+}
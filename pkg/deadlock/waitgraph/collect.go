@@ -0,0 +1,88 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package waitgraph
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+)
+
+// Collect asks every store of clients, keyed by address, for its wait-for
+// entries concurrently and merges them into a graph. A store that fails is
+// recorded in the errors of the graph instead of failing the collection.
+func Collect(ctx context.Context, clients map[string]deadlock.DeadlockClient) *Graph {
+	type result struct {
+		store   string
+		entries []deadlock.WaitForEntry
+		err     error
+	}
+	results := make([]result, 0, len(clients))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for store, client := range clients {
+		wg.Add(1)
+		go func(store string, client deadlock.DeadlockClient) {
+			defer wg.Done()
+			resp, err := client.GetWaitForEntries(ctx, &deadlock.WaitForEntriesRequest{})
+			mu.Lock()
+			results = append(results, result{store: store, entries: resp.GetEntries(), err: err})
+			mu.Unlock()
+		}(store, client)
+	}
+	wg.Wait()
+
+	// Merge in store order, so that the graph does not depend on timing.
+	sort.Slice(results, func(i, j int) bool { return results[i].store < results[j].store })
+	g := NewGraph()
+	for _, r := range results {
+		if r.err != nil {
+			g.AddError(r.store, r.err)
+			continue
+		}
+		g.Add(r.store, r.entries)
+	}
+	return g
+}
+
+// StoreAddrs returns the addresses of the stores of the cluster clusterID
+// that are not tombstones, in store ID order.
+func StoreAddrs(ctx context.Context, client pdpb.PDClient, clusterID uint64) ([]string, error) {
+	resp, err := client.GetAllStores(ctx, &pdpb.GetAllStoresRequest{
+		Header:                 &pdpb.RequestHeader{ClusterId: clusterID},
+		ExcludeTombstoneStores: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = kverror.FromPDError(resp.GetHeader().GetError()); err != nil {
+		return nil, err
+	}
+	stores := resp.GetStores()
+	sort.Slice(stores, func(i, j int) bool { return stores[i].GetId() < stores[j].GetId() })
+	addrs := make([]string, 0, len(stores))
+	for _, store := range stores {
+		if store.GetState() != metapb.StoreState_Tombstone {
+			addrs = append(addrs, store.GetAddress())
+		}
+	}
+	return addrs, nil
+}
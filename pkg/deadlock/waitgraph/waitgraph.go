@@ -0,0 +1,342 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package waitgraph merges the wait-for entries of the stores of a cluster
+// into one graph, finds its cycles and longest wait chains, and exports it
+// as DOT or JSON.
+package waitgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+)
+
+// Edge is the wait of a transaction for another, merged over the stores.
+type Edge struct {
+	Txn        uint64 `json:"txn"`
+	WaitForTxn uint64 `json:"wait_for_txn"`
+	// KeyHashes are the keys waited for, in the order they were reported.
+	KeyHashes []uint64 `json:"key_hashes"`
+	// Stores are the stores reporting the edge.
+	Stores []string `json:"stores"`
+}
+
+type edgeKey struct {
+	txn, waitForTxn uint64
+}
+
+// Graph is a wait-for graph between transactions.
+type Graph struct {
+	edges  map[edgeKey]*Edge
+	errors map[string]string
+}
+
+// NewGraph creates an empty Graph.
+func NewGraph() *Graph {
+	return &Graph{
+		edges:  make(map[edgeKey]*Edge),
+		errors: make(map[string]string),
+	}
+}
+
+// Add merges the entries reported by store into the graph.
+func (g *Graph) Add(store string, entries []deadlock.WaitForEntry) {
+	for _, entry := range entries {
+		key := edgeKey{txn: entry.GetTxn(), waitForTxn: entry.GetWaitForTxn()}
+		e := g.edges[key]
+		if e == nil {
+			e = &Edge{Txn: key.txn, WaitForTxn: key.waitForTxn}
+			g.edges[key] = e
+		}
+		if !containsHash(e.KeyHashes, entry.GetKeyHash()) {
+			e.KeyHashes = append(e.KeyHashes, entry.GetKeyHash())
+		}
+		if len(e.Stores) == 0 || e.Stores[len(e.Stores)-1] != store {
+			e.Stores = append(e.Stores, store)
+		}
+	}
+}
+
+func containsHash(hashes []uint64, h uint64) bool {
+	for _, x := range hashes {
+		if x == h {
+			return true
+		}
+	}
+	return false
+}
+
+// AddError records that the entries of store could not be collected.
+func (g *Graph) AddError(store string, err error) {
+	g.errors[store] = err.Error()
+}
+
+// Errors returns the errors of the stores whose entries are missing.
+func (g *Graph) Errors() map[string]string {
+	return g.errors
+}
+
+// Edges returns the edges ordered by txn and wait_for_txn.
+func (g *Graph) Edges() []*Edge {
+	edges := make([]*Edge, 0, len(g.edges))
+	for _, e := range g.edges {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Txn != edges[j].Txn {
+			return edges[i].Txn < edges[j].Txn
+		}
+		return edges[i].WaitForTxn < edges[j].WaitForTxn
+	})
+	return edges
+}
+
+// adjacency returns the sorted transactions and whom each waits for, in
+// order. A transaction waiting for itself is left out, as it is not a
+// deadlock.
+func (g *Graph) adjacency() ([]uint64, map[uint64][]uint64) {
+	adj := make(map[uint64][]uint64)
+	for _, e := range g.Edges() {
+		for _, txn := range []uint64{e.Txn, e.WaitForTxn} {
+			if _, ok := adj[txn]; !ok {
+				adj[txn] = nil
+			}
+		}
+		if e.Txn != e.WaitForTxn {
+			adj[e.Txn] = append(adj[e.Txn], e.WaitForTxn)
+		}
+	}
+	txns := make([]uint64, 0, len(adj))
+	for txn := range adj {
+		txns = append(txns, txn)
+	}
+	sort.Slice(txns, func(i, j int) bool { return txns[i] < txns[j] })
+	return txns, adj
+}
+
+// components returns the strongly connected component of each transaction,
+// numbered in reverse topological order, by Tarjan's algorithm.
+func components(txns []uint64, adj map[uint64][]uint64) map[uint64]int {
+	var (
+		index   = make(map[uint64]int)
+		low     = make(map[uint64]int)
+		onStack = make(map[uint64]bool)
+		comp    = make(map[uint64]int)
+		stack   []uint64
+		visit   func(txn uint64)
+	)
+	visit = func(txn uint64) {
+		index[txn] = len(index)
+		low[txn] = index[txn]
+		stack = append(stack, txn)
+		onStack[txn] = true
+		for _, next := range adj[txn] {
+			if _, ok := index[next]; !ok {
+				visit(next)
+				if low[next] < low[txn] {
+					low[txn] = low[next]
+				}
+			} else if onStack[next] && index[next] < low[txn] {
+				low[txn] = index[next]
+			}
+		}
+		if low[txn] != index[txn] {
+			return
+		}
+		id := len(comp)
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			comp[top] = id
+			if top == txn {
+				break
+			}
+		}
+	}
+	for _, txn := range txns {
+		if _, ok := index[txn]; !ok {
+			visit(txn)
+		}
+	}
+	return comp
+}
+
+// Cycles returns one cycle of every deadlock, that is every strongly
+// connected component of more than one transaction. The cycle is the
+// shortest through the smallest transaction of the component, starting with
+// it; each transaction waits for the next, and the last for the first.
+func (g *Graph) Cycles() [][]uint64 {
+	txns, adj := g.adjacency()
+	comp := components(txns, adj)
+	size := make(map[int]int)
+	for _, c := range comp {
+		size[c]++
+	}
+	var cycles [][]uint64
+	seen := make(map[int]bool)
+	for _, txn := range txns {
+		c := comp[txn]
+		if size[c] < 2 || seen[c] {
+			continue
+		}
+		seen[c] = true
+		cycles = append(cycles, shortestCycle(txn, adj, func(next uint64) bool { return comp[next] == c }))
+	}
+	return cycles
+}
+
+// shortestCycle searches breadth first the shortest cycle through start
+// among the transactions in the component.
+func shortestCycle(start uint64, adj map[uint64][]uint64, inComponent func(uint64) bool) []uint64 {
+	prev := map[uint64]uint64{start: start}
+	queue := []uint64{start}
+	for len(queue) > 0 {
+		txn := queue[0]
+		queue = queue[1:]
+		for _, next := range adj[txn] {
+			if next == start {
+				var cycle []uint64
+				for t := txn; t != start; t = prev[t] {
+					cycle = append(cycle, t)
+				}
+				cycle = append(cycle, start)
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+			if _, ok := prev[next]; !ok && inComponent(next) {
+				prev[next] = txn
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// Chains returns the n longest wait chains, longest first, or all of them
+// if n <= 0. Each transaction of a chain waits for the next. Waits inside a
+// deadlock are left out, so a chain starts at a transaction that nobody
+// outside its deadlock waits for, which may be deadlocked itself, and ends
+// at one that waits for nobody outside its deadlock.
+func (g *Graph) Chains(n int) [][]uint64 {
+	txns, adj := g.adjacency()
+	comp := components(txns, adj)
+	dag := make(map[uint64][]uint64)
+	waited := make(map[uint64]bool)
+	for _, txn := range txns {
+		for _, next := range adj[txn] {
+			if comp[next] != comp[txn] {
+				dag[txn] = append(dag[txn], next)
+				waited[next] = true
+			}
+		}
+	}
+	// Components are numbered in reverse topological order, so the
+	// transactions waited for come first.
+	order := append([]uint64(nil), txns...)
+	sort.SliceStable(order, func(i, j int) bool { return comp[order[i]] < comp[order[j]] })
+	depth := make(map[uint64]int)
+	succ := make(map[uint64]uint64)
+	for _, txn := range order {
+		for _, next := range dag[txn] {
+			if depth[next]+1 > depth[txn] {
+				depth[txn] = depth[next] + 1
+				succ[txn] = next
+			}
+		}
+	}
+
+	var chains [][]uint64
+	for _, txn := range txns {
+		if waited[txn] || depth[txn] == 0 {
+			continue
+		}
+		chain := []uint64{txn}
+		for t := txn; depth[t] > 0; {
+			t = succ[t]
+			chain = append(chain, t)
+		}
+		chains = append(chains, chain)
+	}
+	sort.SliceStable(chains, func(i, j int) bool { return len(chains[i]) > len(chains[j]) })
+	if n > 0 && len(chains) > n {
+		chains = chains[:n]
+	}
+	return chains
+}
+
+// Report is the graph with its analysis, as exported to JSON.
+type Report struct {
+	Edges  []*Edge           `json:"edges"`
+	Cycles [][]uint64        `json:"cycles"`
+	Chains [][]uint64        `json:"chains"`
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// Report returns the edges, cycles and the n longest chains of the graph.
+func (g *Graph) Report(n int) *Report {
+	return &Report{
+		Edges:  g.Edges(),
+		Cycles: g.Cycles(),
+		Chains: g.Chains(n),
+		Errors: g.errors,
+	}
+}
+
+// WriteJSON writes the report of the graph with the n longest chains to w.
+func (g *Graph) WriteJSON(w io.Writer, n int) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g.Report(n))
+}
+
+// WriteDOT writes the graph to w in the DOT language of Graphviz. Edges are
+// labeled with their key hashes, and the waits inside deadlocks are red.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	txns, adj := g.adjacency()
+	comp := components(txns, adj)
+	var b strings.Builder
+	b.WriteString("digraph waitfor {\n")
+	stores := make([]string, 0, len(g.errors))
+	for store := range g.errors {
+		stores = append(stores, store)
+	}
+	sort.Strings(stores)
+	for _, store := range stores {
+		fmt.Fprintf(&b, "\t// %s: %s\n", store, g.errors[store])
+	}
+	for _, txn := range txns {
+		fmt.Fprintf(&b, "\t\"%d\";\n", txn)
+	}
+	for _, e := range g.Edges() {
+		hashes := make([]string, 0, len(e.KeyHashes))
+		for _, h := range e.KeyHashes {
+			hashes = append(hashes, fmt.Sprint(h))
+		}
+		attrs := fmt.Sprintf("label=\"%s\"", strings.Join(hashes, ","))
+		if e.Txn != e.WaitForTxn && comp[e.Txn] == comp[e.WaitForTxn] {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(&b, "\t\"%d\" -> \"%d\" [%s];\n", e.Txn, e.WaitForTxn, attrs)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
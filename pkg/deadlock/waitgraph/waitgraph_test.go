@@ -0,0 +1,196 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package waitgraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/deadlock"
+	"github.com/pingcap/kvproto/pkg/deadlock/detector"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/mockpd"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func entries(s string) []deadlock.WaitForEntry {
+	var entries []deadlock.WaitForEntry
+	for _, f := range strings.Fields(s) {
+		var e deadlock.WaitForEntry
+		if _, err := fmt.Sscanf(f, "%d->%d:%d", &e.Txn, &e.WaitForTxn, &e.KeyHash); err != nil {
+			panic(err)
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestGraph(t *testing.T) {
+	g := NewGraph()
+	g.Add("a", entries("1->2:10 2->3:20 3->1:30 4->1:40 5->4:50 6->7:60 8->8:80"))
+	g.Add("b", entries("1->2:11 2->3:20 9->2:90"))
+	g.AddError("c", errors.New("unavailable"))
+
+	edges := g.Edges()
+	if len(edges) != 8 {
+		t.Fatalf("expect 8 edges, got %d", len(edges))
+	}
+	if e := edges[0]; !reflect.DeepEqual(e, &Edge{Txn: 1, WaitForTxn: 2, KeyHashes: []uint64{10, 11}, Stores: []string{"a", "b"}}) {
+		t.Fatalf("unexpected edge %+v", e)
+	}
+	if cycles := g.Cycles(); !reflect.DeepEqual(cycles, [][]uint64{{1, 2, 3}}) {
+		t.Fatalf("unexpected cycles %v", cycles)
+	}
+	if chains := g.Chains(0); !reflect.DeepEqual(chains, [][]uint64{{5, 4, 1}, {6, 7}, {9, 2}}) {
+		t.Fatalf("unexpected chains %v", chains)
+	}
+	if chains := g.Chains(1); !reflect.DeepEqual(chains, [][]uint64{{5, 4, 1}}) {
+		t.Fatalf("unexpected chains %v", chains)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"\t// c: unavailable\n",
+		"\t\"1\" -> \"2\" [label=\"10,11\", color=red];\n",
+		"\t\"4\" -> \"1\" [label=\"40\"];\n",
+		"\t\"8\" -> \"8\" [label=\"80\"];\n",
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Fatalf("expect %q in\n%s", line, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := g.WriteJSON(&buf, 2); err != nil {
+		t.Fatal(err)
+	}
+	var report Report
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&report, g.Report(2)) {
+		t.Fatalf("unexpected report %s", buf.String())
+	}
+
+	// Two deadlocks sharing no transaction.
+	g.Add("a", entries("7->6:70 9->10:91 10->9:100"))
+	if cycles := g.Cycles(); !reflect.DeepEqual(cycles, [][]uint64{{1, 2, 3}, {6, 7}, {9, 10}}) {
+		t.Fatalf("unexpected cycles %v", cycles)
+	}
+	// 9 starts a chain although 10 waits for it, inside their deadlock.
+	if chains := g.Chains(0); !reflect.DeepEqual(chains, [][]uint64{{5, 4, 1}, {9, 2}}) {
+		t.Fatalf("unexpected chains %v", chains)
+	}
+}
+
+type failingClient struct {
+	deadlock.DeadlockClient
+}
+
+func (failingClient) GetWaitForEntries(ctx context.Context, req *deadlock.WaitForEntriesRequest, opts ...grpc.CallOption) (*deadlock.WaitForEntriesResponse, error) {
+	return nil, errors.New("unavailable")
+}
+
+func serve(t *testing.T, register func(*grpc.Server)) (*grpc.ClientConn, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	register(s)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestCollect(t *testing.T) {
+	clients := map[string]deadlock.DeadlockClient{"store3": failingClient{}}
+	for i, s := range []string{"1->2:10 2->3:20", "3->1:30 2->3:21"} {
+		d := detector.NewDetector(0)
+		for _, e := range entries(s) {
+			d.Detect(e.Txn, e.WaitForTxn, e.KeyHash)
+		}
+		conn, stop := serve(t, func(s *grpc.Server) { deadlock.RegisterDeadlockServer(s, detector.NewServer(d)) })
+		defer stop()
+		clients[fmt.Sprintf("store%d", i+1)] = deadlock.NewDeadlockClient(conn)
+	}
+
+	g := Collect(context.Background(), clients)
+	if !reflect.DeepEqual(g.Errors(), map[string]string{"store3": "unavailable"}) {
+		t.Fatalf("unexpected errors %v", g.Errors())
+	}
+	if e := g.Edges()[1]; !reflect.DeepEqual(e, &Edge{Txn: 2, WaitForTxn: 3, KeyHashes: []uint64{20, 21}, Stores: []string{"store1", "store2"}}) {
+		t.Fatalf("unexpected edge %+v", e)
+	}
+	if cycles := g.Cycles(); !reflect.DeepEqual(cycles, [][]uint64{{1, 2, 3}}) {
+		t.Fatalf("unexpected cycles %v", cycles)
+	}
+}
+
+func TestStoreAddrs(t *testing.T) {
+	const clusterID = 1
+	pd := mockpd.NewServer(clusterID)
+	conn, stop := serve(t, func(s *grpc.Server) { pdpb.RegisterPDServer(s, pd) })
+	defer stop()
+	client := pdpb.NewPDClient(conn)
+	ctx := context.Background()
+	header := &pdpb.RequestHeader{ClusterId: clusterID}
+
+	if _, err := StoreAddrs(ctx, client, clusterID); err == nil {
+		t.Fatal("expect an error before bootstrap")
+	}
+	resp, err := client.Bootstrap(ctx, &pdpb.BootstrapRequest{
+		Header: header,
+		Store:  &metapb.Store{Id: 1, Address: "store1"},
+		Region: &metapb.Region{
+			Id:          2,
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+			Peers:       []*metapb.Peer{{Id: 3, StoreId: 1}},
+		},
+	})
+	if err != nil || resp.GetHeader().GetError() != nil {
+		t.Fatal(err, resp.GetHeader().GetError())
+	}
+	for _, store := range []*metapb.Store{
+		{Id: 5, Address: "store5"},
+		{Id: 4, Address: "store4", State: metapb.StoreState_Tombstone},
+		{Id: 6, Address: "store6", State: metapb.StoreState_Offline},
+	} {
+		if _, err = client.PutStore(ctx, &pdpb.PutStoreRequest{Header: header, Store: store}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addrs, err := StoreAddrs(ctx, client, clusterID)
+	if err != nil || !reflect.DeepEqual(addrs, []string{"store1", "store5", "store6"}) {
+		t.Fatal(err, addrs)
+	}
+}
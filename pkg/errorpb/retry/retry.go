@@ -0,0 +1,223 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry decides how a client should react to an errorpb.Error
+// returned in a region request.
+package retry
+
+import (
+	"math"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+)
+
+// Kind classifies an errorpb.Error by the field that is set.
+type Kind int
+
+// Kinds of region errors. KindUnknown is an error with only a message.
+const (
+	KindUnknown Kind = iota
+	KindNotLeader
+	KindRegionNotFound
+	KindKeyNotInRegion
+	KindEpochNotMatch
+	KindServerIsBusy
+	KindStaleCommand
+	KindStoreNotMatch
+	KindRaftEntryTooLarge
+)
+
+var kindNames = [...]string{
+	KindUnknown:           "Unknown",
+	KindNotLeader:         "NotLeader",
+	KindRegionNotFound:    "RegionNotFound",
+	KindKeyNotInRegion:    "KeyNotInRegion",
+	KindEpochNotMatch:     "EpochNotMatch",
+	KindServerIsBusy:      "ServerIsBusy",
+	KindStaleCommand:      "StaleCommand",
+	KindStoreNotMatch:     "StoreNotMatch",
+	KindRaftEntryTooLarge: "RaftEntryTooLarge",
+}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return "Unknown"
+	}
+	return kindNames[k]
+}
+
+// Classify returns the kind of err. The first set field wins, in the order
+// of the fields in errorpb.Error.
+func Classify(err *errorpb.Error) Kind {
+	switch {
+	case err.GetNotLeader() != nil:
+		return KindNotLeader
+	case err.GetRegionNotFound() != nil:
+		return KindRegionNotFound
+	case err.GetKeyNotInRegion() != nil:
+		return KindKeyNotInRegion
+	case err.GetEpochNotMatch() != nil:
+		return KindEpochNotMatch
+	case err.GetServerIsBusy() != nil:
+		return KindServerIsBusy
+	case err.GetStaleCommand() != nil:
+		return KindStaleCommand
+	case err.GetStoreNotMatch() != nil:
+		return KindStoreNotMatch
+	case err.GetRaftEntryTooLarge() != nil:
+		return KindRaftEntryTooLarge
+	}
+	return KindUnknown
+}
+
+// Action is what the caller should do before sending the request again.
+type Action int
+
+// Actions, from giving up to retrying as is.
+const (
+	// ActionFail means the request must not be retried.
+	ActionFail Action = iota
+	// ActionRetry means the request can be sent to the same peer again.
+	ActionRetry
+	// ActionSwitchLeader means the request should go to Decision.Leader.
+	ActionSwitchLeader
+	// ActionRefreshRegion means the cached region is stale and should be
+	// replaced by Decision.Regions, or reloaded from PD when it is empty.
+	ActionRefreshRegion
+	// ActionBackoff means the request should be sent to the same peer again
+	// after Decision.Backoff.
+	ActionBackoff
+)
+
+var actionNames = [...]string{
+	ActionFail:          "Fail",
+	ActionRetry:         "Retry",
+	ActionSwitchLeader:  "SwitchLeader",
+	ActionRefreshRegion: "RefreshRegion",
+	ActionBackoff:       "Backoff",
+}
+
+func (a Action) String() string {
+	if a < 0 || int(a) >= len(actionNames) {
+		return "Unknown"
+	}
+	return actionNames[a]
+}
+
+// Decision is the structured reaction to a region error.
+type Decision struct {
+	Kind   Kind
+	Action Action
+	// Leader is the new leader for ActionSwitchLeader.
+	Leader *metapb.Peer
+	// Regions are the regions reported by EpochNotMatch.
+	Regions []*metapb.Region
+	// Backoff is how long to wait before retrying.
+	Backoff time.Duration
+	// Reason is the message of the error.
+	Reason string
+}
+
+// BackoffPolicy limits retries and spaces them out.
+type BackoffPolicy interface {
+	// Backoff returns the delay before retry number attempt, counting from
+	// 1, after an error of kind. It returns false if the caller should give
+	// up instead.
+	Backoff(kind Kind, attempt int) (time.Duration, bool)
+}
+
+// BackoffFunc adapts a function to a BackoffPolicy.
+type BackoffFunc func(kind Kind, attempt int) (time.Duration, bool)
+
+// Backoff implements BackoffPolicy.
+func (f BackoffFunc) Backoff(kind Kind, attempt int) (time.Duration, bool) {
+	return f(kind, attempt)
+}
+
+// ExponentialBackoff doubles the delay from Base on every attempt up to Max,
+// and gives up after MaxAttempts retries. A MaxAttempts <= 0 never gives up.
+type ExponentialBackoff struct {
+	Base        time.Duration
+	Max         time.Duration
+	MaxAttempts int
+}
+
+// Backoff implements BackoffPolicy.
+func (b ExponentialBackoff) Backoff(kind Kind, attempt int) (time.Duration, bool) {
+	if b.MaxAttempts > 0 && attempt > b.MaxAttempts {
+		return 0, false
+	}
+	d := b.Base
+	for i := 1; i < attempt && (b.Max <= 0 || d < b.Max) && d < math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	return d, true
+}
+
+// DefaultBackoff is the policy used when Decide is given none.
+var DefaultBackoff BackoffPolicy = ExponentialBackoff{
+	Base:        2 * time.Millisecond,
+	Max:         500 * time.Millisecond,
+	MaxAttempts: 20,
+}
+
+// Decide returns how to react to err on retry number attempt, counting from
+// 1. Only ActionBackoff waits for the policy's delay; every other action is
+// immediate, but all of them fail once the policy gives up. A nil policy
+// means DefaultBackoff, and a nil err yields ActionFail.
+func Decide(err *errorpb.Error, attempt int, policy BackoffPolicy) Decision {
+	if policy == nil {
+		policy = DefaultBackoff
+	}
+	kind := Classify(err)
+	d := Decision{Kind: kind, Reason: err.GetMessage()}
+	if err == nil {
+		return d
+	}
+	switch kind {
+	case KindNotLeader:
+		if leader := err.GetNotLeader().GetLeader(); leader != nil {
+			d.Action, d.Leader = ActionSwitchLeader, leader
+		} else {
+			// The region is electing a new leader.
+			d.Action = ActionBackoff
+		}
+	case KindRegionNotFound, KindKeyNotInRegion, KindStoreNotMatch, KindUnknown:
+		d.Action = ActionRefreshRegion
+	case KindEpochNotMatch:
+		d.Action, d.Regions = ActionRefreshRegion, err.GetEpochNotMatch().GetCurrentRegions()
+	case KindServerIsBusy:
+		d.Action = ActionBackoff
+	case KindStaleCommand:
+		d.Action = ActionRetry
+	case KindRaftEntryTooLarge:
+		// Retrying the same request can never succeed.
+		return d
+	}
+	backoff, ok := policy.Backoff(kind, attempt)
+	if !ok {
+		return Decision{Kind: kind, Action: ActionFail, Reason: d.Reason}
+	}
+	if d.Action == ActionBackoff {
+		d.Backoff = backoff
+		if busy := time.Duration(err.GetServerIsBusy().GetBackoffMs()) * time.Millisecond; busy > d.Backoff {
+			d.Backoff = busy
+		}
+	}
+	return d
+}
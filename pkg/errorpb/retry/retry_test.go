@@ -0,0 +1,163 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+)
+
+var testPolicy = ExponentialBackoff{Base: 10 * time.Millisecond, Max: 40 * time.Millisecond, MaxAttempts: 3}
+
+var decideTests = []struct {
+	field   string
+	err     *errorpb.Error
+	attempt int
+	expect  Decision
+}{
+	{
+		field:   "Message",
+		err:     &errorpb.Error{Message: "unknown"},
+		attempt: 1,
+		expect:  Decision{Kind: KindUnknown, Action: ActionRefreshRegion, Reason: "unknown"},
+	},
+	{
+		field:   "NotLeader",
+		err:     &errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 1, Leader: &metapb.Peer{Id: 2, StoreId: 3}}},
+		attempt: 1,
+		expect:  Decision{Kind: KindNotLeader, Action: ActionSwitchLeader, Leader: &metapb.Peer{Id: 2, StoreId: 3}},
+	},
+	{
+		field:   "NotLeader",
+		err:     &errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 1}},
+		attempt: 2,
+		expect:  Decision{Kind: KindNotLeader, Action: ActionBackoff, Backoff: 20 * time.Millisecond},
+	},
+	{
+		field:   "RegionNotFound",
+		err:     &errorpb.Error{RegionNotFound: &errorpb.RegionNotFound{RegionId: 1}},
+		attempt: 1,
+		expect:  Decision{Kind: KindRegionNotFound, Action: ActionRefreshRegion},
+	},
+	{
+		field:   "KeyNotInRegion",
+		err:     &errorpb.Error{KeyNotInRegion: &errorpb.KeyNotInRegion{Key: []byte("k"), RegionId: 1}},
+		attempt: 1,
+		expect:  Decision{Kind: KindKeyNotInRegion, Action: ActionRefreshRegion},
+	},
+	{
+		field:   "EpochNotMatch",
+		err:     &errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{CurrentRegions: []*metapb.Region{{Id: 1}, {Id: 4}}}},
+		attempt: 1,
+		expect:  Decision{Kind: KindEpochNotMatch, Action: ActionRefreshRegion, Regions: []*metapb.Region{{Id: 1}, {Id: 4}}},
+	},
+	{
+		field:   "EpochNotMatch",
+		err:     &errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{}},
+		attempt: 1,
+		expect:  Decision{Kind: KindEpochNotMatch, Action: ActionRefreshRegion},
+	},
+	{
+		field:   "ServerIsBusy",
+		err:     &errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{Reason: "busy", BackoffMs: 100}},
+		attempt: 1,
+		expect:  Decision{Kind: KindServerIsBusy, Action: ActionBackoff, Backoff: 100 * time.Millisecond},
+	},
+	{
+		field:   "ServerIsBusy",
+		err:     &errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{Reason: "busy"}},
+		attempt: 3,
+		expect:  Decision{Kind: KindServerIsBusy, Action: ActionBackoff, Backoff: 40 * time.Millisecond},
+	},
+	{
+		field:   "StaleCommand",
+		err:     &errorpb.Error{StaleCommand: &errorpb.StaleCommand{}},
+		attempt: 1,
+		expect:  Decision{Kind: KindStaleCommand, Action: ActionRetry},
+	},
+	{
+		field:   "StoreNotMatch",
+		err:     &errorpb.Error{StoreNotMatch: &errorpb.StoreNotMatch{RequestStoreId: 1, ActualStoreId: 2}},
+		attempt: 1,
+		expect:  Decision{Kind: KindStoreNotMatch, Action: ActionRefreshRegion},
+	},
+	{
+		field:   "RaftEntryTooLarge",
+		err:     &errorpb.Error{RaftEntryTooLarge: &errorpb.RaftEntryTooLarge{RegionId: 1, EntrySize: 1 << 30}},
+		attempt: 1,
+		expect:  Decision{Kind: KindRaftEntryTooLarge, Action: ActionFail},
+	},
+	{
+		field:   "StaleCommand",
+		err:     &errorpb.Error{StaleCommand: &errorpb.StaleCommand{}},
+		attempt: 4,
+		expect:  Decision{Kind: KindStaleCommand, Action: ActionFail},
+	},
+}
+
+func TestDecide(t *testing.T) {
+	for i, c := range decideTests {
+		if d := Decide(c.err, c.attempt, testPolicy); !reflect.DeepEqual(d, c.expect) {
+			t.Fatalf("#%d %s: expect %+v, got %+v", i, c.field, c.expect, d)
+		}
+	}
+}
+
+func TestDecideCoversAllFields(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, c := range decideTests {
+		covered[c.field] = true
+	}
+	typ := reflect.TypeOf(errorpb.Error{})
+	for i := 0; i < typ.NumField(); i++ {
+		name := typ.Field(i).Name
+		if !strings.HasPrefix(name, "XXX_") && !covered[name] {
+			t.Fatalf("errorpb.Error.%s is not covered", name)
+		}
+	}
+}
+
+func TestDecideNil(t *testing.T) {
+	if d := Decide(nil, 1, nil); d.Action != ActionFail {
+		t.Fatalf("expect fail for nil error, got %v", d.Action)
+	}
+}
+
+func TestBackoffPolicy(t *testing.T) {
+	var kinds []Kind
+	policy := BackoffFunc(func(kind Kind, attempt int) (time.Duration, bool) {
+		kinds = append(kinds, kind)
+		return time.Second, attempt < 2
+	})
+	busy := &errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{}}
+	if d := Decide(busy, 1, policy); d.Action != ActionBackoff || d.Backoff != time.Second {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	if d := Decide(busy, 2, policy); d.Action != ActionFail {
+		t.Fatalf("expect policy to give up, got %+v", d)
+	}
+	if len(kinds) != 2 || kinds[0] != KindServerIsBusy {
+		t.Fatalf("unexpected policy calls %v", kinds)
+	}
+
+	unlimited := ExponentialBackoff{Base: time.Millisecond}
+	if d, ok := unlimited.Backoff(KindServerIsBusy, 100); !ok || d <= 0 {
+		t.Fatalf("expect unlimited backoff, got %v %v", d, ok)
+	}
+}
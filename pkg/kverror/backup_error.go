@@ -0,0 +1,132 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kverror
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/import_kvpb"
+)
+
+// BackupError is a backup.Error. It matches ErrBackup, ErrClusterIDMismatch
+// for a cluster ID error, and unwraps to the key or region error it carries.
+type BackupError struct {
+	Err *backup.Error
+}
+
+func (e *BackupError) Error() string {
+	var detail string
+	switch {
+	case e.Err.GetClusterIdError() != nil:
+		detail = fmt.Sprintf("%v: current=%d, request=%d", ErrClusterIDMismatch,
+			e.Err.GetClusterIdError().GetCurrent(), e.Err.GetClusterIdError().GetRequest())
+	case e.Unwrap() != nil:
+		detail = e.Unwrap().Error()
+	}
+	switch {
+	case detail == "":
+		return fmt.Sprintf("%v: %s", ErrBackup, e.Err.GetMsg())
+	case e.Err.GetMsg() == "":
+		return fmt.Sprintf("%v: %s", ErrBackup, detail)
+	}
+	return fmt.Sprintf("%v: %s: %s", ErrBackup, e.Err.GetMsg(), detail)
+}
+
+// Is reports whether target is ErrBackup or ErrClusterIDMismatch.
+func (e *BackupError) Is(target error) bool {
+	return target == ErrBackup || (target == ErrClusterIDMismatch && e.Err.GetClusterIdError() != nil)
+}
+
+// Unwrap returns the key or region error carried by e.
+func (e *BackupError) Unwrap() error {
+	if ke := e.Err.GetKvError(); ke != nil {
+		return FromKeyError(ke)
+	}
+	return FromRegionError(e.Err.GetRegionError())
+}
+
+// FromBackupError returns the Go error for e, or nil if e is nil.
+func FromBackupError(e *backup.Error) error {
+	if e == nil {
+		return nil
+	}
+	return &BackupError{e}
+}
+
+// ToBackupError returns the proto form of err, or nil if err is nil. Key and
+// region errors are kept as details.
+func ToBackupError(err error) *backup.Error {
+	if err == nil {
+		return nil
+	}
+	var e *BackupError
+	if errors.As(err, &e) {
+		return e.Err
+	}
+	be := &backup.Error{Msg: err.Error()}
+	var region *RegionError
+	if errors.As(err, &region) {
+		be.Detail = &backup.Error_RegionError{RegionError: region.Err}
+	} else if errors.As(err, new(keyErrorer)) {
+		be.Detail = &backup.Error_KvError{KvError: ToKeyError(err)}
+	}
+	return be
+}
+
+// ImportError is an import_kvpb.Error. It matches ErrImport, and
+// ErrEngineNotFound if the engine is missing.
+type ImportError struct {
+	Err *import_kvpb.Error
+}
+
+func (e *ImportError) Error() string {
+	if nf := e.Err.GetEngineNotFound(); nf != nil {
+		return fmt.Sprintf("%v: %v: uuid=%x", ErrImport, ErrEngineNotFound, nf.GetUuid())
+	}
+	return ErrImport.Error()
+}
+
+// Is reports whether target is ErrImport or ErrEngineNotFound.
+func (e *ImportError) Is(target error) bool {
+	return target == ErrImport || (target == ErrEngineNotFound && e.Err.GetEngineNotFound() != nil)
+}
+
+// FromImportError returns the Go error for e, or nil if e is nil.
+func FromImportError(e *import_kvpb.Error) error {
+	if e == nil {
+		return nil
+	}
+	return &ImportError{e}
+}
+
+// ToImportError returns the proto form of err, or nil if err is nil. The
+// proto has no field for an error of any other kind, so it becomes an empty
+// import_kvpb.Error.
+func ToImportError(err error) *import_kvpb.Error {
+	if err == nil {
+		return nil
+	}
+	var e *ImportError
+	if errors.As(err, &e) {
+		return e.Err
+	}
+	return &import_kvpb.Error{}
+}
+
+// NewEngineNotFound returns the error for a missing import engine.
+func NewEngineNotFound(uuid []byte) error {
+	return &ImportError{&import_kvpb.Error{EngineNotFound: &import_kvpb.Error_EngineNotFound{Uuid: uuid}}}
+}
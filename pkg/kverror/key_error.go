@@ -0,0 +1,152 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kverror
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+)
+
+// keyErrorer is implemented by the errors that have a kvrpcpb.KeyError form.
+type keyErrorer interface {
+	KeyError() *kvrpcpb.KeyError
+}
+
+// LockedError is a kvrpcpb.KeyError with Locked set.
+type LockedError struct {
+	*kvrpcpb.LockInfo
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%v: key=%q, primary=%q, start_ts=%d, ttl=%d, txn_size=%d",
+		ErrLocked, e.GetKey(), e.GetPrimaryLock(), e.GetLockVersion(), e.GetLockTtl(), e.GetTxnSize())
+}
+
+// Is reports whether target is ErrLocked.
+func (e *LockedError) Is(target error) bool { return target == ErrLocked }
+
+// KeyError returns the proto form of e.
+func (e *LockedError) KeyError() *kvrpcpb.KeyError { return &kvrpcpb.KeyError{Locked: e.LockInfo} }
+
+// WriteConflictError is a kvrpcpb.KeyError with Conflict set.
+type WriteConflictError struct {
+	*kvrpcpb.WriteConflict
+}
+
+func (e *WriteConflictError) Error() string {
+	return fmt.Sprintf("%v: start_ts=%d, conflict_ts=%d, conflict_commit_ts=%d, key=%q, primary=%q",
+		ErrWriteConflict, e.GetStartTs(), e.GetConflictTs(), e.GetConflictCommitTs(), e.GetKey(), e.GetPrimary())
+}
+
+// Is reports whether target is ErrWriteConflict.
+func (e *WriteConflictError) Is(target error) bool { return target == ErrWriteConflict }
+
+// KeyError returns the proto form of e.
+func (e *WriteConflictError) KeyError() *kvrpcpb.KeyError {
+	return &kvrpcpb.KeyError{Conflict: e.WriteConflict}
+}
+
+// AlreadyExistError is a kvrpcpb.KeyError with AlreadyExist set.
+type AlreadyExistError struct {
+	*kvrpcpb.AlreadyExist
+}
+
+func (e *AlreadyExistError) Error() string {
+	return fmt.Sprintf("%v: key=%q", ErrAlreadyExist, e.GetKey())
+}
+
+// Is reports whether target is ErrAlreadyExist.
+func (e *AlreadyExistError) Is(target error) bool { return target == ErrAlreadyExist }
+
+// KeyError returns the proto form of e.
+func (e *AlreadyExistError) KeyError() *kvrpcpb.KeyError {
+	return &kvrpcpb.KeyError{AlreadyExist: e.AlreadyExist}
+}
+
+// DeadlockError is a kvrpcpb.KeyError with Deadlock set.
+type DeadlockError struct {
+	*kvrpcpb.Deadlock
+}
+
+func (e *DeadlockError) Error() string {
+	return fmt.Sprintf("%v: lock_ts=%d, lock_key=%q, deadlock_key_hash=%d",
+		ErrDeadlock, e.GetLockTs(), e.GetLockKey(), e.GetDeadlockKeyHash())
+}
+
+// Is reports whether target is ErrDeadlock.
+func (e *DeadlockError) Is(target error) bool { return target == ErrDeadlock }
+
+// KeyError returns the proto form of e.
+func (e *DeadlockError) KeyError() *kvrpcpb.KeyError { return &kvrpcpb.KeyError{Deadlock: e.Deadlock} }
+
+// RetryableError is a kvrpcpb.KeyError with only Retryable set.
+type RetryableError struct {
+	Msg string
+}
+
+func (e *RetryableError) Error() string { return fmt.Sprintf("%v: %s", ErrRetryable, e.Msg) }
+
+// Is reports whether target is ErrRetryable.
+func (e *RetryableError) Is(target error) bool { return target == ErrRetryable }
+
+// KeyError returns the proto form of e.
+func (e *RetryableError) KeyError() *kvrpcpb.KeyError { return &kvrpcpb.KeyError{Retryable: e.Msg} }
+
+// AbortError is a kvrpcpb.KeyError with only Abort set.
+type AbortError struct {
+	Msg string
+}
+
+func (e *AbortError) Error() string { return fmt.Sprintf("%v: %s", ErrAbort, e.Msg) }
+
+// Is reports whether target is ErrAbort.
+func (e *AbortError) Is(target error) bool { return target == ErrAbort }
+
+// KeyError returns the proto form of e.
+func (e *AbortError) KeyError() *kvrpcpb.KeyError { return &kvrpcpb.KeyError{Abort: e.Msg} }
+
+// FromKeyError returns the Go error for e, or nil if e is nil. The kind is
+// picked from the most specific field set.
+func FromKeyError(e *kvrpcpb.KeyError) error {
+	switch {
+	case e == nil:
+		return nil
+	case e.GetLocked() != nil:
+		return &LockedError{e.GetLocked()}
+	case e.GetConflict() != nil:
+		return &WriteConflictError{e.GetConflict()}
+	case e.GetAlreadyExist() != nil:
+		return &AlreadyExistError{e.GetAlreadyExist()}
+	case e.GetDeadlock() != nil:
+		return &DeadlockError{e.GetDeadlock()}
+	case e.GetRetryable() != "":
+		return &RetryableError{e.GetRetryable()}
+	}
+	return &AbortError{e.GetAbort()}
+}
+
+// ToKeyError returns the proto form of err, or nil if err is nil. An error
+// of no known kind becomes an abort with its message.
+func ToKeyError(err error) *kvrpcpb.KeyError {
+	if err == nil {
+		return nil
+	}
+	var e keyErrorer
+	if errors.As(err, &e) {
+		return e.KeyError()
+	}
+	return &kvrpcpb.KeyError{Abort: err.Error()}
+}
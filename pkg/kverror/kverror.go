@@ -0,0 +1,73 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kverror wraps the error messages of the protocol into Go errors,
+// so that they work with errors.Is and errors.As, and converts them back for
+// servers.
+//
+// Every wrapper matches a sentinel of its kind with errors.Is:
+//
+//	if errors.Is(kverror.FromKeyError(resp.GetError()), kverror.ErrWriteConflict) {
+//		...
+//	}
+//
+// and errors.As gives access to the proto fields:
+//
+//	var conflict *kverror.WriteConflictError
+//	if errors.As(err, &conflict) {
+//		retryAfter(conflict.GetConflictCommitTs())
+//	}
+package kverror
+
+import "errors"
+
+// Sentinels for kvrpcpb.KeyError.
+var (
+	ErrLocked        = errors.New("key is locked")
+	ErrRetryable     = errors.New("retryable")
+	ErrAbort         = errors.New("abort")
+	ErrWriteConflict = errors.New("write conflict")
+	ErrAlreadyExist  = errors.New("key already exists")
+	ErrDeadlock      = errors.New("deadlock")
+)
+
+// Sentinels for errorpb.Error. ErrRegion matches every region error.
+var (
+	ErrRegion            = errors.New("region error")
+	ErrNotLeader         = errors.New("not leader")
+	ErrRegionNotFound    = errors.New("region not found")
+	ErrKeyNotInRegion    = errors.New("key not in region")
+	ErrEpochNotMatch     = errors.New("epoch not match")
+	ErrServerIsBusy      = errors.New("server is busy")
+	ErrStaleCommand      = errors.New("stale command")
+	ErrStoreNotMatch     = errors.New("store not match")
+	ErrRaftEntryTooLarge = errors.New("raft entry too large")
+)
+
+// Sentinels for pdpb.Error.
+var (
+	ErrPDUnknown           = errors.New("pd: unknown error")
+	ErrNotBootstrapped     = errors.New("pd: cluster is not bootstrapped")
+	ErrStoreTombstone      = errors.New("pd: store is tombstone")
+	ErrAlreadyBootstrapped = errors.New("pd: cluster is already bootstrapped")
+	ErrIncompatibleVersion = errors.New("pd: incompatible version")
+	ErrPDRegionNotFound    = errors.New("pd: region not found")
+)
+
+// Sentinels for backup.Error and import_kvpb.Error.
+var (
+	ErrBackup            = errors.New("backup error")
+	ErrClusterIDMismatch = errors.New("cluster id mismatch")
+	ErrImport            = errors.New("import error")
+	ErrEngineNotFound    = errors.New("engine not found")
+)
@@ -0,0 +1,153 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kverror
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/import_kvpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+)
+
+func TestKeyError(t *testing.T) {
+	cases := []struct {
+		pb       *kvrpcpb.KeyError
+		sentinel error
+		msg      string
+	}{
+		{&kvrpcpb.KeyError{Locked: &kvrpcpb.LockInfo{Key: []byte("k"), PrimaryLock: []byte("p"), LockVersion: 10, LockTtl: 3000}}, ErrLocked,
+			`key is locked: key="k", primary="p", start_ts=10, ttl=3000, txn_size=0`},
+		{&kvrpcpb.KeyError{Conflict: &kvrpcpb.WriteConflict{StartTs: 10, ConflictTs: 5, ConflictCommitTs: 12, Key: []byte("k"), Primary: []byte("p")}}, ErrWriteConflict,
+			`write conflict: start_ts=10, conflict_ts=5, conflict_commit_ts=12, key="k", primary="p"`},
+		{&kvrpcpb.KeyError{AlreadyExist: &kvrpcpb.AlreadyExist{Key: []byte("k")}}, ErrAlreadyExist,
+			`key already exists: key="k"`},
+		{&kvrpcpb.KeyError{Deadlock: &kvrpcpb.Deadlock{LockTs: 10, LockKey: []byte("k"), DeadlockKeyHash: 7}}, ErrDeadlock,
+			`deadlock: lock_ts=10, lock_key="k", deadlock_key_hash=7`},
+		{&kvrpcpb.KeyError{Retryable: "try again"}, ErrRetryable, "retryable: try again"},
+		{&kvrpcpb.KeyError{Abort: "give up"}, ErrAbort, "abort: give up"},
+	}
+	for _, c := range cases {
+		err := FromKeyError(c.pb)
+		if !errors.Is(err, c.sentinel) {
+			t.Fatalf("%v does not match %v", err, c.sentinel)
+		}
+		if err.Error() != c.msg {
+			t.Fatalf("expect %q, got %q", c.msg, err.Error())
+		}
+		wrapped := fmt.Errorf("prewrite: %w", err)
+		if !errors.Is(wrapped, c.sentinel) || !reflect.DeepEqual(ToKeyError(wrapped), c.pb) {
+			t.Fatalf("%v does not round trip", wrapped)
+		}
+		for _, other := range cases {
+			if other.sentinel != c.sentinel && errors.Is(err, other.sentinel) {
+				t.Fatalf("%v matches %v", err, other.sentinel)
+			}
+		}
+	}
+
+	var conflict *WriteConflictError
+	if !errors.As(FromKeyError(cases[1].pb), &conflict) || conflict.GetConflictCommitTs() != 12 {
+		t.Fatalf("expect write conflict, got %v", conflict)
+	}
+	if FromKeyError(nil) != nil || ToKeyError(nil) != nil {
+		t.Fatal("expect nil for nil")
+	}
+	if pb := ToKeyError(errors.New("oops")); pb.GetAbort() != "oops" {
+		t.Fatalf("expect abort, got %v", pb)
+	}
+}
+
+func TestRegionError(t *testing.T) {
+	cases := []struct {
+		pb       *errorpb.Error
+		sentinel error
+	}{
+		{&errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 1, Leader: &metapb.Peer{Id: 2}}}, ErrNotLeader},
+		{&errorpb.Error{RegionNotFound: &errorpb.RegionNotFound{RegionId: 1}}, ErrRegionNotFound},
+		{&errorpb.Error{KeyNotInRegion: &errorpb.KeyNotInRegion{Key: []byte("k")}}, ErrKeyNotInRegion},
+		{&errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{}}, ErrEpochNotMatch},
+		{&errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{BackoffMs: 10}}, ErrServerIsBusy},
+		{&errorpb.Error{StaleCommand: &errorpb.StaleCommand{}}, ErrStaleCommand},
+		{&errorpb.Error{StoreNotMatch: &errorpb.StoreNotMatch{RequestStoreId: 1, ActualStoreId: 2}}, ErrStoreNotMatch},
+		{&errorpb.Error{RaftEntryTooLarge: &errorpb.RaftEntryTooLarge{EntrySize: 1}}, ErrRaftEntryTooLarge},
+	}
+	for _, c := range cases {
+		err := FromRegionError(c.pb)
+		if !errors.Is(err, c.sentinel) || !errors.Is(err, ErrRegion) {
+			t.Fatalf("%v does not match %v", err, c.sentinel)
+		}
+		if ToRegionError(fmt.Errorf("get: %w", err)) != c.pb {
+			t.Fatalf("%v does not round trip", err)
+		}
+	}
+	err := FromRegionError(&errorpb.Error{Message: "oops"})
+	if !errors.Is(err, ErrRegion) || errors.Is(err, ErrNotLeader) || err.Error() != "region error, message=oops" {
+		t.Fatalf("unexpected bare region error %v", err)
+	}
+}
+
+func TestPDError(t *testing.T) {
+	if FromPDError(&pdpb.Error{Type: pdpb.ErrorType_OK}) != nil {
+		t.Fatal("expect nil for OK")
+	}
+	err := FromPDError(&pdpb.Error{Type: pdpb.ErrorType_NOT_BOOTSTRAPPED, Message: "bootstrap first"})
+	if !errors.Is(err, ErrNotBootstrapped) || errors.Is(err, ErrPDUnknown) {
+		t.Fatalf("unexpected match for %v", err)
+	}
+	if err.Error() != "pd: cluster is not bootstrapped: bootstrap first" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+	if pb := ToPDError(ErrStoreTombstone); pb.GetType() != pdpb.ErrorType_STORE_TOMBSTONE {
+		t.Fatalf("expect tombstone, got %v", pb)
+	}
+	if pb := ToPDError(errors.New("oops")); pb.GetType() != pdpb.ErrorType_UNKNOWN || pb.GetMessage() != "oops" {
+		t.Fatalf("expect unknown, got %v", pb)
+	}
+}
+
+func TestBackupAndImportError(t *testing.T) {
+	err := FromBackupError(&backup.Error{
+		Msg:    "backup region",
+		Detail: &backup.Error_KvError{KvError: &kvrpcpb.KeyError{Locked: &kvrpcpb.LockInfo{Key: []byte("k")}}},
+	})
+	if !errors.Is(err, ErrBackup) || !errors.Is(err, ErrLocked) || errors.Is(err, ErrClusterIDMismatch) {
+		t.Fatalf("unexpected match for %v", err)
+	}
+	err = FromBackupError(&backup.Error{Detail: &backup.Error_ClusterIdError{ClusterIdError: &backup.ClusterIDError{Current: 1, Request: 2}}})
+	if !errors.Is(err, ErrClusterIDMismatch) || err.Error() != "backup error: cluster id mismatch: current=1, request=2" {
+		t.Fatalf("unexpected cluster id error %v", err)
+	}
+	pb := ToBackupError(FromRegionError(&errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 1}}))
+	if pb.GetRegionError().GetNotLeader().GetRegionId() != 1 || !errors.Is(FromBackupError(pb), ErrNotLeader) {
+		t.Fatalf("expect region error detail, got %v", pb)
+	}
+	if pb = ToBackupError(&AbortError{"stop"}); pb.GetKvError().GetAbort() != "stop" {
+		t.Fatalf("expect key error detail, got %v", pb)
+	}
+
+	err = NewEngineNotFound([]byte{0xab})
+	if !errors.Is(err, ErrEngineNotFound) || !errors.Is(err, ErrImport) || err.Error() != "import error: engine not found: uuid=ab" {
+		t.Fatalf("unexpected import error %v", err)
+	}
+	if ToImportError(err).GetEngineNotFound() == nil || FromImportError(&import_kvpb.Error{}) == nil {
+		t.Fatal("import error does not round trip")
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kverror
+
+import (
+	"errors"
+
+	"github.com/pingcap/kvproto/pkg/pdpb"
+)
+
+var pdErrors = map[pdpb.ErrorType]error{
+	pdpb.ErrorType_UNKNOWN:              ErrPDUnknown,
+	pdpb.ErrorType_NOT_BOOTSTRAPPED:     ErrNotBootstrapped,
+	pdpb.ErrorType_STORE_TOMBSTONE:      ErrStoreTombstone,
+	pdpb.ErrorType_ALREADY_BOOTSTRAPPED: ErrAlreadyBootstrapped,
+	pdpb.ErrorType_INCOMPATIBLE_VERSION: ErrIncompatibleVersion,
+	pdpb.ErrorType_REGION_NOT_FOUND:     ErrPDRegionNotFound,
+}
+
+// PDError is a pdpb.Error. It matches the sentinel of its type.
+type PDError struct {
+	Err *pdpb.Error
+}
+
+func (e *PDError) kind() error {
+	if kind, ok := pdErrors[e.Err.GetType()]; ok {
+		return kind
+	}
+	return ErrPDUnknown
+}
+
+func (e *PDError) Error() string {
+	if e.Err.GetMessage() == "" {
+		return e.kind().Error()
+	}
+	return e.kind().Error() + ": " + e.Err.GetMessage()
+}
+
+// Is reports whether target is the sentinel of the error type.
+func (e *PDError) Is(target error) bool { return target != nil && target == e.kind() }
+
+// FromPDError returns the Go error for e, or nil if e is nil or of type OK.
+func FromPDError(e *pdpb.Error) error {
+	if e == nil || e.GetType() == pdpb.ErrorType_OK {
+		return nil
+	}
+	return &PDError{e}
+}
+
+// ToPDError returns the proto form of err, or nil if err is nil. A sentinel
+// becomes an error of its type, and an error of no known kind becomes
+// UNKNOWN with its message.
+func ToPDError(err error) *pdpb.Error {
+	if err == nil {
+		return nil
+	}
+	var e *PDError
+	if errors.As(err, &e) {
+		return e.Err
+	}
+	for typ, kind := range pdErrors {
+		if err == kind {
+			return &pdpb.Error{Type: typ}
+		}
+	}
+	return &pdpb.Error{Type: pdpb.ErrorType_UNKNOWN, Message: err.Error()}
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kverror
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+)
+
+// RegionError is an errorpb.Error. It matches ErrRegion and the sentinel of
+// the field set.
+type RegionError struct {
+	Err *errorpb.Error
+}
+
+// kind returns the sentinel of the field set, or nil for a bare message.
+func (e *RegionError) kind() error {
+	switch {
+	case e.Err.GetNotLeader() != nil:
+		return ErrNotLeader
+	case e.Err.GetRegionNotFound() != nil:
+		return ErrRegionNotFound
+	case e.Err.GetKeyNotInRegion() != nil:
+		return ErrKeyNotInRegion
+	case e.Err.GetEpochNotMatch() != nil:
+		return ErrEpochNotMatch
+	case e.Err.GetServerIsBusy() != nil:
+		return ErrServerIsBusy
+	case e.Err.GetStaleCommand() != nil:
+		return ErrStaleCommand
+	case e.Err.GetStoreNotMatch() != nil:
+		return ErrStoreNotMatch
+	case e.Err.GetRaftEntryTooLarge() != nil:
+		return ErrRaftEntryTooLarge
+	}
+	return nil
+}
+
+func (e *RegionError) Error() string {
+	var detail string
+	switch kind := e.kind(); kind {
+	case ErrNotLeader:
+		detail = fmt.Sprintf("%v: region_id=%d, leader=%v", kind, e.Err.GetNotLeader().GetRegionId(), e.Err.GetNotLeader().GetLeader())
+	case ErrRegionNotFound:
+		detail = fmt.Sprintf("%v: region_id=%d", kind, e.Err.GetRegionNotFound().GetRegionId())
+	case ErrKeyNotInRegion:
+		r := e.Err.GetKeyNotInRegion()
+		detail = fmt.Sprintf("%v: key=%q, region_id=%d, start_key=%q, end_key=%q", kind, r.GetKey(), r.GetRegionId(), r.GetStartKey(), r.GetEndKey())
+	case ErrEpochNotMatch:
+		detail = fmt.Sprintf("%v: current_regions=%v", kind, e.Err.GetEpochNotMatch().GetCurrentRegions())
+	case ErrServerIsBusy:
+		detail = fmt.Sprintf("%v: reason=%s, backoff_ms=%d", kind, e.Err.GetServerIsBusy().GetReason(), e.Err.GetServerIsBusy().GetBackoffMs())
+	case ErrStoreNotMatch:
+		detail = fmt.Sprintf("%v: request_store_id=%d, actual_store_id=%d", kind, e.Err.GetStoreNotMatch().GetRequestStoreId(), e.Err.GetStoreNotMatch().GetActualStoreId())
+	case ErrRaftEntryTooLarge:
+		detail = fmt.Sprintf("%v: region_id=%d, entry_size=%d", kind, e.Err.GetRaftEntryTooLarge().GetRegionId(), e.Err.GetRaftEntryTooLarge().GetEntrySize())
+	case nil:
+		detail = ErrRegion.Error()
+	default:
+		detail = kind.Error()
+	}
+	if e.Err.GetMessage() == "" {
+		return detail
+	}
+	return detail + ", message=" + e.Err.GetMessage()
+}
+
+// Is reports whether target is ErrRegion or the sentinel of the field set.
+func (e *RegionError) Is(target error) bool {
+	return target == ErrRegion || (target != nil && target == e.kind())
+}
+
+// FromRegionError returns the Go error for e, or nil if e is nil.
+func FromRegionError(e *errorpb.Error) error {
+	if e == nil {
+		return nil
+	}
+	return &RegionError{e}
+}
+
+// ToRegionError returns the proto form of err, or nil if err is nil. An
+// error of no known kind becomes a region error with only its message.
+func ToRegionError(err error) *errorpb.Error {
+	if err == nil {
+		return nil
+	}
+	var e *RegionError
+	if errors.As(err, &e) {
+		return e.Err
+	}
+	return &errorpb.Error{Message: err.Error()}
+}
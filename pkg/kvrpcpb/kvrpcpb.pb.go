@@ -51,7 +51,7 @@ func (x CommandPri) String() string {
 	return proto.EnumName(CommandPri_name, int32(x))
 }
 func (CommandPri) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{0}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{0}
 }
 
 type IsolationLevel int32
@@ -74,7 +74,7 @@ func (x IsolationLevel) String() string {
 	return proto.EnumName(IsolationLevel_name, int32(x))
 }
 func (IsolationLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{1}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{1}
 }
 
 type Op int32
@@ -110,7 +110,7 @@ func (x Op) String() string {
 	return proto.EnumName(Op_name, int32(x))
 }
 func (Op) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{2}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{2}
 }
 
 type Assertion int32
@@ -136,7 +136,38 @@ func (x Assertion) String() string {
 	return proto.EnumName(Assertion_name, int32(x))
 }
 func (Assertion) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{3}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{3}
+}
+
+type Action int32
+
+const (
+	Action_NoAction             Action = 0
+	Action_TTLExpireRollback    Action = 1
+	Action_LockNotExistRollback Action = 2
+	// The min_commit_ts of the lock is after caller_start_ts, so the caller
+	// can read past the locks of the transaction.
+	Action_MinCommitTSPushed Action = 3
+)
+
+var Action_name = map[int32]string{
+	0: "NoAction",
+	1: "TTLExpireRollback",
+	2: "LockNotExistRollback",
+	3: "MinCommitTSPushed",
+}
+var Action_value = map[string]int32{
+	"NoAction":             0,
+	"TTLExpireRollback":    1,
+	"LockNotExistRollback": 2,
+	"MinCommitTSPushed":    3,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{4}
 }
 
 type LockInfo struct {
@@ -156,7 +187,7 @@ func (m *LockInfo) Reset()         { *m = LockInfo{} }
 func (m *LockInfo) String() string { return proto.CompactTextString(m) }
 func (*LockInfo) ProtoMessage()    {}
 func (*LockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{0}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{0}
 }
 func (m *LockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +269,7 @@ func (m *AlreadyExist) Reset()         { *m = AlreadyExist{} }
 func (m *AlreadyExist) String() string { return proto.CompactTextString(m) }
 func (*AlreadyExist) ProtoMessage()    {}
 func (*AlreadyExist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{1}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{1}
 }
 func (m *AlreadyExist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +321,7 @@ func (m *KeyError) Reset()         { *m = KeyError{} }
 func (m *KeyError) String() string { return proto.CompactTextString(m) }
 func (*KeyError) ProtoMessage()    {}
 func (*KeyError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{2}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{2}
 }
 func (m *KeyError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +407,7 @@ func (m *WriteConflict) Reset()         { *m = WriteConflict{} }
 func (m *WriteConflict) String() string { return proto.CompactTextString(m) }
 func (*WriteConflict) ProtoMessage()    {}
 func (*WriteConflict) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{3}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{3}
 }
 func (m *WriteConflict) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +484,7 @@ func (m *Deadlock) Reset()         { *m = Deadlock{} }
 func (m *Deadlock) String() string { return proto.CompactTextString(m) }
 func (*Deadlock) ProtoMessage()    {}
 func (*Deadlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{4}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{4}
 }
 func (m *Deadlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +556,7 @@ func (m *Context) Reset()         { *m = Context{} }
 func (m *Context) String() string { return proto.CompactTextString(m) }
 func (*Context) ProtoMessage()    {}
 func (*Context) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{5}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{5}
 }
 func (m *Context) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +681,7 @@ func (m *HandleTime) Reset()         { *m = HandleTime{} }
 func (m *HandleTime) String() string { return proto.CompactTextString(m) }
 func (*HandleTime) ProtoMessage()    {}
 func (*HandleTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{6}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{6}
 }
 func (m *HandleTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +736,7 @@ func (m *ScanInfo) Reset()         { *m = ScanInfo{} }
 func (m *ScanInfo) String() string { return proto.CompactTextString(m) }
 func (*ScanInfo) ProtoMessage()    {}
 func (*ScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{7}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{7}
 }
 func (m *ScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +792,7 @@ func (m *ScanDetail) Reset()         { *m = ScanDetail{} }
 func (m *ScanDetail) String() string { return proto.CompactTextString(m) }
 func (*ScanDetail) ProtoMessage()    {}
 func (*ScanDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{8}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{8}
 }
 func (m *ScanDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +854,7 @@ func (m *ExecDetails) Reset()         { *m = ExecDetails{} }
 func (m *ExecDetails) String() string { return proto.CompactTextString(m) }
 func (*ExecDetails) ProtoMessage()    {}
 func (*ExecDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{9}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{9}
 }
 func (m *ExecDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +910,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{10}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{10}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +974,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{11}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{11}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1050,7 @@ func (m *ScanRequest) Reset()         { *m = ScanRequest{} }
 func (m *ScanRequest) String() string { return proto.CompactTextString(m) }
 func (*ScanRequest) ProtoMessage()    {}
 func (*ScanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{12}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{12}
 }
 func (m *ScanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1141,7 @@ func (m *KvPair) Reset()         { *m = KvPair{} }
 func (m *KvPair) String() string { return proto.CompactTextString(m) }
 func (*KvPair) ProtoMessage()    {}
 func (*KvPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{13}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{13}
 }
 func (m *KvPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1172,7 +1203,7 @@ func (m *ScanResponse) Reset()         { *m = ScanResponse{} }
 func (m *ScanResponse) String() string { return proto.CompactTextString(m) }
 func (*ScanResponse) ProtoMessage()    {}
 func (*ScanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{14}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{14}
 }
 func (m *ScanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,6 +1246,78 @@ func (m *ScanResponse) GetPairs() []*KvPair {
 	return nil
 }
 
+// A chunk of the pairs streamed by KvScanStream. The limit of the request
+// bounds the whole stream, and 0 means no limit; the chunk size is up to the
+// server. The stream only covers the part of the range inside the region:
+// when the range goes on beyond it, the last response carries a
+// KeyNotInRegion error for the key where the scan should continue, which is
+// also its resume_key.
+type ScanStreamResponse struct {
+	RegionError *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	Pairs       []*KvPair      `protobuf:"bytes,2,rep,name=pairs" json:"pairs,omitempty"`
+	// The start_key to resume the scan with after this chunk: the key right
+	// after the last pair when scanning forward, or the last pair itself when
+	// scanning backward.
+	ResumeKey            []byte   `protobuf:"bytes,3,opt,name=resume_key,json=resumeKey,proto3" json:"resume_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScanStreamResponse) Reset()         { *m = ScanStreamResponse{} }
+func (m *ScanStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanStreamResponse) ProtoMessage()    {}
+func (*ScanStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{15}
+}
+func (m *ScanStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScanStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScanStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ScanStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanStreamResponse.Merge(dst, src)
+}
+func (m *ScanStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScanStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanStreamResponse proto.InternalMessageInfo
+
+func (m *ScanStreamResponse) GetRegionError() *errorpb.Error {
+	if m != nil {
+		return m.RegionError
+	}
+	return nil
+}
+
+func (m *ScanStreamResponse) GetPairs() []*KvPair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *ScanStreamResponse) GetResumeKey() []byte {
+	if m != nil {
+		return m.ResumeKey
+	}
+	return nil
+}
+
 type Mutation struct {
 	Op                   Op        `protobuf:"varint,1,opt,name=op,proto3,enum=kvrpcpb.Op" json:"op,omitempty"`
 	Key                  []byte    `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -1229,7 +1332,7 @@ func (m *Mutation) Reset()         { *m = Mutation{} }
 func (m *Mutation) String() string { return proto.CompactTextString(m) }
 func (*Mutation) ProtoMessage()    {}
 func (*Mutation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{15}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{16}
 }
 func (m *Mutation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1312,7 +1415,7 @@ func (m *PrewriteRequest) Reset()         { *m = PrewriteRequest{} }
 func (m *PrewriteRequest) String() string { return proto.CompactTextString(m) }
 func (*PrewriteRequest) ProtoMessage()    {}
 func (*PrewriteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{16}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{17}
 }
 func (m *PrewriteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1526,7 @@ func (m *PrewriteResponse) Reset()         { *m = PrewriteResponse{} }
 func (m *PrewriteResponse) String() string { return proto.CompactTextString(m) }
 func (*PrewriteResponse) ProtoMessage()    {}
 func (*PrewriteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{17}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{18}
 }
 func (m *PrewriteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,7 +1584,7 @@ func (m *TxnHeartBeatRequest) Reset()         { *m = TxnHeartBeatRequest{} }
 func (m *TxnHeartBeatRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnHeartBeatRequest) ProtoMessage()    {}
 func (*TxnHeartBeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{18}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{19}
 }
 func (m *TxnHeartBeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1654,7 @@ func (m *TxnHeartBeatResponse) Reset()         { *m = TxnHeartBeatResponse{} }
 func (m *TxnHeartBeatResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnHeartBeatResponse) ProtoMessage()    {}
 func (*TxnHeartBeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{19}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{20}
 }
 func (m *TxnHeartBeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1622,7 +1725,7 @@ func (m *PessimisticLockRequest) Reset()         { *m = PessimisticLockRequest{}
 func (m *PessimisticLockRequest) String() string { return proto.CompactTextString(m) }
 func (*PessimisticLockRequest) ProtoMessage()    {}
 func (*PessimisticLockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{20}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{21}
 }
 func (m *PessimisticLockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1822,7 @@ func (m *PessimisticLockResponse) Reset()         { *m = PessimisticLockResponse
 func (m *PessimisticLockResponse) String() string { return proto.CompactTextString(m) }
 func (*PessimisticLockResponse) ProtoMessage()    {}
 func (*PessimisticLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{21}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{22}
 }
 func (m *PessimisticLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1776,7 +1879,7 @@ func (m *PessimisticRollbackRequest) Reset()         { *m = PessimisticRollbackR
 func (m *PessimisticRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*PessimisticRollbackRequest) ProtoMessage()    {}
 func (*PessimisticRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{22}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{23}
 }
 func (m *PessimisticRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1845,7 +1948,7 @@ func (m *PessimisticRollbackResponse) Reset()         { *m = PessimisticRollback
 func (m *PessimisticRollbackResponse) String() string { return proto.CompactTextString(m) }
 func (*PessimisticRollbackResponse) ProtoMessage()    {}
 func (*PessimisticRollbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{23}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{24}
 }
 func (m *PessimisticRollbackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1902,7 +2005,7 @@ func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
 func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitRequest) ProtoMessage()    {}
 func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{24}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{25}
 }
 func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1972,7 +2075,7 @@ func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
 func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitResponse) ProtoMessage()    {}
 func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{25}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{26}
 }
 func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,7 +2137,7 @@ func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
 func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportRequest) ProtoMessage()    {}
 func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{26}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{27}
 }
 func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2089,7 +2192,7 @@ func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
 func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportResponse) ProtoMessage()    {}
 func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{27}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{28}
 }
 func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2145,7 +2248,7 @@ func (m *BatchRollbackRequest) Reset()         { *m = BatchRollbackRequest{} }
 func (m *BatchRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchRollbackRequest) ProtoMessage()    {}
 func (*BatchRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{28}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{29}
 }
 func (m *BatchRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2207,7 +2310,7 @@ func (m *BatchRollbackResponse) Reset()         { *m = BatchRollbackResponse{} }
 func (m *BatchRollbackResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchRollbackResponse) ProtoMessage()    {}
 func (*BatchRollbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{29}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{30}
 }
 func (m *BatchRollbackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2255,7 +2358,6 @@ func (m *BatchRollbackResponse) GetError() *KeyError {
 // If the TTL of the transaction is exhausted, abort that transaction and return rollbacked;
 // Otherwise, returns the TTL information.
 // CheckTxnStatusRequest may also pushe forward the minCommitTS of a large transaction.
-//
 type CheckTxnStatusRequest struct {
 	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
 	PrimaryKey           []byte   `protobuf:"bytes,2,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
@@ -2271,7 +2373,7 @@ func (m *CheckTxnStatusRequest) Reset()         { *m = CheckTxnStatusRequest{} }
 func (m *CheckTxnStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckTxnStatusRequest) ProtoMessage()    {}
 func (*CheckTxnStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{30}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{31}
 }
 func (m *CheckTxnStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,8 +2444,10 @@ type CheckTxnStatusResponse struct {
 	// locked: lock_ttl > 0
 	// committed: commit_version > 0
 	// rollbacked: lock_ttl = 0 && commit_version = 0
-	LockTtl              uint64   `protobuf:"varint,3,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
-	CommitVersion        uint64   `protobuf:"varint,4,opt,name=commit_version,json=commitVersion,proto3" json:"commit_version,omitempty"`
+	LockTtl       uint64 `protobuf:"varint,3,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
+	CommitVersion uint64 `protobuf:"varint,4,opt,name=commit_version,json=commitVersion,proto3" json:"commit_version,omitempty"`
+	// What CheckTxnStatus did to the lock.
+	Action               Action   `protobuf:"varint,5,opt,name=action,proto3,enum=kvrpcpb.Action" json:"action,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2353,7 +2457,7 @@ func (m *CheckTxnStatusResponse) Reset()         { *m = CheckTxnStatusResponse{}
 func (m *CheckTxnStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckTxnStatusResponse) ProtoMessage()    {}
 func (*CheckTxnStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{31}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{32}
 }
 func (m *CheckTxnStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2410,6 +2514,13 @@ func (m *CheckTxnStatusResponse) GetCommitVersion() uint64 {
 	return 0
 }
 
+func (m *CheckTxnStatusResponse) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_NoAction
+}
+
 type CleanupRequest struct {
 	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
 	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -2424,7 +2535,7 @@ func (m *CleanupRequest) Reset()         { *m = CleanupRequest{} }
 func (m *CleanupRequest) String() string { return proto.CompactTextString(m) }
 func (*CleanupRequest) ProtoMessage()    {}
 func (*CleanupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{32}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{33}
 }
 func (m *CleanupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2494,7 +2605,7 @@ func (m *CleanupResponse) Reset()         { *m = CleanupResponse{} }
 func (m *CleanupResponse) String() string { return proto.CompactTextString(m) }
 func (*CleanupResponse) ProtoMessage()    {}
 func (*CleanupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{33}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{34}
 }
 func (m *CleanupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2557,7 +2668,7 @@ func (m *BatchGetRequest) Reset()         { *m = BatchGetRequest{} }
 func (m *BatchGetRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchGetRequest) ProtoMessage()    {}
 func (*BatchGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{34}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{35}
 }
 func (m *BatchGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2619,7 +2730,7 @@ func (m *BatchGetResponse) Reset()         { *m = BatchGetResponse{} }
 func (m *BatchGetResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchGetResponse) ProtoMessage()    {}
 func (*BatchGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{35}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{36}
 }
 func (m *BatchGetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2787,7 @@ func (m *ScanLockRequest) Reset()         { *m = ScanLockRequest{} }
 func (m *ScanLockRequest) String() string { return proto.CompactTextString(m) }
 func (*ScanLockRequest) ProtoMessage()    {}
 func (*ScanLockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{36}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{37}
 }
 func (m *ScanLockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2746,7 +2857,7 @@ func (m *ScanLockResponse) Reset()         { *m = ScanLockResponse{} }
 func (m *ScanLockResponse) String() string { return proto.CompactTextString(m) }
 func (*ScanLockResponse) ProtoMessage()    {}
 func (*ScanLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{37}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{38}
 }
 func (m *ScanLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2808,7 +2919,7 @@ func (m *TxnInfo) Reset()         { *m = TxnInfo{} }
 func (m *TxnInfo) String() string { return proto.CompactTextString(m) }
 func (*TxnInfo) ProtoMessage()    {}
 func (*TxnInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{38}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{39}
 }
 func (m *TxnInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2868,7 +2979,7 @@ func (m *ResolveLockRequest) Reset()         { *m = ResolveLockRequest{} }
 func (m *ResolveLockRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveLockRequest) ProtoMessage()    {}
 func (*ResolveLockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{39}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{40}
 }
 func (m *ResolveLockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2944,7 +3055,7 @@ func (m *ResolveLockResponse) Reset()         { *m = ResolveLockResponse{} }
 func (m *ResolveLockResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveLockResponse) ProtoMessage()    {}
 func (*ResolveLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{40}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{41}
 }
 func (m *ResolveLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2999,7 +3110,7 @@ func (m *GCRequest) Reset()         { *m = GCRequest{} }
 func (m *GCRequest) String() string { return proto.CompactTextString(m) }
 func (*GCRequest) ProtoMessage()    {}
 func (*GCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{41}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{42}
 }
 func (m *GCRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3054,7 +3165,7 @@ func (m *GCResponse) Reset()         { *m = GCResponse{} }
 func (m *GCResponse) String() string { return proto.CompactTextString(m) }
 func (*GCResponse) ProtoMessage()    {}
 func (*GCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{42}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{43}
 }
 func (m *GCResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3110,7 +3221,7 @@ func (m *RawGetRequest) Reset()         { *m = RawGetRequest{} }
 func (m *RawGetRequest) String() string { return proto.CompactTextString(m) }
 func (*RawGetRequest) ProtoMessage()    {}
 func (*RawGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{43}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{44}
 }
 func (m *RawGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3174,7 +3285,7 @@ func (m *RawGetResponse) Reset()         { *m = RawGetResponse{} }
 func (m *RawGetResponse) String() string { return proto.CompactTextString(m) }
 func (*RawGetResponse) ProtoMessage()    {}
 func (*RawGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{44}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{45}
 }
 func (m *RawGetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3232,10 +3343,12 @@ func (m *RawGetResponse) GetNotFound() bool {
 }
 
 type RawPutRequest struct {
-	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Cf                   string   `protobuf:"bytes,4,opt,name=cf,proto3" json:"cf,omitempty"`
+	Context *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Key     []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Cf      string   `protobuf:"bytes,4,opt,name=cf,proto3" json:"cf,omitempty"`
+	// Seconds until the key expires. 0 means it never expires.
+	Ttl                  uint64   `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3245,7 +3358,7 @@ func (m *RawPutRequest) Reset()         { *m = RawPutRequest{} }
 func (m *RawPutRequest) String() string { return proto.CompactTextString(m) }
 func (*RawPutRequest) ProtoMessage()    {}
 func (*RawPutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{45}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{46}
 }
 func (m *RawPutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3302,6 +3415,13 @@ func (m *RawPutRequest) GetCf() string {
 	return ""
 }
 
+func (m *RawPutRequest) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type RawPutResponse struct {
 	RegionError          *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
 	Error                string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -3314,7 +3434,7 @@ func (m *RawPutResponse) Reset()         { *m = RawPutResponse{} }
 func (m *RawPutResponse) String() string { return proto.CompactTextString(m) }
 func (*RawPutResponse) ProtoMessage()    {}
 func (*RawPutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{46}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{47}
 }
 func (m *RawPutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3358,19 +3478,22 @@ func (m *RawPutResponse) GetError() string {
 }
 
 type RawBatchPutRequest struct {
-	Context              *Context  `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
-	Pairs                []*KvPair `protobuf:"bytes,2,rep,name=pairs" json:"pairs,omitempty"`
-	Cf                   string    `protobuf:"bytes,3,opt,name=cf,proto3" json:"cf,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Context *Context  `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Pairs   []*KvPair `protobuf:"bytes,2,rep,name=pairs" json:"pairs,omitempty"`
+	Cf      string    `protobuf:"bytes,3,opt,name=cf,proto3" json:"cf,omitempty"`
+	// The TTL in seconds of each pair, like RawPutRequest.ttl. Empty if no
+	// pair expires.
+	Ttls                 []uint64 `protobuf:"varint,4,rep,packed,name=ttls" json:"ttls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RawBatchPutRequest) Reset()         { *m = RawBatchPutRequest{} }
 func (m *RawBatchPutRequest) String() string { return proto.CompactTextString(m) }
 func (*RawBatchPutRequest) ProtoMessage()    {}
 func (*RawBatchPutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{47}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{48}
 }
 func (m *RawBatchPutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3420,6 +3543,13 @@ func (m *RawBatchPutRequest) GetCf() string {
 	return ""
 }
 
+func (m *RawBatchPutRequest) GetTtls() []uint64 {
+	if m != nil {
+		return m.Ttls
+	}
+	return nil
+}
+
 type RawBatchPutResponse struct {
 	RegionError          *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
 	Error                string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -3432,7 +3562,7 @@ func (m *RawBatchPutResponse) Reset()         { *m = RawBatchPutResponse{} }
 func (m *RawBatchPutResponse) String() string { return proto.CompactTextString(m) }
 func (*RawBatchPutResponse) ProtoMessage()    {}
 func (*RawBatchPutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{48}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{49}
 }
 func (m *RawBatchPutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3475,6 +3605,141 @@ func (m *RawBatchPutResponse) GetError() string {
 	return ""
 }
 
+type RawGetKeyTTLRequest struct {
+	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Cf                   string   `protobuf:"bytes,3,opt,name=cf,proto3" json:"cf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RawGetKeyTTLRequest) Reset()         { *m = RawGetKeyTTLRequest{} }
+func (m *RawGetKeyTTLRequest) String() string { return proto.CompactTextString(m) }
+func (*RawGetKeyTTLRequest) ProtoMessage()    {}
+func (*RawGetKeyTTLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{50}
+}
+func (m *RawGetKeyTTLRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawGetKeyTTLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawGetKeyTTLRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RawGetKeyTTLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawGetKeyTTLRequest.Merge(dst, src)
+}
+func (m *RawGetKeyTTLRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawGetKeyTTLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawGetKeyTTLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawGetKeyTTLRequest proto.InternalMessageInfo
+
+func (m *RawGetKeyTTLRequest) GetContext() *Context {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *RawGetKeyTTLRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *RawGetKeyTTLRequest) GetCf() string {
+	if m != nil {
+		return m.Cf
+	}
+	return ""
+}
+
+type RawGetKeyTTLResponse struct {
+	RegionError *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	Error       string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Seconds until the key expires, rounded up. 0 means it never expires.
+	Ttl                  uint64   `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	NotFound             bool     `protobuf:"varint,4,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RawGetKeyTTLResponse) Reset()         { *m = RawGetKeyTTLResponse{} }
+func (m *RawGetKeyTTLResponse) String() string { return proto.CompactTextString(m) }
+func (*RawGetKeyTTLResponse) ProtoMessage()    {}
+func (*RawGetKeyTTLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{51}
+}
+func (m *RawGetKeyTTLResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawGetKeyTTLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawGetKeyTTLResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RawGetKeyTTLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawGetKeyTTLResponse.Merge(dst, src)
+}
+func (m *RawGetKeyTTLResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawGetKeyTTLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawGetKeyTTLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawGetKeyTTLResponse proto.InternalMessageInfo
+
+func (m *RawGetKeyTTLResponse) GetRegionError() *errorpb.Error {
+	if m != nil {
+		return m.RegionError
+	}
+	return nil
+}
+
+func (m *RawGetKeyTTLResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RawGetKeyTTLResponse) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *RawGetKeyTTLResponse) GetNotFound() bool {
+	if m != nil {
+		return m.NotFound
+	}
+	return false
+}
+
 type RawBatchGetRequest struct {
 	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
 	Keys                 [][]byte `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
@@ -3488,7 +3753,7 @@ func (m *RawBatchGetRequest) Reset()         { *m = RawBatchGetRequest{} }
 func (m *RawBatchGetRequest) String() string { return proto.CompactTextString(m) }
 func (*RawBatchGetRequest) ProtoMessage()    {}
 func (*RawBatchGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{49}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{52}
 }
 func (m *RawBatchGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3550,7 +3815,7 @@ func (m *RawBatchGetResponse) Reset()         { *m = RawBatchGetResponse{} }
 func (m *RawBatchGetResponse) String() string { return proto.CompactTextString(m) }
 func (*RawBatchGetResponse) ProtoMessage()    {}
 func (*RawBatchGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{50}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{53}
 }
 func (m *RawBatchGetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3606,7 +3871,7 @@ func (m *RawDeleteRequest) Reset()         { *m = RawDeleteRequest{} }
 func (m *RawDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*RawDeleteRequest) ProtoMessage()    {}
 func (*RawDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{51}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{54}
 }
 func (m *RawDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3668,7 +3933,7 @@ func (m *RawDeleteResponse) Reset()         { *m = RawDeleteResponse{} }
 func (m *RawDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*RawDeleteResponse) ProtoMessage()    {}
 func (*RawDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{52}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{55}
 }
 func (m *RawDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3724,7 +3989,7 @@ func (m *RawBatchDeleteRequest) Reset()         { *m = RawBatchDeleteRequest{} }
 func (m *RawBatchDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*RawBatchDeleteRequest) ProtoMessage()    {}
 func (*RawBatchDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{53}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{56}
 }
 func (m *RawBatchDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3786,7 +4051,7 @@ func (m *RawBatchDeleteResponse) Reset()         { *m = RawBatchDeleteResponse{}
 func (m *RawBatchDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*RawBatchDeleteResponse) ProtoMessage()    {}
 func (*RawBatchDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{54}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{57}
 }
 func (m *RawBatchDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3843,7 +4108,7 @@ func (m *DeleteRangeRequest) Reset()         { *m = DeleteRangeRequest{} }
 func (m *DeleteRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRangeRequest) ProtoMessage()    {}
 func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{55}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{58}
 }
 func (m *DeleteRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3912,7 +4177,7 @@ func (m *DeleteRangeResponse) Reset()         { *m = DeleteRangeResponse{} }
 func (m *DeleteRangeResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteRangeResponse) ProtoMessage()    {}
 func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{56}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{59}
 }
 func (m *DeleteRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3955,28 +4220,33 @@ func (m *DeleteRangeResponse) GetError() string {
 	return ""
 }
 
-type RawDeleteRangeRequest struct {
-	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
-	StartKey             []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
-	EndKey               []byte   `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
-	Cf                   string   `protobuf:"bytes,4,opt,name=cf,proto3" json:"cf,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Checksum computes the checksum of the keys in a range at a version without
+// reading them out, the same way as the checksums of backup.File and
+// backup.Schema: crc64xor is the xor of the CRC-64 (ECMA) of every key
+// followed by its value, total_kvs the number of keys and total_bytes the
+// total length of keys and values. The range is clipped to the region, and
+// its start key must be inside it.
+type ChecksumRequest struct {
+	Context              *Context  `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Version              uint64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Range                *KeyRange `protobuf:"bytes,3,opt,name=range" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *RawDeleteRangeRequest) Reset()         { *m = RawDeleteRangeRequest{} }
-func (m *RawDeleteRangeRequest) String() string { return proto.CompactTextString(m) }
-func (*RawDeleteRangeRequest) ProtoMessage()    {}
-func (*RawDeleteRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{57}
+func (m *ChecksumRequest) Reset()         { *m = ChecksumRequest{} }
+func (m *ChecksumRequest) String() string { return proto.CompactTextString(m) }
+func (*ChecksumRequest) ProtoMessage()    {}
+func (*ChecksumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{60}
 }
-func (m *RawDeleteRangeRequest) XXX_Unmarshal(b []byte) error {
+func (m *ChecksumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RawDeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChecksumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RawDeleteRangeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChecksumRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3986,66 +4256,62 @@ func (m *RawDeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (dst *RawDeleteRangeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawDeleteRangeRequest.Merge(dst, src)
+func (dst *ChecksumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChecksumRequest.Merge(dst, src)
 }
-func (m *RawDeleteRangeRequest) XXX_Size() int {
+func (m *ChecksumRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RawDeleteRangeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawDeleteRangeRequest.DiscardUnknown(m)
+func (m *ChecksumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChecksumRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawDeleteRangeRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChecksumRequest proto.InternalMessageInfo
 
-func (m *RawDeleteRangeRequest) GetContext() *Context {
+func (m *ChecksumRequest) GetContext() *Context {
 	if m != nil {
 		return m.Context
 	}
 	return nil
 }
 
-func (m *RawDeleteRangeRequest) GetStartKey() []byte {
+func (m *ChecksumRequest) GetVersion() uint64 {
 	if m != nil {
-		return m.StartKey
+		return m.Version
 	}
-	return nil
+	return 0
 }
 
-func (m *RawDeleteRangeRequest) GetEndKey() []byte {
+func (m *ChecksumRequest) GetRange() *KeyRange {
 	if m != nil {
-		return m.EndKey
+		return m.Range
 	}
 	return nil
 }
 
-func (m *RawDeleteRangeRequest) GetCf() string {
-	if m != nil {
-		return m.Cf
-	}
-	return ""
-}
-
-type RawDeleteRangeResponse struct {
+type ChecksumResponse struct {
 	RegionError          *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
-	Error                string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Error                *KeyError      `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	Crc64Xor             uint64         `protobuf:"varint,3,opt,name=crc64xor,proto3" json:"crc64xor,omitempty"`
+	TotalKvs             uint64         `protobuf:"varint,4,opt,name=total_kvs,json=totalKvs,proto3" json:"total_kvs,omitempty"`
+	TotalBytes           uint64         `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *RawDeleteRangeResponse) Reset()         { *m = RawDeleteRangeResponse{} }
-func (m *RawDeleteRangeResponse) String() string { return proto.CompactTextString(m) }
-func (*RawDeleteRangeResponse) ProtoMessage()    {}
-func (*RawDeleteRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{58}
+func (m *ChecksumResponse) Reset()         { *m = ChecksumResponse{} }
+func (m *ChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*ChecksumResponse) ProtoMessage()    {}
+func (*ChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{61}
 }
-func (m *RawDeleteRangeResponse) XXX_Unmarshal(b []byte) error {
+func (m *ChecksumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RawDeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RawDeleteRangeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChecksumResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -4055,55 +4321,202 @@ func (m *RawDeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (dst *RawDeleteRangeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawDeleteRangeResponse.Merge(dst, src)
+func (dst *ChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChecksumResponse.Merge(dst, src)
 }
-func (m *RawDeleteRangeResponse) XXX_Size() int {
+func (m *ChecksumResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RawDeleteRangeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawDeleteRangeResponse.DiscardUnknown(m)
+func (m *ChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChecksumResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawDeleteRangeResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChecksumResponse proto.InternalMessageInfo
 
-func (m *RawDeleteRangeResponse) GetRegionError() *errorpb.Error {
+func (m *ChecksumResponse) GetRegionError() *errorpb.Error {
 	if m != nil {
 		return m.RegionError
 	}
 	return nil
 }
 
-func (m *RawDeleteRangeResponse) GetError() string {
+func (m *ChecksumResponse) GetError() *KeyError {
 	if m != nil {
 		return m.Error
 	}
-	return ""
+	return nil
 }
 
-type RawScanRequest struct {
-	Context  *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
-	StartKey []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
-	Limit    uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	KeyOnly  bool     `protobuf:"varint,4,opt,name=key_only,json=keyOnly,proto3" json:"key_only,omitempty"`
-	Cf       string   `protobuf:"bytes,5,opt,name=cf,proto3" json:"cf,omitempty"`
-	Reverse  bool     `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	// For compatibility, when scanning forward, the range to scan is [start_key, end_key), where start_key < end_key;
-	// and when scanning backward, it scans [end_key, start_key) in descending order, where end_key < start_key.
-	EndKey               []byte   `protobuf:"bytes,7,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChecksumResponse) GetCrc64Xor() uint64 {
+	if m != nil {
+		return m.Crc64Xor
+	}
+	return 0
 }
 
-func (m *RawScanRequest) Reset()         { *m = RawScanRequest{} }
-func (m *RawScanRequest) String() string { return proto.CompactTextString(m) }
-func (*RawScanRequest) ProtoMessage()    {}
-func (*RawScanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{59}
+func (m *ChecksumResponse) GetTotalKvs() uint64 {
+	if m != nil {
+		return m.TotalKvs
+	}
+	return 0
 }
-func (m *RawScanRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *ChecksumResponse) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+type RawDeleteRangeRequest struct {
+	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	StartKey             []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey               []byte   `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	Cf                   string   `protobuf:"bytes,4,opt,name=cf,proto3" json:"cf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RawDeleteRangeRequest) Reset()         { *m = RawDeleteRangeRequest{} }
+func (m *RawDeleteRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*RawDeleteRangeRequest) ProtoMessage()    {}
+func (*RawDeleteRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{62}
+}
+func (m *RawDeleteRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawDeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawDeleteRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RawDeleteRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawDeleteRangeRequest.Merge(dst, src)
+}
+func (m *RawDeleteRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawDeleteRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawDeleteRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawDeleteRangeRequest proto.InternalMessageInfo
+
+func (m *RawDeleteRangeRequest) GetContext() *Context {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *RawDeleteRangeRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *RawDeleteRangeRequest) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
+func (m *RawDeleteRangeRequest) GetCf() string {
+	if m != nil {
+		return m.Cf
+	}
+	return ""
+}
+
+type RawDeleteRangeResponse struct {
+	RegionError          *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	Error                string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RawDeleteRangeResponse) Reset()         { *m = RawDeleteRangeResponse{} }
+func (m *RawDeleteRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*RawDeleteRangeResponse) ProtoMessage()    {}
+func (*RawDeleteRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{63}
+}
+func (m *RawDeleteRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawDeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawDeleteRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RawDeleteRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawDeleteRangeResponse.Merge(dst, src)
+}
+func (m *RawDeleteRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawDeleteRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawDeleteRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawDeleteRangeResponse proto.InternalMessageInfo
+
+func (m *RawDeleteRangeResponse) GetRegionError() *errorpb.Error {
+	if m != nil {
+		return m.RegionError
+	}
+	return nil
+}
+
+func (m *RawDeleteRangeResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RawScanRequest struct {
+	Context  *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	StartKey []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit    uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	KeyOnly  bool     `protobuf:"varint,4,opt,name=key_only,json=keyOnly,proto3" json:"key_only,omitempty"`
+	Cf       string   `protobuf:"bytes,5,opt,name=cf,proto3" json:"cf,omitempty"`
+	Reverse  bool     `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// For compatibility, when scanning forward, the range to scan is [start_key, end_key), where start_key < end_key;
+	// and when scanning backward, it scans [end_key, start_key) in descending order, where end_key < start_key.
+	EndKey               []byte   `protobuf:"bytes,7,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RawScanRequest) Reset()         { *m = RawScanRequest{} }
+func (m *RawScanRequest) String() string { return proto.CompactTextString(m) }
+func (*RawScanRequest) ProtoMessage()    {}
+func (*RawScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{64}
+}
+func (m *RawScanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 func (m *RawScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
@@ -4190,7 +4603,7 @@ func (m *RawScanResponse) Reset()         { *m = RawScanResponse{} }
 func (m *RawScanResponse) String() string { return proto.CompactTextString(m) }
 func (*RawScanResponse) ProtoMessage()    {}
 func (*RawScanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{60}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{65}
 }
 func (m *RawScanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4233,6 +4646,70 @@ func (m *RawScanResponse) GetKvs() []*KvPair {
 	return nil
 }
 
+// A chunk of the pairs streamed by RawScanStream, like ScanStreamResponse.
+type RawScanStreamResponse struct {
+	RegionError          *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	Kvs                  []*KvPair      `protobuf:"bytes,2,rep,name=kvs" json:"kvs,omitempty"`
+	ResumeKey            []byte         `protobuf:"bytes,3,opt,name=resume_key,json=resumeKey,proto3" json:"resume_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RawScanStreamResponse) Reset()         { *m = RawScanStreamResponse{} }
+func (m *RawScanStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*RawScanStreamResponse) ProtoMessage()    {}
+func (*RawScanStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{66}
+}
+func (m *RawScanStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawScanStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawScanStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RawScanStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawScanStreamResponse.Merge(dst, src)
+}
+func (m *RawScanStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawScanStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawScanStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawScanStreamResponse proto.InternalMessageInfo
+
+func (m *RawScanStreamResponse) GetRegionError() *errorpb.Error {
+	if m != nil {
+		return m.RegionError
+	}
+	return nil
+}
+
+func (m *RawScanStreamResponse) GetKvs() []*KvPair {
+	if m != nil {
+		return m.Kvs
+	}
+	return nil
+}
+
+func (m *RawScanStreamResponse) GetResumeKey() []byte {
+	if m != nil {
+		return m.ResumeKey
+	}
+	return nil
+}
+
 type KeyRange struct {
 	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
 	EndKey               []byte   `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
@@ -4245,7 +4722,7 @@ func (m *KeyRange) Reset()         { *m = KeyRange{} }
 func (m *KeyRange) String() string { return proto.CompactTextString(m) }
 func (*KeyRange) ProtoMessage()    {}
 func (*KeyRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{61}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{67}
 }
 func (m *KeyRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4304,7 +4781,7 @@ func (m *RawBatchScanRequest) Reset()         { *m = RawBatchScanRequest{} }
 func (m *RawBatchScanRequest) String() string { return proto.CompactTextString(m) }
 func (*RawBatchScanRequest) ProtoMessage()    {}
 func (*RawBatchScanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{62}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{68}
 }
 func (m *RawBatchScanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4387,7 +4864,7 @@ func (m *RawBatchScanResponse) Reset()         { *m = RawBatchScanResponse{} }
 func (m *RawBatchScanResponse) String() string { return proto.CompactTextString(m) }
 func (*RawBatchScanResponse) ProtoMessage()    {}
 func (*RawBatchScanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{63}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{69}
 }
 func (m *RawBatchScanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4430,28 +4907,34 @@ func (m *RawBatchScanResponse) GetKvs() []*KvPair {
 	return nil
 }
 
-type MvccWrite struct {
-	Type                 Op       `protobuf:"varint,1,opt,name=type,proto3,enum=kvrpcpb.Op" json:"type,omitempty"`
-	StartTs              uint64   `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	CommitTs             uint64   `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
-	ShortValue           []byte   `protobuf:"bytes,4,opt,name=short_value,json=shortValue,proto3" json:"short_value,omitempty"`
+// Atomically sets key to value if its current value is previous_value, or if
+// it does not exist when previous_not_exist is set.
+type RawCompareAndSwapRequest struct {
+	Context          *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Key              []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value            []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PreviousNotExist bool     `protobuf:"varint,4,opt,name=previous_not_exist,json=previousNotExist,proto3" json:"previous_not_exist,omitempty"`
+	PreviousValue    []byte   `protobuf:"bytes,5,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	Cf               string   `protobuf:"bytes,6,opt,name=cf,proto3" json:"cf,omitempty"`
+	// The TTL of value, like RawPutRequest.ttl.
+	Ttl                  uint64   `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MvccWrite) Reset()         { *m = MvccWrite{} }
-func (m *MvccWrite) String() string { return proto.CompactTextString(m) }
-func (*MvccWrite) ProtoMessage()    {}
-func (*MvccWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{64}
+func (m *RawCompareAndSwapRequest) Reset()         { *m = RawCompareAndSwapRequest{} }
+func (m *RawCompareAndSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*RawCompareAndSwapRequest) ProtoMessage()    {}
+func (*RawCompareAndSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{70}
 }
-func (m *MvccWrite) XXX_Unmarshal(b []byte) error {
+func (m *RawCompareAndSwapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MvccWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RawCompareAndSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MvccWrite.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RawCompareAndSwapRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -4461,64 +4944,237 @@ func (m *MvccWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *MvccWrite) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MvccWrite.Merge(dst, src)
+func (dst *RawCompareAndSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawCompareAndSwapRequest.Merge(dst, src)
 }
-func (m *MvccWrite) XXX_Size() int {
+func (m *RawCompareAndSwapRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MvccWrite) XXX_DiscardUnknown() {
-	xxx_messageInfo_MvccWrite.DiscardUnknown(m)
+func (m *RawCompareAndSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawCompareAndSwapRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MvccWrite proto.InternalMessageInfo
+var xxx_messageInfo_RawCompareAndSwapRequest proto.InternalMessageInfo
 
-func (m *MvccWrite) GetType() Op {
+func (m *RawCompareAndSwapRequest) GetContext() *Context {
 	if m != nil {
-		return m.Type
+		return m.Context
 	}
-	return Op_Put
+	return nil
 }
 
-func (m *MvccWrite) GetStartTs() uint64 {
+func (m *RawCompareAndSwapRequest) GetKey() []byte {
 	if m != nil {
-		return m.StartTs
+		return m.Key
 	}
-	return 0
+	return nil
 }
 
-func (m *MvccWrite) GetCommitTs() uint64 {
+func (m *RawCompareAndSwapRequest) GetValue() []byte {
 	if m != nil {
-		return m.CommitTs
+		return m.Value
 	}
-	return 0
+	return nil
 }
 
-func (m *MvccWrite) GetShortValue() []byte {
+func (m *RawCompareAndSwapRequest) GetPreviousNotExist() bool {
 	if m != nil {
-		return m.ShortValue
+		return m.PreviousNotExist
+	}
+	return false
+}
+
+func (m *RawCompareAndSwapRequest) GetPreviousValue() []byte {
+	if m != nil {
+		return m.PreviousValue
 	}
 	return nil
 }
 
-type MvccValue struct {
-	StartTs              uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *RawCompareAndSwapRequest) GetCf() string {
+	if m != nil {
+		return m.Cf
+	}
+	return ""
+}
+
+func (m *RawCompareAndSwapRequest) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+type RawCompareAndSwapResponse struct {
+	RegionError *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	Error       string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether value was set.
+	Succeed bool `protobuf:"varint,3,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	// The value of key before the request, whether it was set or not.
+	PreviousNotExist     bool     `protobuf:"varint,4,opt,name=previous_not_exist,json=previousNotExist,proto3" json:"previous_not_exist,omitempty"`
+	PreviousValue        []byte   `protobuf:"bytes,5,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MvccValue) Reset()         { *m = MvccValue{} }
-func (m *MvccValue) String() string { return proto.CompactTextString(m) }
-func (*MvccValue) ProtoMessage()    {}
-func (*MvccValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{65}
+func (m *RawCompareAndSwapResponse) Reset()         { *m = RawCompareAndSwapResponse{} }
+func (m *RawCompareAndSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*RawCompareAndSwapResponse) ProtoMessage()    {}
+func (*RawCompareAndSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{71}
 }
-func (m *MvccValue) XXX_Unmarshal(b []byte) error {
+func (m *RawCompareAndSwapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MvccValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RawCompareAndSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawCompareAndSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RawCompareAndSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawCompareAndSwapResponse.Merge(dst, src)
+}
+func (m *RawCompareAndSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawCompareAndSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawCompareAndSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawCompareAndSwapResponse proto.InternalMessageInfo
+
+func (m *RawCompareAndSwapResponse) GetRegionError() *errorpb.Error {
+	if m != nil {
+		return m.RegionError
+	}
+	return nil
+}
+
+func (m *RawCompareAndSwapResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RawCompareAndSwapResponse) GetSucceed() bool {
+	if m != nil {
+		return m.Succeed
+	}
+	return false
+}
+
+func (m *RawCompareAndSwapResponse) GetPreviousNotExist() bool {
+	if m != nil {
+		return m.PreviousNotExist
+	}
+	return false
+}
+
+func (m *RawCompareAndSwapResponse) GetPreviousValue() []byte {
+	if m != nil {
+		return m.PreviousValue
+	}
+	return nil
+}
+
+type MvccWrite struct {
+	Type                 Op       `protobuf:"varint,1,opt,name=type,proto3,enum=kvrpcpb.Op" json:"type,omitempty"`
+	StartTs              uint64   `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs             uint64   `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	ShortValue           []byte   `protobuf:"bytes,4,opt,name=short_value,json=shortValue,proto3" json:"short_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MvccWrite) Reset()         { *m = MvccWrite{} }
+func (m *MvccWrite) String() string { return proto.CompactTextString(m) }
+func (*MvccWrite) ProtoMessage()    {}
+func (*MvccWrite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{72}
+}
+func (m *MvccWrite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MvccWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MvccWrite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MvccWrite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MvccWrite.Merge(dst, src)
+}
+func (m *MvccWrite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MvccWrite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MvccWrite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MvccWrite proto.InternalMessageInfo
+
+func (m *MvccWrite) GetType() Op {
+	if m != nil {
+		return m.Type
+	}
+	return Op_Put
+}
+
+func (m *MvccWrite) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *MvccWrite) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
+	}
+	return 0
+}
+
+func (m *MvccWrite) GetShortValue() []byte {
+	if m != nil {
+		return m.ShortValue
+	}
+	return nil
+}
+
+type MvccValue struct {
+	StartTs              uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MvccValue) Reset()         { *m = MvccValue{} }
+func (m *MvccValue) String() string { return proto.CompactTextString(m) }
+func (*MvccValue) ProtoMessage()    {}
+func (*MvccValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{73}
+}
+func (m *MvccValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MvccValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_MvccValue.Marshal(b, m, deterministic)
 	} else {
@@ -4570,7 +5226,7 @@ func (m *MvccLock) Reset()         { *m = MvccLock{} }
 func (m *MvccLock) String() string { return proto.CompactTextString(m) }
 func (*MvccLock) ProtoMessage()    {}
 func (*MvccLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{66}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{74}
 }
 func (m *MvccLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4640,7 +5296,7 @@ func (m *MvccInfo) Reset()         { *m = MvccInfo{} }
 func (m *MvccInfo) String() string { return proto.CompactTextString(m) }
 func (*MvccInfo) ProtoMessage()    {}
 func (*MvccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{67}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{75}
 }
 func (m *MvccInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4702,7 +5358,7 @@ func (m *MvccGetByKeyRequest) Reset()         { *m = MvccGetByKeyRequest{} }
 func (m *MvccGetByKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*MvccGetByKeyRequest) ProtoMessage()    {}
 func (*MvccGetByKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{68}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{76}
 }
 func (m *MvccGetByKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4758,7 +5414,7 @@ func (m *MvccGetByKeyResponse) Reset()         { *m = MvccGetByKeyResponse{} }
 func (m *MvccGetByKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*MvccGetByKeyResponse) ProtoMessage()    {}
 func (*MvccGetByKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{69}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{77}
 }
 func (m *MvccGetByKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4820,7 +5476,7 @@ func (m *MvccGetByStartTsRequest) Reset()         { *m = MvccGetByStartTsRequest
 func (m *MvccGetByStartTsRequest) String() string { return proto.CompactTextString(m) }
 func (*MvccGetByStartTsRequest) ProtoMessage()    {}
 func (*MvccGetByStartTsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{70}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{78}
 }
 func (m *MvccGetByStartTsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4877,7 +5533,7 @@ func (m *MvccGetByStartTsResponse) Reset()         { *m = MvccGetByStartTsRespon
 func (m *MvccGetByStartTsResponse) String() string { return proto.CompactTextString(m) }
 func (*MvccGetByStartTsResponse) ProtoMessage()    {}
 func (*MvccGetByStartTsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{71}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{79}
 }
 func (m *MvccGetByStartTsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4947,7 +5603,7 @@ func (m *SplitRegionRequest) Reset()         { *m = SplitRegionRequest{} }
 func (m *SplitRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitRegionRequest) ProtoMessage()    {}
 func (*SplitRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{72}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{80}
 }
 func (m *SplitRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5012,7 +5668,7 @@ func (m *SplitRegionResponse) Reset()         { *m = SplitRegionResponse{} }
 func (m *SplitRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*SplitRegionResponse) ProtoMessage()    {}
 func (*SplitRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{73}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{81}
 }
 func (m *SplitRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5071,6 +5727,147 @@ func (m *SplitRegionResponse) GetRegions() []*metapb.Region {
 	return nil
 }
 
+// GetRegionStats estimates the data in a range, so that clients can plan
+// splits with SplitRegionRequest. The range is clipped to the region, and its
+// start key must be inside it. The transactional keys and the raw keys of
+// every column family are counted apart in approximate_keys, so a key held
+// by several of them is counted once in each.
+type GetRegionStatsRequest struct {
+	Context *Context  `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Range   *KeyRange `protobuf:"bytes,2,opt,name=range" json:"range,omitempty"`
+	// If not 0, the response suggests keys splitting the range into chunks
+	// of about chunk_size bytes.
+	ChunkSize            uint64   `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegionStatsRequest) Reset()         { *m = GetRegionStatsRequest{} }
+func (m *GetRegionStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegionStatsRequest) ProtoMessage()    {}
+func (*GetRegionStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{82}
+}
+func (m *GetRegionStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRegionStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRegionStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetRegionStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionStatsRequest.Merge(dst, src)
+}
+func (m *GetRegionStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRegionStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegionStatsRequest proto.InternalMessageInfo
+
+func (m *GetRegionStatsRequest) GetContext() *Context {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *GetRegionStatsRequest) GetRange() *KeyRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *GetRegionStatsRequest) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+type GetRegionStatsResponse struct {
+	RegionError          *errorpb.Error `protobuf:"bytes,1,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	ApproximateSize      uint64         `protobuf:"varint,2,opt,name=approximate_size,json=approximateSize,proto3" json:"approximate_size,omitempty"`
+	ApproximateKeys      uint64         `protobuf:"varint,3,opt,name=approximate_keys,json=approximateKeys,proto3" json:"approximate_keys,omitempty"`
+	SplitKeys            [][]byte       `protobuf:"bytes,4,rep,name=split_keys,json=splitKeys" json:"split_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetRegionStatsResponse) Reset()         { *m = GetRegionStatsResponse{} }
+func (m *GetRegionStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegionStatsResponse) ProtoMessage()    {}
+func (*GetRegionStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{83}
+}
+func (m *GetRegionStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRegionStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRegionStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetRegionStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionStatsResponse.Merge(dst, src)
+}
+func (m *GetRegionStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRegionStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegionStatsResponse proto.InternalMessageInfo
+
+func (m *GetRegionStatsResponse) GetRegionError() *errorpb.Error {
+	if m != nil {
+		return m.RegionError
+	}
+	return nil
+}
+
+func (m *GetRegionStatsResponse) GetApproximateSize() uint64 {
+	if m != nil {
+		return m.ApproximateSize
+	}
+	return 0
+}
+
+func (m *GetRegionStatsResponse) GetApproximateKeys() uint64 {
+	if m != nil {
+		return m.ApproximateKeys
+	}
+	return 0
+}
+
+func (m *GetRegionStatsResponse) GetSplitKeys() [][]byte {
+	if m != nil {
+		return m.SplitKeys
+	}
+	return nil
+}
+
 type UnsafeDestroyRangeRequest struct {
 	Context              *Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
 	StartKey             []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
@@ -5084,7 +5881,7 @@ func (m *UnsafeDestroyRangeRequest) Reset()         { *m = UnsafeDestroyRangeReq
 func (m *UnsafeDestroyRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsafeDestroyRangeRequest) ProtoMessage()    {}
 func (*UnsafeDestroyRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{74}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{84}
 }
 func (m *UnsafeDestroyRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5146,7 +5943,7 @@ func (m *UnsafeDestroyRangeResponse) Reset()         { *m = UnsafeDestroyRangeRe
 func (m *UnsafeDestroyRangeResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsafeDestroyRangeResponse) ProtoMessage()    {}
 func (*UnsafeDestroyRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{75}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{85}
 }
 func (m *UnsafeDestroyRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5200,7 +5997,7 @@ func (m *ReadIndexRequest) Reset()         { *m = ReadIndexRequest{} }
 func (m *ReadIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadIndexRequest) ProtoMessage()    {}
 func (*ReadIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{76}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{86}
 }
 func (m *ReadIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5248,7 +6045,7 @@ func (m *ReadIndexResponse) Reset()         { *m = ReadIndexResponse{} }
 func (m *ReadIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadIndexResponse) ProtoMessage()    {}
 func (*ReadIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kvrpcpb_f2a9ed65272f7883, []int{77}
+	return fileDescriptor_kvrpcpb_399b45796d82b8de, []int{87}
 }
 func (m *ReadIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5307,6 +6104,7 @@ func init() {
 	proto.RegisterType((*ScanRequest)(nil), "kvrpcpb.ScanRequest")
 	proto.RegisterType((*KvPair)(nil), "kvrpcpb.KvPair")
 	proto.RegisterType((*ScanResponse)(nil), "kvrpcpb.ScanResponse")
+	proto.RegisterType((*ScanStreamResponse)(nil), "kvrpcpb.ScanStreamResponse")
 	proto.RegisterType((*Mutation)(nil), "kvrpcpb.Mutation")
 	proto.RegisterType((*PrewriteRequest)(nil), "kvrpcpb.PrewriteRequest")
 	proto.RegisterType((*PrewriteResponse)(nil), "kvrpcpb.PrewriteResponse")
@@ -5341,6 +6139,8 @@ func init() {
 	proto.RegisterType((*RawPutResponse)(nil), "kvrpcpb.RawPutResponse")
 	proto.RegisterType((*RawBatchPutRequest)(nil), "kvrpcpb.RawBatchPutRequest")
 	proto.RegisterType((*RawBatchPutResponse)(nil), "kvrpcpb.RawBatchPutResponse")
+	proto.RegisterType((*RawGetKeyTTLRequest)(nil), "kvrpcpb.RawGetKeyTTLRequest")
+	proto.RegisterType((*RawGetKeyTTLResponse)(nil), "kvrpcpb.RawGetKeyTTLResponse")
 	proto.RegisterType((*RawBatchGetRequest)(nil), "kvrpcpb.RawBatchGetRequest")
 	proto.RegisterType((*RawBatchGetResponse)(nil), "kvrpcpb.RawBatchGetResponse")
 	proto.RegisterType((*RawDeleteRequest)(nil), "kvrpcpb.RawDeleteRequest")
@@ -5349,13 +6149,18 @@ func init() {
 	proto.RegisterType((*RawBatchDeleteResponse)(nil), "kvrpcpb.RawBatchDeleteResponse")
 	proto.RegisterType((*DeleteRangeRequest)(nil), "kvrpcpb.DeleteRangeRequest")
 	proto.RegisterType((*DeleteRangeResponse)(nil), "kvrpcpb.DeleteRangeResponse")
+	proto.RegisterType((*ChecksumRequest)(nil), "kvrpcpb.ChecksumRequest")
+	proto.RegisterType((*ChecksumResponse)(nil), "kvrpcpb.ChecksumResponse")
 	proto.RegisterType((*RawDeleteRangeRequest)(nil), "kvrpcpb.RawDeleteRangeRequest")
 	proto.RegisterType((*RawDeleteRangeResponse)(nil), "kvrpcpb.RawDeleteRangeResponse")
 	proto.RegisterType((*RawScanRequest)(nil), "kvrpcpb.RawScanRequest")
 	proto.RegisterType((*RawScanResponse)(nil), "kvrpcpb.RawScanResponse")
+	proto.RegisterType((*RawScanStreamResponse)(nil), "kvrpcpb.RawScanStreamResponse")
 	proto.RegisterType((*KeyRange)(nil), "kvrpcpb.KeyRange")
 	proto.RegisterType((*RawBatchScanRequest)(nil), "kvrpcpb.RawBatchScanRequest")
 	proto.RegisterType((*RawBatchScanResponse)(nil), "kvrpcpb.RawBatchScanResponse")
+	proto.RegisterType((*RawCompareAndSwapRequest)(nil), "kvrpcpb.RawCompareAndSwapRequest")
+	proto.RegisterType((*RawCompareAndSwapResponse)(nil), "kvrpcpb.RawCompareAndSwapResponse")
 	proto.RegisterType((*MvccWrite)(nil), "kvrpcpb.MvccWrite")
 	proto.RegisterType((*MvccValue)(nil), "kvrpcpb.MvccValue")
 	proto.RegisterType((*MvccLock)(nil), "kvrpcpb.MvccLock")
@@ -5366,6 +6171,8 @@ func init() {
 	proto.RegisterType((*MvccGetByStartTsResponse)(nil), "kvrpcpb.MvccGetByStartTsResponse")
 	proto.RegisterType((*SplitRegionRequest)(nil), "kvrpcpb.SplitRegionRequest")
 	proto.RegisterType((*SplitRegionResponse)(nil), "kvrpcpb.SplitRegionResponse")
+	proto.RegisterType((*GetRegionStatsRequest)(nil), "kvrpcpb.GetRegionStatsRequest")
+	proto.RegisterType((*GetRegionStatsResponse)(nil), "kvrpcpb.GetRegionStatsResponse")
 	proto.RegisterType((*UnsafeDestroyRangeRequest)(nil), "kvrpcpb.UnsafeDestroyRangeRequest")
 	proto.RegisterType((*UnsafeDestroyRangeResponse)(nil), "kvrpcpb.UnsafeDestroyRangeResponse")
 	proto.RegisterType((*ReadIndexRequest)(nil), "kvrpcpb.ReadIndexRequest")
@@ -5374,6 +6181,7 @@ func init() {
 	proto.RegisterEnum("kvrpcpb.IsolationLevel", IsolationLevel_name, IsolationLevel_value)
 	proto.RegisterEnum("kvrpcpb.Op", Op_name, Op_value)
 	proto.RegisterEnum("kvrpcpb.Assertion", Assertion_name, Assertion_value)
+	proto.RegisterEnum("kvrpcpb.Action", Action_name, Action_value)
 }
 func (m *LockInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -6153,7 +6961,7 @@ func (m *ScanResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Mutation) Marshal() (dAtA []byte, err error) {
+func (m *ScanStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6163,32 +6971,38 @@ func (m *Mutation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Mutation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScanStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		dAtA[i] = 0x8
+	if m.RegionError != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Op))
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
+		n20, err := m.RegionError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if len(m.Pairs) > 0 {
+		for _, msg := range m.Pairs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKvrpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Value) > 0 {
+	if len(m.ResumeKey) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.Assertion != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Assertion))
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.ResumeKey)))
+		i += copy(dAtA[i:], m.ResumeKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6196,7 +7010,7 @@ func (m *Mutation) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PrewriteRequest) Marshal() (dAtA []byte, err error) {
+func (m *Mutation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6206,7 +7020,50 @@ func (m *PrewriteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrewriteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Mutation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Op != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Op))
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Assertion != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Assertion))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PrewriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrewriteRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6215,11 +7072,11 @@ func (m *PrewriteRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n20, err := m.Context.MarshalTo(dAtA[i:])
+		n21, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if len(m.Mutations) > 0 {
 		for _, msg := range m.Mutations {
@@ -6312,11 +7169,11 @@ func (m *PrewriteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n21, err := m.RegionError.MarshalTo(dAtA[i:])
+		n22, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if len(m.Errors) > 0 {
 		for _, msg := range m.Errors {
@@ -6355,11 +7212,11 @@ func (m *TxnHeartBeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n22, err := m.Context.MarshalTo(dAtA[i:])
+		n23, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	if len(m.PrimaryLock) > 0 {
 		dAtA[i] = 0x12
@@ -6402,21 +7259,21 @@ func (m *TxnHeartBeatResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n23, err := m.RegionError.MarshalTo(dAtA[i:])
+		n24, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n24, err := m.Error.MarshalTo(dAtA[i:])
+		n25, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.LockTtl != 0 {
 		dAtA[i] = 0x18
@@ -6448,11 +7305,11 @@ func (m *PessimisticLockRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n25, err := m.Context.MarshalTo(dAtA[i:])
+		n26, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
 	}
 	if len(m.Mutations) > 0 {
 		for _, msg := range m.Mutations {
@@ -6527,11 +7384,11 @@ func (m *PessimisticLockResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n26, err := m.RegionError.MarshalTo(dAtA[i:])
+		n27, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n27
 	}
 	if len(m.Errors) > 0 {
 		for _, msg := range m.Errors {
@@ -6570,11 +7427,11 @@ func (m *PessimisticRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n27, err := m.Context.MarshalTo(dAtA[i:])
+		n28, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n28
 	}
 	if m.StartVersion != 0 {
 		dAtA[i] = 0x10
@@ -6619,11 +7476,11 @@ func (m *PessimisticRollbackResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n28, err := m.RegionError.MarshalTo(dAtA[i:])
+		n29, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n29
 	}
 	if len(m.Errors) > 0 {
 		for _, msg := range m.Errors {
@@ -6662,11 +7519,11 @@ func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n29, err := m.Context.MarshalTo(dAtA[i:])
+		n30, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	if m.StartVersion != 0 {
 		dAtA[i] = 0x10
@@ -6711,21 +7568,21 @@ func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n30, err := m.RegionError.MarshalTo(dAtA[i:])
+		n31, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n31
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n31, err := m.Error.MarshalTo(dAtA[i:])
+		n32, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n32
 	}
 	if m.CommitVersion != 0 {
 		dAtA[i] = 0x18
@@ -6795,11 +7652,11 @@ func (m *ImportResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n32, err := m.RegionError.MarshalTo(dAtA[i:])
+		n33, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n33
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -6832,11 +7689,11 @@ func (m *BatchRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n33, err := m.Context.MarshalTo(dAtA[i:])
+		n34, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	if m.StartVersion != 0 {
 		dAtA[i] = 0x10
@@ -6876,21 +7733,21 @@ func (m *BatchRollbackResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n34, err := m.RegionError.MarshalTo(dAtA[i:])
+		n35, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n35
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n35, err := m.Error.MarshalTo(dAtA[i:])
+		n36, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n36
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6917,11 +7774,11 @@ func (m *CheckTxnStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n36, err := m.Context.MarshalTo(dAtA[i:])
+		n37, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n37
 	}
 	if len(m.PrimaryKey) > 0 {
 		dAtA[i] = 0x12
@@ -6969,21 +7826,21 @@ func (m *CheckTxnStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n37, err := m.RegionError.MarshalTo(dAtA[i:])
+		n38, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n38
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n38, err := m.Error.MarshalTo(dAtA[i:])
+		n39, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n39
 	}
 	if m.LockTtl != 0 {
 		dAtA[i] = 0x18
@@ -6995,6 +7852,11 @@ func (m *CheckTxnStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.CommitVersion))
 	}
+	if m.Action != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Action))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -7020,11 +7882,11 @@ func (m *CleanupRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n39, err := m.Context.MarshalTo(dAtA[i:])
+		n40, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
 	}
 	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
@@ -7067,21 +7929,21 @@ func (m *CleanupResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n40, err := m.RegionError.MarshalTo(dAtA[i:])
+		n41, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n41
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n41, err := m.Error.MarshalTo(dAtA[i:])
+		n42, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n42
 	}
 	if m.CommitVersion != 0 {
 		dAtA[i] = 0x18
@@ -7113,11 +7975,11 @@ func (m *BatchGetRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n42, err := m.Context.MarshalTo(dAtA[i:])
+		n43, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n43
 	}
 	if len(m.Keys) > 0 {
 		for _, b := range m.Keys {
@@ -7157,11 +8019,11 @@ func (m *BatchGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n43, err := m.RegionError.MarshalTo(dAtA[i:])
+		n44, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n44
 	}
 	if len(m.Pairs) > 0 {
 		for _, msg := range m.Pairs {
@@ -7200,11 +8062,11 @@ func (m *ScanLockRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n44, err := m.Context.MarshalTo(dAtA[i:])
+		n45, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n45
 	}
 	if m.MaxVersion != 0 {
 		dAtA[i] = 0x10
@@ -7247,21 +8109,21 @@ func (m *ScanLockResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n45, err := m.RegionError.MarshalTo(dAtA[i:])
+		n46, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n46
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n46, err := m.Error.MarshalTo(dAtA[i:])
+		n47, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n47
 	}
 	if len(m.Locks) > 0 {
 		for _, msg := range m.Locks {
@@ -7331,11 +8193,11 @@ func (m *ResolveLockRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n47, err := m.Context.MarshalTo(dAtA[i:])
+		n48, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n48
 	}
 	if m.StartVersion != 0 {
 		dAtA[i] = 0x10
@@ -7392,21 +8254,21 @@ func (m *ResolveLockResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n48, err := m.RegionError.MarshalTo(dAtA[i:])
+		n49, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n49
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n49, err := m.Error.MarshalTo(dAtA[i:])
+		n50, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n50
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7433,11 +8295,11 @@ func (m *GCRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n50, err := m.Context.MarshalTo(dAtA[i:])
+		n51, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n51
 	}
 	if m.SafePoint != 0 {
 		dAtA[i] = 0x10
@@ -7469,21 +8331,21 @@ func (m *GCResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n51, err := m.RegionError.MarshalTo(dAtA[i:])
+		n52, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n52
 	}
 	if m.Error != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
-		n52, err := m.Error.MarshalTo(dAtA[i:])
+		n53, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n53
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7510,11 +8372,11 @@ func (m *RawGetRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n53, err := m.Context.MarshalTo(dAtA[i:])
+		n54, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n54
 	}
 	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
@@ -7553,11 +8415,11 @@ func (m *RawGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n54, err := m.RegionError.MarshalTo(dAtA[i:])
+		n55, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n55
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -7606,11 +8468,11 @@ func (m *RawPutRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n55, err := m.Context.MarshalTo(dAtA[i:])
+		n56, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n56
 	}
 	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
@@ -7630,6 +8492,11 @@ func (m *RawPutRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Cf)))
 		i += copy(dAtA[i:], m.Cf)
 	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Ttl))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -7655,11 +8522,11 @@ func (m *RawPutResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n56, err := m.RegionError.MarshalTo(dAtA[i:])
+		n57, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n57
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -7692,11 +8559,11 @@ func (m *RawBatchPutRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n57, err := m.Context.MarshalTo(dAtA[i:])
+		n58, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n58
 	}
 	if len(m.Pairs) > 0 {
 		for _, msg := range m.Pairs {
@@ -7716,6 +8583,23 @@ func (m *RawBatchPutRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Cf)))
 		i += copy(dAtA[i:], m.Cf)
 	}
+	if len(m.Ttls) > 0 {
+		dAtA60 := make([]byte, len(m.Ttls)*10)
+		var j59 int
+		for _, num := range m.Ttls {
+			for num >= 1<<7 {
+				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j59++
+			}
+			dAtA60[j59] = uint8(num)
+			j59++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(j59))
+		i += copy(dAtA[i:], dAtA60[:j59])
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -7741,11 +8625,91 @@ func (m *RawBatchPutResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n58, err := m.RegionError.MarshalTo(dAtA[i:])
+		n61, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n61
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RawGetKeyTTLRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawGetKeyTTLRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Context != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
+		n62, err := m.Context.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RawGetKeyTTLResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawGetKeyTTLResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
+		n63, err := m.RegionError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -7753,6 +8717,21 @@ func (m *RawBatchPutResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Error)))
 		i += copy(dAtA[i:], m.Error)
 	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Ttl))
+	}
+	if m.NotFound {
+		dAtA[i] = 0x20
+		i++
+		if m.NotFound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -7778,11 +8757,11 @@ func (m *RawBatchGetRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n59, err := m.Context.MarshalTo(dAtA[i:])
+		n64, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n64
 	}
 	if len(m.Keys) > 0 {
 		for _, b := range m.Keys {
@@ -7823,11 +8802,11 @@ func (m *RawBatchGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n60, err := m.RegionError.MarshalTo(dAtA[i:])
+		n65, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n65
 	}
 	if len(m.Pairs) > 0 {
 		for _, msg := range m.Pairs {
@@ -7866,11 +8845,11 @@ func (m *RawDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n61, err := m.Context.MarshalTo(dAtA[i:])
+		n66, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n66
 	}
 	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
@@ -7909,11 +8888,11 @@ func (m *RawDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n62, err := m.RegionError.MarshalTo(dAtA[i:])
+		n67, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n67
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -7946,11 +8925,11 @@ func (m *RawBatchDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n63, err := m.Context.MarshalTo(dAtA[i:])
+		n68, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n68
 	}
 	if len(m.Keys) > 0 {
 		for _, b := range m.Keys {
@@ -7991,11 +8970,11 @@ func (m *RawBatchDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n64, err := m.RegionError.MarshalTo(dAtA[i:])
+		n69, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n69
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -8028,11 +9007,11 @@ func (m *DeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n65, err := m.Context.MarshalTo(dAtA[i:])
+		n70, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n70
 	}
 	if len(m.StartKey) > 0 {
 		dAtA[i] = 0x12
@@ -8081,11 +9060,11 @@ func (m *DeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n66, err := m.RegionError.MarshalTo(dAtA[i:])
+		n71, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n71
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -8099,7 +9078,7 @@ func (m *DeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RawDeleteRangeRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChecksumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8109,7 +9088,7 @@ func (m *RawDeleteRangeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawDeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -8118,26 +9097,128 @@ func (m *RawDeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n67, err := m.Context.MarshalTo(dAtA[i:])
+		n72, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n72
 	}
-	if len(m.StartKey) > 0 {
-		dAtA[i] = 0x12
+	if m.Version != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.StartKey)))
-		i += copy(dAtA[i:], m.StartKey)
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Version))
 	}
-	if len(m.EndKey) > 0 {
+	if m.Range != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.EndKey)))
-		i += copy(dAtA[i:], m.EndKey)
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Range.Size()))
+		n73, err := m.Range.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
 	}
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0x22
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
+		n74, err := m.RegionError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Error.Size()))
+		n75, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
+	if m.Crc64Xor != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Crc64Xor))
+	}
+	if m.TotalKvs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.TotalKvs))
+	}
+	if m.TotalBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.TotalBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RawDeleteRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawDeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Context != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
+		n76, err := m.Context.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
+	}
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0x22
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Cf)))
 		i += copy(dAtA[i:], m.Cf)
@@ -8167,11 +9248,11 @@ func (m *RawDeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n68, err := m.RegionError.MarshalTo(dAtA[i:])
+		n77, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n77
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -8204,11 +9285,11 @@ func (m *RawScanRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n69, err := m.Context.MarshalTo(dAtA[i:])
+		n78, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n78
 	}
 	if len(m.StartKey) > 0 {
 		dAtA[i] = 0x12
@@ -8278,11 +9359,54 @@ func (m *RawScanResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n70, err := m.RegionError.MarshalTo(dAtA[i:])
+		n79, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n79
+	}
+	if len(m.Kvs) > 0 {
+		for _, msg := range m.Kvs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKvrpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RawScanStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawScanStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
+		n80, err := m.RegionError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
 	}
 	if len(m.Kvs) > 0 {
 		for _, msg := range m.Kvs {
@@ -8296,6 +9420,12 @@ func (m *RawScanResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ResumeKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.ResumeKey)))
+		i += copy(dAtA[i:], m.ResumeKey)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -8354,11 +9484,11 @@ func (m *RawBatchScanRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n71, err := m.Context.MarshalTo(dAtA[i:])
+		n81, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n81
 	}
 	if len(m.Ranges) > 0 {
 		for _, msg := range m.Ranges {
@@ -8428,11 +9558,11 @@ func (m *RawBatchScanResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n72, err := m.RegionError.MarshalTo(dAtA[i:])
+		n82, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n82
 	}
 	if len(m.Kvs) > 0 {
 		for _, msg := range m.Kvs {
@@ -8452,6 +9582,139 @@ func (m *RawBatchScanResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RawCompareAndSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawCompareAndSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Context != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
+		n83, err := m.Context.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.PreviousNotExist {
+		dAtA[i] = 0x20
+		i++
+		if m.PreviousNotExist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PreviousValue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.PreviousValue)))
+		i += copy(dAtA[i:], m.PreviousValue)
+	}
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Ttl))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RawCompareAndSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawCompareAndSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
+		n84, err := m.RegionError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Succeed {
+		dAtA[i] = 0x18
+		i++
+		if m.Succeed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PreviousNotExist {
+		dAtA[i] = 0x20
+		i++
+		if m.PreviousNotExist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PreviousValue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.PreviousValue)))
+		i += copy(dAtA[i:], m.PreviousValue)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *MvccWrite) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8588,11 +9851,11 @@ func (m *MvccInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Lock.Size()))
-		n73, err := m.Lock.MarshalTo(dAtA[i:])
+		n85, err := m.Lock.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n85
 	}
 	if len(m.Writes) > 0 {
 		for _, msg := range m.Writes {
@@ -8643,11 +9906,11 @@ func (m *MvccGetByKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n74, err := m.Context.MarshalTo(dAtA[i:])
+		n86, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n86
 	}
 	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
@@ -8680,11 +9943,11 @@ func (m *MvccGetByKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n75, err := m.RegionError.MarshalTo(dAtA[i:])
+		n87, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n87
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -8696,11 +9959,11 @@ func (m *MvccGetByKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Info.Size()))
-		n76, err := m.Info.MarshalTo(dAtA[i:])
+		n88, err := m.Info.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n88
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8727,11 +9990,11 @@ func (m *MvccGetByStartTsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n77, err := m.Context.MarshalTo(dAtA[i:])
+		n89, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n89
 	}
 	if m.StartTs != 0 {
 		dAtA[i] = 0x10
@@ -8763,11 +10026,11 @@ func (m *MvccGetByStartTsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n78, err := m.RegionError.MarshalTo(dAtA[i:])
+		n90, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n90
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -8785,11 +10048,11 @@ func (m *MvccGetByStartTsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Info.Size()))
-		n79, err := m.Info.MarshalTo(dAtA[i:])
+		n91, err := m.Info.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n91
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8816,11 +10079,11 @@ func (m *SplitRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n80, err := m.Context.MarshalTo(dAtA[i:])
+		n92, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n92
 	}
 	if len(m.SplitKey) > 0 {
 		dAtA[i] = 0x12
@@ -8861,31 +10124,31 @@ func (m *SplitRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n81, err := m.RegionError.MarshalTo(dAtA[i:])
+		n93, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n93
 	}
 	if m.Left != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Left.Size()))
-		n82, err := m.Left.MarshalTo(dAtA[i:])
+		n94, err := m.Left.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n94
 	}
 	if m.Right != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Right.Size()))
-		n83, err := m.Right.MarshalTo(dAtA[i:])
+		n95, err := m.Right.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n95
 	}
 	if len(m.Regions) > 0 {
 		for _, msg := range m.Regions {
@@ -8905,6 +10168,101 @@ func (m *SplitRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GetRegionStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRegionStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Context != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
+		n96, err := m.Context.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n96
+	}
+	if m.Range != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Range.Size()))
+		n97, err := m.Range.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n97
+	}
+	if m.ChunkSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.ChunkSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetRegionStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRegionStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
+		n98, err := m.RegionError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n98
+	}
+	if m.ApproximateSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.ApproximateSize))
+	}
+	if m.ApproximateKeys != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.ApproximateKeys))
+	}
+	if len(m.SplitKeys) > 0 {
+		for _, b := range m.SplitKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintKvrpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *UnsafeDestroyRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8924,11 +10282,11 @@ func (m *UnsafeDestroyRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n84, err := m.Context.MarshalTo(dAtA[i:])
+		n99, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n99
 	}
 	if len(m.StartKey) > 0 {
 		dAtA[i] = 0x12
@@ -8967,11 +10325,11 @@ func (m *UnsafeDestroyRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n85, err := m.RegionError.MarshalTo(dAtA[i:])
+		n100, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n100
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x12
@@ -9004,11 +10362,11 @@ func (m *ReadIndexRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Context.Size()))
-		n86, err := m.Context.MarshalTo(dAtA[i:])
+		n101, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n101
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9035,11 +10393,11 @@ func (m *ReadIndexResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.RegionError.Size()))
-		n87, err := m.RegionError.MarshalTo(dAtA[i:])
+		n102, err := m.RegionError.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n102
 	}
 	if m.ReadIndex != 0 {
 		dAtA[i] = 0x10
@@ -9417,6 +10775,29 @@ func (m *ScanResponse) Size() (n int) {
 	return n
 }
 
+func (m *ScanStreamResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		l = m.RegionError.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovKvrpcpb(uint64(l))
+		}
+	}
+	l = len(m.ResumeKey)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Mutation) Size() (n int) {
 	var l int
 	_ = l
@@ -9809,6 +11190,9 @@ func (m *CheckTxnStatusResponse) Size() (n int) {
 	if m.CommitVersion != 0 {
 		n += 1 + sovKvrpcpb(uint64(m.CommitVersion))
 	}
+	if m.Action != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Action))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10105,6 +11489,9 @@ func (m *RawPutRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
+	if m.Ttl != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Ttl))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10145,6 +11532,13 @@ func (m *RawBatchPutRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
+	if len(m.Ttls) > 0 {
+		l = 0
+		for _, e := range m.Ttls {
+			l += sovKvrpcpb(uint64(e))
+		}
+		n += 1 + sovKvrpcpb(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10168,6 +11562,50 @@ func (m *RawBatchPutResponse) Size() (n int) {
 	return n
 }
 
+func (m *RawGetKeyTTLRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RawGetKeyTTLResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		l = m.RegionError.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Ttl))
+	}
+	if m.NotFound {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RawBatchGetRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -10329,6 +11767,52 @@ func (m *DeleteRangeResponse) Size() (n int) {
 	return n
 }
 
+func (m *ChecksumRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Version))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChecksumResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		l = m.RegionError.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Crc64Xor != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Crc64Xor))
+	}
+	if m.TotalKvs != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.TotalKvs))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.TotalBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RawDeleteRangeRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -10424,6 +11908,29 @@ func (m *RawScanResponse) Size() (n int) {
 	return n
 }
 
+func (m *RawScanStreamResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		l = m.RegionError.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if len(m.Kvs) > 0 {
+		for _, e := range m.Kvs {
+			l = e.Size()
+			n += 1 + l + sovKvrpcpb(uint64(l))
+		}
+	}
+	l = len(m.ResumeKey)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *KeyRange) Size() (n int) {
 	var l int
 	_ = l
@@ -10492,35 +11999,97 @@ func (m *RawBatchScanResponse) Size() (n int) {
 	return n
 }
 
-func (m *MvccWrite) Size() (n int) {
+func (m *RawCompareAndSwapRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovKvrpcpb(uint64(m.Type))
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
-	if m.StartTs != 0 {
-		n += 1 + sovKvrpcpb(uint64(m.StartTs))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovKvrpcpb(uint64(m.CommitTs))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
-	l = len(m.ShortValue)
+	if m.PreviousNotExist {
+		n += 2
+	}
+	l = len(m.PreviousValue)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	l = len(m.Cf)
 	if l > 0 {
 		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
+	if m.Ttl != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Ttl))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MvccValue) Size() (n int) {
+func (m *RawCompareAndSwapResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.StartTs != 0 {
-		n += 1 + sovKvrpcpb(uint64(m.StartTs))
+	if m.RegionError != nil {
+		l = m.RegionError.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
-	l = len(m.Value)
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Succeed {
+		n += 2
+	}
+	if m.PreviousNotExist {
+		n += 2
+	}
+	l = len(m.PreviousValue)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MvccWrite) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Type))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.CommitTs))
+	}
+	l = len(m.ShortValue)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MvccValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.StartTs))
+	}
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovKvrpcpb(uint64(l))
 	}
@@ -10707,6 +12276,51 @@ func (m *SplitRegionResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetRegionStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.ChunkSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRegionStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionError != nil {
+		l = m.RegionError.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.ApproximateSize != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.ApproximateSize))
+	}
+	if m.ApproximateKeys != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.ApproximateKeys))
+	}
+	if len(m.SplitKeys) > 0 {
+		for _, b := range m.SplitKeys {
+			l = len(b)
+			n += 1 + l + sovKvrpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *UnsafeDestroyRangeRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -13176,6 +14790,152 @@ func (m *ScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScanStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScanStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScanStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
+			}
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &KvPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeKey = append(m.ResumeKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeKey == nil {
+				m.ResumeKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Mutation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15732,6 +17492,25 @@ func (m *CheckTxnStatusResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= (Action(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -17660,6 +19439,25 @@ func (m *RawPutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Cf = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -17917,11 +19715,73 @@ func (m *RawBatchPutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Cf = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKvrpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ttls = append(m.Ttls, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKvrpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthKvrpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKvrpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ttls = append(m.Ttls, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttls", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthKvrpcpb
@@ -18052,7 +19912,7 @@ func (m *RawBatchPutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBatchGetRequest) Unmarshal(dAtA []byte) error {
+func (m *RawGetKeyTTLRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18075,10 +19935,10 @@ func (m *RawBatchGetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBatchGetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawGetKeyTTLRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBatchGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawGetKeyTTLRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18116,7 +19976,7 @@ func (m *RawBatchGetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -18140,8 +20000,10 @@ func (m *RawBatchGetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
-			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -18194,7 +20056,7 @@ func (m *RawBatchGetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBatchGetResponse) Unmarshal(dAtA []byte) error {
+func (m *RawGetKeyTTLResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18217,10 +20079,10 @@ func (m *RawBatchGetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBatchGetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawGetKeyTTLResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBatchGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawGetKeyTTLResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18258,9 +20120,9 @@ func (m *RawBatchGetResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -18270,23 +20132,60 @@ func (m *RawBatchGetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, &KvPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotFound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -18309,7 +20208,7 @@ func (m *RawBatchGetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *RawBatchGetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18332,10 +20231,10 @@ func (m *RawDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawBatchGetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawBatchGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18373,7 +20272,7 @@ func (m *RawDeleteRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -18397,10 +20296,8 @@ func (m *RawDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -18453,7 +20350,7 @@ func (m *RawDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *RawBatchGetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18476,10 +20373,10 @@ func (m *RawDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawBatchGetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawBatchGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18517,9 +20414,9 @@ func (m *RawDeleteResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -18529,20 +20426,22 @@ func (m *RawDeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Pairs = append(m.Pairs, &KvPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18566,7 +20465,7 @@ func (m *RawDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBatchDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *RawDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18589,10 +20488,10 @@ func (m *RawBatchDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBatchDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBatchDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18630,7 +20529,7 @@ func (m *RawBatchDeleteRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -18654,8 +20553,10 @@ func (m *RawBatchDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
-			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -18708,7 +20609,7 @@ func (m *RawBatchDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBatchDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *RawDeleteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18731,10 +20632,10 @@ func (m *RawBatchDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBatchDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawDeleteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBatchDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18821,7 +20722,7 @@ func (m *RawBatchDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *RawBatchDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18844,10 +20745,10 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawBatchDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawBatchDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18885,7 +20786,7 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -18909,16 +20810,14 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
-			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -18928,43 +20827,21 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
-			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotifyOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.NotifyOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -18987,7 +20864,7 @@ func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *RawBatchDeleteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19010,10 +20887,10 @@ func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawBatchDeleteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawBatchDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19100,7 +20977,7 @@ func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawDeleteRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19123,10 +21000,10 @@ func (m *RawDeleteRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawDeleteRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawDeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19225,10 +21102,10 @@ func (m *RawDeleteRangeRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifyOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -19238,21 +21115,12 @@ func (m *RawDeleteRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.NotifyOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -19275,7 +21143,7 @@ func (m *RawDeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawDeleteRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19298,10 +21166,10 @@ func (m *RawDeleteRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawDeleteRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawDeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19388,7 +21256,7 @@ func (m *RawDeleteRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
+func (m *ChecksumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19411,10 +21279,10 @@ func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChecksumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19451,60 +21319,10 @@ func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -19514,17 +21332,16 @@ func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KeyOnly = bool(v != 0)
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -19534,70 +21351,23 @@ func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Range == nil {
+				m.Range = &KeyRange{}
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -19622,7 +21392,7 @@ func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawScanResponse) Unmarshal(dAtA []byte) error {
+func (m *ChecksumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19645,10 +21415,10 @@ func (m *RawScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChecksumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19686,7 +21456,7 @@ func (m *RawScanResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19710,11 +21480,70 @@ func (m *RawScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kvs = append(m.Kvs, &KvPair{})
-			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Error == nil {
+				m.Error = &KeyError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Crc64Xor", wireType)
+			}
+			m.Crc64Xor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Crc64Xor |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalKvs", wireType)
+			}
+			m.TotalKvs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalKvs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -19737,7 +21566,7 @@ func (m *RawScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyRange) Unmarshal(dAtA []byte) error {
+func (m *RawDeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19760,13 +21589,46 @@ func (m *KeyRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawDeleteRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawDeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &Context{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
@@ -19797,7 +21659,7 @@ func (m *KeyRange) Unmarshal(dAtA []byte) error {
 				m.StartKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
@@ -19828,20 +21690,49 @@ func (m *KeyRange) Unmarshal(dAtA []byte) error {
 				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
 
@@ -19850,7 +21741,7 @@ func (m *KeyRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
+func (m *RawDeleteRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19873,15 +21764,15 @@ func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBatchScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawDeleteRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBatchScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawDeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19905,16 +21796,96 @@ func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &Context{}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawScanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawScanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19938,16 +21909,49 @@ func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ranges = append(m.Ranges, &KeyRange{})
-			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Context == nil {
+				m.Context = &Context{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EachLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.EachLimit = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -19957,7 +21961,7 @@ func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EachLimit |= (uint32(b) & 0x7F) << shift
+				m.Limit |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20031,6 +22035,1297 @@ func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Reverse = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawScanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawScanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
+			}
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kvs = append(m.Kvs, &KvPair{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawScanStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawScanStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawScanStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
+			}
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kvs = append(m.Kvs, &KvPair{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeKey = append(m.ResumeKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeKey == nil {
+				m.ResumeKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawBatchScanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawBatchScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &Context{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranges = append(m.Ranges, &KeyRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EachLimit", wireType)
+			}
+			m.EachLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EachLimit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyOnly = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawBatchScanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawBatchScanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawBatchScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
+			}
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kvs = append(m.Kvs, &KvPair{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawCompareAndSwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawCompareAndSwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawCompareAndSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &Context{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNotExist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreviousNotExist = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValue = append(m.PreviousValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousValue == nil {
+				m.PreviousValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawCompareAndSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawCompareAndSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawCompareAndSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
+			}
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNotExist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreviousNotExist = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValue = append(m.PreviousValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousValue == nil {
+				m.PreviousValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MvccWrite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MvccWrite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MvccWrite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Op(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortValue = append(m.ShortValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShortValue == nil {
+				m.ShortValue = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -20053,7 +23348,7 @@ func (m *RawBatchScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBatchScanResponse) Unmarshal(dAtA []byte) error {
+func (m *MvccValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20076,17 +23371,17 @@ func (m *RawBatchScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBatchScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBatchScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var msglen int
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20096,30 +23391,16 @@ func (m *RawBatchScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RegionError == nil {
-				m.RegionError = &errorpb.Error{}
-			}
-			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20129,21 +23410,21 @@ func (m *RawBatchScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kvs = append(m.Kvs, &KvPair{})
-			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -20168,7 +23449,7 @@ func (m *RawBatchScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccWrite) Unmarshal(dAtA []byte) error {
+func (m *MvccLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20191,10 +23472,10 @@ func (m *MvccWrite) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccWrite: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccWrite: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20236,10 +23517,10 @@ func (m *MvccWrite) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
 			}
-			m.CommitTs = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20249,11 +23530,23 @@ func (m *MvccWrite) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Primary = append(m.Primary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Primary == nil {
+				m.Primary = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShortValue", wireType)
@@ -20307,7 +23600,7 @@ func (m *MvccWrite) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccValue) Unmarshal(dAtA []byte) error {
+func (m *MvccInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20330,17 +23623,17 @@ func (m *MvccValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
-			m.StartTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20350,16 +23643,30 @@ func (m *MvccValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lock == nil {
+				m.Lock = &MvccLock{}
+			}
+			if err := m.Lock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20369,21 +23676,52 @@ func (m *MvccValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Writes = append(m.Writes, &MvccWrite{})
+			if err := m.Writes[len(m.Writes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &MvccValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -20408,7 +23746,7 @@ func (m *MvccValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccLock) Unmarshal(dAtA []byte) error {
+func (m *MvccGetByKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20431,55 +23769,17 @@ func (m *MvccLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccGetByKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccGetByKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (Op(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
-			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKvrpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20489,26 +23789,28 @@ func (m *MvccLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Primary = append(m.Primary[:0], dAtA[iNdEx:postIndex]...)
-			if m.Primary == nil {
-				m.Primary = []byte{}
+			if m.Context == nil {
+				m.Context = &Context{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -20532,9 +23834,9 @@ func (m *MvccLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortValue = append(m.ShortValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.ShortValue == nil {
-				m.ShortValue = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -20559,7 +23861,7 @@ func (m *MvccLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccInfo) Unmarshal(dAtA []byte) error {
+func (m *MvccGetByKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20582,15 +23884,15 @@ func (m *MvccInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccGetByKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccGetByKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20614,18 +23916,18 @@ func (m *MvccInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lock == nil {
-				m.Lock = &MvccLock{}
+			if m.RegionError == nil {
+				m.RegionError = &errorpb.Error{}
 			}
-			if err := m.Lock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegionError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20635,26 +23937,24 @@ func (m *MvccInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Writes = append(m.Writes, &MvccWrite{})
-			if err := m.Writes[len(m.Writes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20678,8 +23978,10 @@ func (m *MvccInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &MvccValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Info == nil {
+				m.Info = &MvccInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20705,7 +24007,7 @@ func (m *MvccInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccGetByKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *MvccGetByStartTsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20728,10 +24030,10 @@ func (m *MvccGetByKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccGetByKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccGetByStartTsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccGetByKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccGetByStartTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20768,10 +24070,10 @@ func (m *MvccGetByKeyRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var byteLen int
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -20781,23 +24083,11 @@ func (m *MvccGetByKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -20820,7 +24110,7 @@ func (m *MvccGetByKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccGetByKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20843,10 +24133,10 @@ func (m *MvccGetByKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccGetByKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MvccGetByStartTsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccGetByKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MvccGetByStartTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20912,6 +24202,37 @@ func (m *MvccGetByKeyResponse) Unmarshal(dAtA []byte) error {
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
@@ -20966,7 +24287,7 @@ func (m *MvccGetByKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccGetByStartTsRequest) Unmarshal(dAtA []byte) error {
+func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20989,10 +24310,10 @@ func (m *MvccGetByStartTsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccGetByStartTsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccGetByStartTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21029,10 +24350,10 @@ func (m *MvccGetByStartTsRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
 			}
-			m.StartTs = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21042,11 +24363,52 @@ func (m *MvccGetByStartTsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKeys = append(m.SplitKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SplitKeys[len(m.SplitKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -21069,7 +24431,7 @@ func (m *MvccGetByStartTsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
+func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21092,10 +24454,10 @@ func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MvccGetByStartTsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitRegionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MvccGetByStartTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21133,9 +24495,9 @@ func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21145,26 +24507,30 @@ func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if m.Left == nil {
+				m.Left = &metapb.Region{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21174,26 +24540,28 @@ func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.Right == nil {
+				m.Right = &metapb.Region{}
+			}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21217,10 +24585,8 @@ func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &MvccInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21246,7 +24612,7 @@ func (m *MvccGetByStartTsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21269,10 +24635,10 @@ func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRegionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21310,9 +24676,9 @@ func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21322,28 +24688,30 @@ func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
+			if m.Range == nil {
+				m.Range = &KeyRange{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
 			}
-			var byteLen int
+			m.ChunkSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21353,21 +24721,11 @@ func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.ChunkSize |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SplitKeys = append(m.SplitKeys, make([]byte, postIndex-iNdEx))
-			copy(m.SplitKeys[len(m.SplitKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
@@ -21390,7 +24748,7 @@ func (m *SplitRegionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRegionStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21413,10 +24771,10 @@ func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRegionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21453,10 +24811,10 @@ func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
 			}
-			var msglen int
+			m.ApproximateSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21466,30 +24824,16 @@ func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Left == nil {
-				m.Left = &metapb.Region{}
-			}
-			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
 			}
-			var msglen int
+			m.ApproximateKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21499,30 +24843,16 @@ func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ApproximateKeys |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKvrpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Right == nil {
-				m.Right = &metapb.Region{}
-			}
-			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKeys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKvrpcpb
@@ -21532,22 +24862,20 @@ func (m *SplitRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKvrpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SplitKeys = append(m.SplitKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SplitKeys[len(m.SplitKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22122,179 +25450,204 @@ var (
 	ErrIntOverflowKvrpcpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("kvrpcpb.proto", fileDescriptor_kvrpcpb_f2a9ed65272f7883) }
-
-var fileDescriptor_kvrpcpb_f2a9ed65272f7883 = []byte{
-	// 2732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcd, 0x8f, 0x1c, 0x47,
-	0xf5, 0xee, 0x9e, 0xaf, 0x9e, 0x37, 0x33, 0xbb, 0xe3, 0xda, 0xb5, 0x3d, 0xb1, 0x7f, 0xb6, 0x37,
-	0xfd, 0xc3, 0xce, 0x66, 0x21, 0x1b, 0xd8, 0x44, 0x1c, 0x10, 0x8a, 0x82, 0xd7, 0x4e, 0xbc, 0xb1,
-	0x1d, 0xaf, 0x6a, 0x27, 0x46, 0x91, 0x80, 0x49, 0xb9, 0xa7, 0x76, 0xa6, 0xd9, 0x9e, 0xee, 0x4e,
-	0x57, 0xcd, 0xee, 0x4e, 0x22, 0x24, 0x10, 0x02, 0x11, 0x09, 0x0e, 0x7c, 0x48, 0xc9, 0x21, 0xd7,
-	0x48, 0x70, 0xe4, 0xc2, 0x1f, 0x00, 0x39, 0x70, 0x41, 0xe4, 0xc0, 0x81, 0x23, 0x0a, 0x07, 0xfe,
-	0x0d, 0x54, 0x55, 0x5d, 0xfd, 0x31, 0x33, 0x4e, 0x56, 0xcd, 0xec, 0x06, 0x71, 0x9a, 0xa9, 0xf7,
-	0x5e, 0xd7, 0xfb, 0x7e, 0xf5, 0xea, 0x03, 0x5a, 0x07, 0x87, 0x51, 0xe8, 0x84, 0x8f, 0x37, 0xc3,
-	0x28, 0xe0, 0x01, 0xaa, 0xc5, 0xc3, 0xcb, 0xcd, 0x11, 0xe5, 0x44, 0x83, 0x2f, 0xb7, 0x68, 0x14,
-	0x05, 0x51, 0x32, 0x5c, 0x1d, 0x04, 0x83, 0x40, 0xfe, 0x7d, 0x5e, 0xfc, 0x8b, 0xa1, 0xcb, 0xd1,
-	0x98, 0x71, 0xf9, 0x57, 0x01, 0xec, 0x3f, 0x1a, 0x60, 0xdd, 0x0f, 0x9c, 0x83, 0x1d, 0x7f, 0x3f,
-	0x40, 0x4f, 0x43, 0x33, 0x8c, 0xdc, 0x11, 0x89, 0x26, 0x3d, 0x2f, 0x70, 0x0e, 0x3a, 0xc6, 0x9a,
-	0xb1, 0xde, 0xc4, 0x8d, 0x18, 0x26, 0xc8, 0x04, 0x89, 0x40, 0xf5, 0x0e, 0x69, 0xc4, 0xdc, 0xc0,
-	0xef, 0x98, 0x6b, 0xc6, 0x7a, 0x19, 0x37, 0x04, 0xec, 0x91, 0x02, 0xa1, 0x36, 0x94, 0x0e, 0xe8,
-	0xa4, 0x53, 0x92, 0x1f, 0x8b, 0xbf, 0xe8, 0x29, 0xb0, 0xe4, 0x47, 0x9c, 0x7b, 0x9d, 0xb2, 0xfc,
-	0xa0, 0x26, 0xc6, 0x5d, 0xee, 0x09, 0x14, 0x3f, 0xf6, 0x7b, 0xcc, 0x7d, 0x87, 0x76, 0x2a, 0x0a,
-	0xc5, 0x8f, 0xfd, 0x3d, 0xf7, 0x1d, 0x8a, 0xd6, 0xa1, 0xae, 0xbe, 0x9a, 0x84, 0xb4, 0x53, 0x5d,
-	0x33, 0xd6, 0x97, 0xb6, 0x1a, 0x9b, 0xda, 0x14, 0x0f, 0x43, 0x2c, 0xe7, 0xec, 0x4e, 0x42, 0x6a,
-	0xaf, 0x41, 0xf3, 0x5b, 0x5e, 0x44, 0x49, 0x7f, 0x72, 0xe7, 0xd8, 0x65, 0x5c, 0x4b, 0x60, 0x24,
-	0x12, 0xd8, 0x3f, 0x33, 0xc1, 0xba, 0x47, 0x27, 0x77, 0x84, 0x89, 0xd0, 0xb3, 0x50, 0x15, 0x9f,
-	0xd2, 0xbe, 0xa4, 0x68, 0x6c, 0x9d, 0x4f, 0x66, 0xd5, 0x96, 0xc0, 0x31, 0x01, 0xfa, 0x3f, 0xa8,
-	0x47, 0x94, 0x47, 0x13, 0xf2, 0xd8, 0xa3, 0x52, 0xd7, 0x3a, 0x4e, 0x01, 0x68, 0x15, 0x2a, 0xe4,
-	0x71, 0x10, 0x71, 0xa9, 0x6b, 0x1d, 0xab, 0x01, 0xda, 0x02, 0xcb, 0x09, 0xfc, 0x7d, 0xcf, 0x75,
-	0xb8, 0xd4, 0xb6, 0xb1, 0x75, 0x31, 0x61, 0xf0, 0xed, 0xc8, 0xe5, 0x74, 0x3b, 0xc6, 0xe2, 0x84,
-	0x0e, 0x7d, 0x03, 0x5a, 0x44, 0x69, 0xd0, 0xa3, 0x42, 0x05, 0x69, 0x8b, 0xc6, 0xd6, 0x85, 0xe4,
-	0xc3, 0xac, 0x7e, 0xb8, 0x49, 0xb2, 0xda, 0x3e, 0x07, 0x56, 0x9f, 0x92, 0xbe, 0xf4, 0x58, 0x75,
-	0x4a, 0xa1, 0xdb, 0x31, 0x02, 0x27, 0x24, 0xf6, 0x47, 0x06, 0xb4, 0x72, 0x62, 0x08, 0x1f, 0x30,
-	0x4e, 0x22, 0xde, 0xe3, 0x4c, 0x5a, 0xa4, 0x8c, 0x6b, 0x72, 0xdc, 0x65, 0xe8, 0x3a, 0x34, 0xb4,
-	0x8c, 0x02, 0xab, 0xbc, 0x0d, 0x1a, 0xd4, 0x65, 0x73, 0x9c, 0xdd, 0x81, 0x5a, 0x1c, 0x30, 0x52,
-	0xfb, 0x26, 0xd6, 0x43, 0xf4, 0x15, 0x40, 0xc9, 0x64, 0x4e, 0x30, 0x1a, 0xb9, 0x72, 0x4e, 0xe5,
-	0xf5, 0xb6, 0xc6, 0x6c, 0x4b, 0x44, 0x97, 0xd9, 0xdf, 0x07, 0x4b, 0x4b, 0x8f, 0x2e, 0x41, 0x4d,
-	0x85, 0x82, 0x16, 0x50, 0xfa, 0xa7, 0xcb, 0x92, 0xc8, 0x12, 0x32, 0x98, 0x8a, 0x9b, 0x18, 0xdf,
-	0xa3, 0x13, 0xb4, 0x01, 0xe7, 0xb5, 0xce, 0x02, 0xdd, 0x1b, 0x12, 0x36, 0x94, 0x72, 0x96, 0xf1,
-	0xb2, 0x46, 0xdc, 0xa3, 0x93, 0xbb, 0x84, 0x0d, 0xed, 0x7f, 0x95, 0xa0, 0xb6, 0x1d, 0xf8, 0x9c,
-	0x1e, 0x73, 0x74, 0x45, 0xb8, 0x7c, 0xe0, 0x06, 0x7e, 0xcf, 0xed, 0xc7, 0xdc, 0x2c, 0x05, 0xd8,
-	0xe9, 0xa3, 0xaf, 0x43, 0x33, 0x46, 0xd2, 0x30, 0x70, 0x86, 0x92, 0x67, 0x63, 0x6b, 0x65, 0x33,
-	0xce, 0x44, 0x2c, 0x71, 0x77, 0x04, 0x0a, 0x37, 0xa2, 0x74, 0x80, 0xd6, 0xa0, 0x1c, 0x52, 0x1a,
-	0x49, 0xfe, 0x8d, 0xad, 0xa6, 0xa6, 0xdf, 0xa5, 0x34, 0xc2, 0x12, 0x83, 0x10, 0x94, 0x39, 0x8d,
-	0x46, 0xb1, 0x39, 0xe4, 0x7f, 0xf4, 0x3c, 0x58, 0x61, 0xe4, 0x06, 0x91, 0xcb, 0x27, 0x71, 0x02,
-	0xac, 0x24, 0x9e, 0x15, 0x76, 0x22, 0x7e, 0x7f, 0x37, 0x72, 0x71, 0x42, 0x84, 0x5e, 0x86, 0x65,
-	0x97, 0x05, 0x1e, 0xe1, 0x42, 0x42, 0x8f, 0x1e, 0x52, 0xaf, 0x53, 0x93, 0xdf, 0x5d, 0x4a, 0xbe,
-	0xdb, 0xd1, 0xf8, 0xfb, 0x02, 0x8d, 0x97, 0xdc, 0xdc, 0x18, 0x7d, 0x09, 0x96, 0xfc, 0x80, 0xf7,
-	0xf6, 0x5d, 0xcf, 0xeb, 0x39, 0xc4, 0x19, 0xd2, 0x8e, 0xb5, 0x66, 0xac, 0x5b, 0xb8, 0xe9, 0x07,
-	0xfc, 0x15, 0xd7, 0xf3, 0xb6, 0x05, 0x4c, 0x46, 0xcc, 0xc4, 0x77, 0x7a, 0x5e, 0x30, 0xe8, 0xd4,
-	0x25, 0xbe, 0x26, 0xc6, 0xf7, 0x83, 0x81, 0x88, 0x98, 0x21, 0xf1, 0xfb, 0x1e, 0xed, 0x71, 0x77,
-	0x44, 0x3b, 0x20, 0xb1, 0xa0, 0x40, 0x5d, 0x77, 0x44, 0x05, 0x01, 0x73, 0x88, 0xdf, 0xeb, 0x53,
-	0x4e, 0x5c, 0xaf, 0xd3, 0x50, 0x04, 0x02, 0x74, 0x5b, 0x42, 0x44, 0x89, 0x89, 0x68, 0xe8, 0xb9,
-	0x0e, 0xe9, 0x89, 0x28, 0xef, 0x34, 0x25, 0x45, 0x23, 0x86, 0x61, 0x4a, 0xfa, 0xe8, 0x06, 0x2c,
-	0x45, 0x94, 0x05, 0xde, 0x21, 0xed, 0xcb, 0x4a, 0xc5, 0x3a, 0xad, 0xb5, 0xd2, 0x7a, 0x19, 0xb7,
-	0x34, 0x54, 0x24, 0x32, 0x7b, 0xad, 0x6c, 0x95, 0xdb, 0x15, 0xf1, 0x25, 0xe9, 0xf7, 0xde, 0x1e,
-	0x07, 0xd1, 0x78, 0x64, 0xdf, 0x06, 0xb8, 0x9b, 0xca, 0x72, 0x09, 0x6a, 0x47, 0xc4, 0xe5, 0xbd,
-	0x91, 0x8a, 0xab, 0x12, 0xae, 0x8a, 0xe1, 0x03, 0x86, 0xae, 0x02, 0x84, 0x51, 0xe0, 0x50, 0xc6,
-	0x04, 0xce, 0x94, 0xb8, 0x7a, 0x0c, 0x79, 0xc0, 0xec, 0x97, 0xc0, 0xda, 0x73, 0x88, 0x2f, 0x8b,
-	0xe6, 0x2a, 0x54, 0x78, 0xc0, 0x89, 0x17, 0xcf, 0xa0, 0x06, 0xa2, 0x70, 0xc4, 0xe4, 0xb4, 0x3f,
-	0xf5, 0x3d, 0xed, 0xdb, 0x3f, 0x36, 0x00, 0xf6, 0x52, 0x8d, 0x9f, 0x81, 0xca, 0x91, 0xc8, 0xc8,
-	0x99, 0x7a, 0xa4, 0x99, 0x60, 0x85, 0x47, 0x37, 0xa0, 0x2c, 0xd3, 0xdc, 0x7c, 0x12, 0x9d, 0x44,
-	0x0b, 0xb2, 0x3e, 0xe1, 0x24, 0x8e, 0xb6, 0x79, 0x64, 0x02, 0x6d, 0x4f, 0xa0, 0x71, 0xe7, 0x98,
-	0x3a, 0x4a, 0x08, 0x86, 0x5e, 0xcc, 0x7b, 0xce, 0x88, 0x43, 0x5b, 0x7f, 0x9c, 0x9a, 0x2d, 0xe7,
-	0xce, 0x17, 0xf3, 0xee, 0x34, 0xa7, 0xbe, 0x4a, 0xb5, 0xcc, 0xfa, 0xd8, 0xee, 0x03, 0xbc, 0x4a,
-	0x39, 0xa6, 0x6f, 0x8f, 0x29, 0xe3, 0x68, 0x03, 0x6a, 0x8e, 0xca, 0xbe, 0x98, 0x6b, 0x3b, 0x13,
-	0xe6, 0x12, 0x8e, 0x35, 0x81, 0x2e, 0x38, 0x66, 0xae, 0xe0, 0xe8, 0xd5, 0x48, 0xa5, 0xb7, 0x1e,
-	0xda, 0x1f, 0x1a, 0xd0, 0x90, 0x6c, 0x58, 0x18, 0xf8, 0x8c, 0xa2, 0xaf, 0xa5, 0xd9, 0x2b, 0x16,
-	0x82, 0x98, 0xd9, 0xd2, 0xa6, 0x5e, 0x39, 0xe5, 0xf2, 0x90, 0x24, 0xae, 0x5c, 0x2b, 0x9e, 0x81,
-	0x8a, 0xa2, 0x9d, 0x36, 0xb9, 0x5e, 0x4d, 0xb0, 0xc2, 0x8b, 0x30, 0x38, 0x24, 0xde, 0x98, 0xc6,
-	0xa5, 0x50, 0x0d, 0x44, 0x31, 0x91, 0xe9, 0x14, 0x8c, 0xfd, 0xbe, 0x2c, 0x87, 0x16, 0xb6, 0x44,
-	0x26, 0x89, 0xb1, 0xfd, 0x37, 0x03, 0x1a, 0xc2, 0x3e, 0x45, 0xcc, 0x70, 0x05, 0xea, 0xaa, 0x66,
-	0xa7, 0xc6, 0x50, 0x45, 0x5c, 0x94, 0xbe, 0x55, 0xa8, 0x78, 0xee, 0xc8, 0x55, 0xeb, 0x52, 0x0b,
-	0xab, 0x41, 0xd6, 0x4e, 0xe5, 0x9c, 0x9d, 0x44, 0x3a, 0x8b, 0x0a, 0x19, 0xf8, 0xde, 0x44, 0xd6,
-	0x1f, 0x0b, 0xd7, 0x0e, 0xe8, 0xe4, 0xa1, 0xef, 0x49, 0xe3, 0x46, 0x54, 0xd0, 0xa9, 0x25, 0xd8,
-	0xc2, 0x7a, 0x28, 0x72, 0x87, 0xfa, 0x7d, 0xc9, 0xbf, 0x26, 0xf9, 0x57, 0xa9, 0xdf, 0xbf, 0x47,
-	0x27, 0xf6, 0x9b, 0x50, 0xbd, 0x77, 0xb8, 0x4b, 0xdc, 0x8c, 0xf1, 0x8c, 0xcf, 0x31, 0xde, 0xac,
-	0x53, 0xe7, 0x9a, 0xd3, 0x1e, 0x42, 0x53, 0x19, 0xac, 0xb8, 0x43, 0x6f, 0x40, 0x25, 0x24, 0x6e,
-	0x24, 0x92, 0xba, 0xb4, 0xde, 0xd8, 0x5a, 0x4e, 0x65, 0x92, 0x32, 0x63, 0x85, 0xb5, 0x7f, 0x64,
-	0x80, 0xf5, 0x60, 0xcc, 0x65, 0x65, 0x44, 0x57, 0xc0, 0x0c, 0x42, 0x39, 0xf9, 0x54, 0x0b, 0x62,
-	0x06, 0xe1, 0x49, 0x65, 0x47, 0x5f, 0x85, 0x3a, 0x61, 0x8c, 0x46, 0x5c, 0x3b, 0x60, 0x69, 0x0b,
-	0xa5, 0xcb, 0xbb, 0xc6, 0xe0, 0x94, 0xc8, 0xfe, 0xa0, 0x04, 0xcb, 0xbb, 0x11, 0x95, 0xa9, 0x5f,
-	0x24, 0x46, 0x9e, 0x87, 0xfa, 0x28, 0x56, 0x41, 0xab, 0x9b, 0xba, 0x40, 0x2b, 0x87, 0x53, 0x9a,
-	0x99, 0xfe, 0xaf, 0x34, 0xdb, 0xff, 0xfd, 0x3f, 0xb4, 0x54, 0xdc, 0xe5, 0x43, 0xa9, 0x29, 0x81,
-	0x8f, 0xd2, 0x78, 0x4a, 0xfa, 0xbd, 0x4a, 0xbe, 0xdf, 0xdb, 0x82, 0x0b, 0xec, 0xc0, 0x0d, 0x7b,
-	0x4e, 0xe0, 0x33, 0x1e, 0x11, 0xd7, 0xe7, 0x3d, 0x67, 0x48, 0xe3, 0xce, 0xc5, 0xc2, 0x2b, 0x02,
-	0xb9, 0x9d, 0xe0, 0xb6, 0x05, 0x0a, 0x6d, 0xc2, 0x8a, 0xcb, 0x7a, 0x21, 0x65, 0xcc, 0x1d, 0xb9,
-	0x8c, 0xbb, 0x8e, 0x92, 0xae, 0xb6, 0x56, 0x5a, 0xb7, 0xf0, 0x79, 0x97, 0xed, 0xa6, 0x18, 0x29,
-	0x63, 0xb6, 0xa7, 0xb4, 0xf2, 0x3d, 0xa5, 0x0d, 0xad, 0xfd, 0x20, 0xea, 0x8d, 0xc3, 0x3e, 0xe1,
-	0x54, 0xb4, 0x13, 0x75, 0xd5, 0xbf, 0xee, 0x07, 0xd1, 0x1b, 0x12, 0xd6, 0x65, 0x82, 0x66, 0xe4,
-	0xfa, 0x99, 0x0e, 0x05, 0x14, 0xcd, 0xc8, 0xf5, 0x93, 0xe6, 0x24, 0x84, 0x76, 0xea, 0x99, 0xe2,
-	0xc1, 0xf8, 0x2c, 0x54, 0x25, 0x76, 0xd6, 0x3d, 0x49, 0x86, 0xc4, 0x04, 0xf6, 0xef, 0x0d, 0x58,
-	0xe9, 0x1e, 0xfb, 0x77, 0x29, 0x89, 0xf8, 0x2d, 0x4a, 0x0a, 0xd5, 0xce, 0x69, 0xff, 0x9a, 0x27,
-	0xf0, 0x6f, 0x69, 0x8e, 0x7f, 0x6f, 0xc2, 0x32, 0xe9, 0x1f, 0xba, 0x8c, 0xf6, 0xa6, 0xda, 0xfa,
-	0x96, 0x02, 0xdf, 0x57, 0xce, 0xb6, 0x7f, 0x61, 0xc0, 0x6a, 0x5e, 0xe6, 0x33, 0x28, 0xc4, 0xd9,
-	0xe0, 0x2b, 0xe5, 0x82, 0xcf, 0xfe, 0x93, 0x09, 0x17, 0xa7, 0x82, 0xe5, 0x7f, 0x25, 0xaf, 0x66,
-	0x02, 0xbb, 0x3a, 0x37, 0xb0, 0x5d, 0xd6, 0xdb, 0x77, 0x23, 0xc6, 0x75, 0x06, 0xc9, 0xce, 0xca,
-	0x65, 0xaf, 0x08, 0x98, 0xde, 0xdf, 0xc9, 0x8e, 0x48, 0xb4, 0x00, 0xc1, 0x98, 0xc7, 0xf9, 0xd3,
-	0x10, 0xb0, 0xae, 0x02, 0xd9, 0x47, 0x70, 0x69, 0xc6, 0x88, 0x67, 0x92, 0x02, 0x1f, 0x19, 0x70,
-	0x39, 0xc3, 0x19, 0x07, 0x9e, 0xf7, 0x98, 0x14, 0x73, 0xe1, 0x8c, 0xb9, 0xcd, 0x39, 0xe6, 0x9e,
-	0xb1, 0x69, 0x69, 0xd6, 0xa6, 0x08, 0xca, 0x07, 0x74, 0xc2, 0x3a, 0xe5, 0xb5, 0xd2, 0x7a, 0x13,
-	0xcb, 0xff, 0xf6, 0xbb, 0x70, 0x65, 0xae, 0x98, 0x67, 0x62, 0xa4, 0xdf, 0x19, 0xd0, 0x52, 0x65,
-	0xea, 0xd4, 0xec, 0xa2, 0x75, 0x2e, 0xa5, 0x3a, 0x8b, 0x8e, 0x3c, 0x2e, 0x98, 0xf9, 0x00, 0x6e,
-	0x29, 0x68, 0xfc, 0xe9, 0x6b, 0x65, 0xab, 0xd2, 0xae, 0xe2, 0xea, 0x63, 0xd7, 0xf7, 0x82, 0x81,
-	0xfd, 0x6b, 0x03, 0x96, 0xb4, 0xac, 0x67, 0x50, 0x19, 0x66, 0x65, 0x2c, 0xcd, 0x91, 0xd1, 0x1e,
-	0x40, 0x6b, 0x67, 0x14, 0x06, 0x51, 0x62, 0xc0, 0x5c, 0xbe, 0x1b, 0x27, 0xc8, 0xf7, 0x59, 0x46,
-	0xe6, 0x3c, 0x46, 0x6f, 0xc2, 0x92, 0x66, 0x54, 0x5c, 0xfb, 0xd5, 0xac, 0xf6, 0xf5, 0x58, 0x55,
-	0xfb, 0x5d, 0x58, 0xbd, 0x45, 0xb8, 0x33, 0x3c, 0xf5, 0x1c, 0x99, 0x13, 0x0b, 0x36, 0x83, 0x0b,
-	0x53, 0xcc, 0x4f, 0xdf, 0xb9, 0xf6, 0xc7, 0x06, 0x5c, 0x90, 0xed, 0x42, 0xf7, 0xd8, 0xdf, 0xe3,
-	0x84, 0x8f, 0x59, 0x11, 0x9d, 0xaf, 0x83, 0xae, 0xca, 0x99, 0xc6, 0x1a, 0x62, 0x90, 0x68, 0xad,
-	0x33, 0x27, 0x11, 0xa5, 0xdc, 0x49, 0xc4, 0x4d, 0x58, 0x76, 0x88, 0xe7, 0xd1, 0xa8, 0x97, 0x9c,
-	0xa5, 0xe8, 0x0c, 0x90, 0xe0, 0xbd, 0xf8, 0x44, 0xe5, 0x2a, 0x80, 0x33, 0x8e, 0x22, 0xea, 0x67,
-	0x0e, 0x3f, 0xea, 0x31, 0xa4, 0xcb, 0xec, 0x3f, 0x18, 0x70, 0x71, 0x5a, 0x8d, 0x2f, 0x74, 0xd1,
-	0x3c, 0x61, 0x66, 0xdb, 0xbf, 0x12, 0xb9, 0xec, 0x51, 0xe2, 0x8f, 0xc3, 0xc5, 0x6c, 0xeb, 0x4e,
-	0xd4, 0x89, 0xe4, 0xad, 0x59, 0x9e, 0xb6, 0xe6, 0x6f, 0x0c, 0x58, 0x4e, 0x84, 0xfa, 0xef, 0xa9,
-	0x30, 0x07, 0xb0, 0x2c, 0x13, 0xa4, 0xe0, 0x16, 0x58, 0xe7, 0x9c, 0x99, 0xa9, 0xbf, 0x4f, 0xde,
-	0x04, 0x7b, 0xd0, 0x4e, 0x99, 0x9d, 0xfa, 0xbe, 0xe9, 0x97, 0x06, 0x2c, 0x8b, 0x2d, 0x5a, 0xd1,
-	0xde, 0xea, 0x3a, 0x34, 0x46, 0xe4, 0x78, 0xaa, 0xe4, 0xc0, 0x88, 0x1c, 0x6b, 0x8f, 0xe7, 0x36,
-	0xbe, 0xa5, 0x27, 0x6d, 0x7c, 0xcb, 0x99, 0x8d, 0xaf, 0xfd, 0xbe, 0x01, 0xed, 0x54, 0xa6, 0x33,
-	0x08, 0x83, 0x67, 0xa0, 0xa2, 0x4e, 0xa5, 0x4a, 0x53, 0x8b, 0x45, 0x72, 0xbe, 0xac, 0xf0, 0xf6,
-	0x0b, 0x50, 0xeb, 0x1e, 0xab, 0x63, 0xa4, 0x36, 0x94, 0xf8, 0xb1, 0x1f, 0x1f, 0x38, 0x8a, 0xbf,
-	0xe8, 0x22, 0x54, 0x99, 0xac, 0x00, 0xb1, 0x15, 0xe2, 0x91, 0xfd, 0x57, 0x03, 0x10, 0x56, 0xe7,
-	0x5c, 0x45, 0xad, 0x7c, 0xa2, 0xd2, 0x7e, 0xb2, 0x60, 0x46, 0xcf, 0x41, 0x5d, 0xec, 0xb6, 0x5c,
-	0x7f, 0x3f, 0x50, 0x6d, 0x50, 0x96, 0x73, 0xac, 0x1d, 0x16, 0x1b, 0x32, 0xf1, 0x27, 0x6d, 0x98,
-	0x2a, 0x99, 0x05, 0xe3, 0x6d, 0x58, 0xc9, 0x29, 0x74, 0x06, 0xcb, 0xc5, 0x23, 0xa8, 0xbf, 0xba,
-	0x5d, 0xc4, 0x74, 0x57, 0x01, 0x18, 0xd9, 0xa7, 0xbd, 0x30, 0x70, 0x7d, 0x1e, 0xdb, 0xad, 0x2e,
-	0x20, 0xbb, 0x02, 0x60, 0x0f, 0x01, 0xc4, 0xbc, 0x67, 0xa0, 0xc1, 0x77, 0xa1, 0x85, 0xc9, 0xd1,
-	0xc2, 0x4e, 0xd1, 0x96, 0xc0, 0x74, 0xf6, 0xe3, 0x8b, 0x0c, 0xd3, 0xd9, 0xb7, 0x7f, 0x6e, 0xc0,
-	0x92, 0x9e, 0x7f, 0xc1, 0xdd, 0x49, 0x91, 0xb3, 0x32, 0x26, 0xb5, 0xdd, 0x1d, 0x2f, 0x48, 0xdb,
-	0xf9, 0x12, 0x28, 0x1b, 0x94, 0x13, 0x1b, 0xbc, 0x29, 0x4d, 0x20, 0x99, 0x2e, 0xba, 0x41, 0x3b,
-	0x02, 0x84, 0xc9, 0x91, 0x2c, 0xcc, 0x05, 0x95, 0x3a, 0x59, 0x41, 0x9e, 0xf1, 0xeb, 0xf7, 0x60,
-	0x25, 0xc7, 0x78, 0xd1, 0x8a, 0xf5, 0x53, 0xc5, 0x16, 0xb8, 0xbc, 0x4d, 0x6b, 0x11, 0xa4, 0x5a,
-	0x9c, 0xcd, 0xba, 0xf6, 0x16, 0xb4, 0x31, 0x39, 0xba, 0x4d, 0x3d, 0x5a, 0xec, 0x2c, 0xee, 0xf3,
-	0x13, 0xee, 0x3b, 0x70, 0x3e, 0xc3, 0x61, 0xd1, 0x6e, 0x19, 0xc0, 0x05, 0x6d, 0xb0, 0xe2, 0x4a,
-	0x9c, 0xc4, 0x33, 0x04, 0x2e, 0x4e, 0x33, 0x5a, 0xb4, 0x2e, 0xef, 0x1b, 0x80, 0xe2, 0xb9, 0x89,
-	0x3f, 0xa0, 0x0b, 0x3f, 0x3e, 0xcf, 0x9c, 0x6c, 0x97, 0xb2, 0x27, 0xdb, 0xa2, 0x39, 0xf1, 0x03,
-	0xee, 0xee, 0xc7, 0x47, 0xe5, 0xaa, 0x46, 0x81, 0x02, 0x3d, 0xf4, 0xbd, 0x89, 0x48, 0xae, 0x9c,
-	0x60, 0x8b, 0xd6, 0xfc, 0x3d, 0x43, 0xba, 0xf1, 0x0b, 0x51, 0x7e, 0xba, 0x38, 0x2a, 0x47, 0x9f,
-	0xaa, 0xba, 0x7f, 0x51, 0x6b, 0xd0, 0x19, 0xde, 0x91, 0x64, 0x6f, 0x42, 0xca, 0xf9, 0x9b, 0x10,
-	0xa5, 0x7f, 0x45, 0xeb, 0x5f, 0xe4, 0x66, 0x64, 0x00, 0xcb, 0x89, 0x3a, 0xc5, 0x6d, 0xf5, 0x34,
-	0x94, 0x0e, 0x0e, 0x9f, 0x58, 0xaf, 0x04, 0xce, 0x7e, 0x59, 0xbe, 0x76, 0x90, 0x5e, 0xc9, 0x5b,
-	0xc1, 0x78, 0xb2, 0xb7, 0xcd, 0x9c, 0xa8, 0x9f, 0x18, 0x69, 0x85, 0x2d, 0x6a, 0xff, 0x67, 0xa1,
-	0x1a, 0x09, 0x11, 0xe6, 0x9e, 0x5a, 0xa9, 0x90, 0x89, 0x09, 0x44, 0x57, 0x45, 0x89, 0x33, 0xec,
-	0x65, 0x5d, 0x52, 0x17, 0x90, 0xfb, 0x0b, 0x73, 0x8b, 0xed, 0xc1, 0x6a, 0x5e, 0xa3, 0x53, 0x75,
-	0xc1, 0x4f, 0x0c, 0xa8, 0x3f, 0x38, 0x74, 0x1c, 0xf9, 0xd4, 0x02, 0x5d, 0x87, 0xb2, 0x7c, 0xc6,
-	0x32, 0xe7, 0x0e, 0x49, 0x22, 0x72, 0x6f, 0x30, 0xcc, 0xfc, 0x1b, 0x8c, 0x2b, 0x50, 0x4f, 0xef,
-	0x22, 0x54, 0x0b, 0x6e, 0x39, 0xf1, 0x45, 0x84, 0xbc, 0x4d, 0x1f, 0x06, 0xa2, 0x93, 0x97, 0xed,
-	0x8c, 0x7a, 0x71, 0x01, 0x12, 0xf4, 0x48, 0x5e, 0x99, 0x7d, 0x53, 0x89, 0x21, 0x07, 0x9f, 0xf5,
-	0xd2, 0x23, 0xe9, 0x88, 0xcc, 0xec, 0x85, 0x9b, 0xbc, 0x06, 0x3b, 0x74, 0xd4, 0xbd, 0xca, 0x7f,
-	0xa2, 0x44, 0xe6, 0x55, 0x48, 0x29, 0xff, 0x2a, 0xe4, 0x73, 0x35, 0x78, 0x2f, 0x96, 0x41, 0x6e,
-	0x93, 0xf4, 0x0d, 0xf8, 0xf4, 0x8d, 0xa2, 0x16, 0x32, 0xbe, 0x01, 0xdf, 0x80, 0xaa, 0xbc, 0x9c,
-	0xd1, 0x3e, 0x42, 0x39, 0x42, 0xe9, 0x13, 0x1c, 0x53, 0x08, 0x5a, 0xc9, 0x5a, 0x6f, 0xd7, 0xf2,
-	0xb4, 0x52, 0x06, 0x1c, 0x53, 0xd8, 0x7b, 0xb0, 0x22, 0x80, 0xaf, 0x52, 0x7e, 0x6b, 0x22, 0x82,
-	0x78, 0x11, 0x9d, 0x80, 0xfd, 0x53, 0x03, 0x56, 0xf3, 0xb3, 0x2e, 0xba, 0xe1, 0xbe, 0x01, 0x65,
-	0xb1, 0x3f, 0x9b, 0x79, 0x10, 0xa0, 0xcd, 0x8a, 0x25, 0xda, 0x7e, 0x0b, 0x2e, 0x25, 0x72, 0xc4,
-	0xe7, 0x55, 0x45, 0x34, 0x7c, 0x72, 0x18, 0xd8, 0x1f, 0x1a, 0xd0, 0x99, 0x65, 0xb1, 0x68, 0x75,
-	0x67, 0x1f, 0x25, 0x69, 0x03, 0x94, 0x3f, 0xdb, 0x00, 0x3f, 0x34, 0x00, 0xed, 0x85, 0x9e, 0xcb,
-	0xd5, 0x43, 0x9e, 0x62, 0x07, 0x18, 0x75, 0x26, 0x66, 0x48, 0x6b, 0xea, 0x2d, 0xb3, 0x63, 0x60,
-	0x4b, 0x02, 0x45, 0xc9, 0x15, 0x1b, 0x48, 0x4d, 0xa0, 0xcf, 0x4d, 0xeb, 0x1a, 0xcb, 0xec, 0x8f,
-	0x0d, 0x58, 0xc9, 0x89, 0x50, 0xdc, 0x38, 0x37, 0xa1, 0xec, 0xd1, 0x7d, 0x1e, 0xef, 0x24, 0x97,
-	0xf2, 0x8f, 0x94, 0xa4, 0x54, 0x12, 0x8f, 0xd6, 0xa1, 0x12, 0xb9, 0x83, 0x21, 0x8f, 0xc3, 0x63,
-	0x1e, 0xa1, 0x22, 0x40, 0xeb, 0xa2, 0xb8, 0x0e, 0xe4, 0x39, 0xb8, 0xda, 0xe9, 0x4f, 0xd1, 0x62,
-	0x8d, 0xb6, 0x7f, 0x00, 0x4f, 0xbd, 0xe1, 0x8b, 0x6d, 0xf1, 0x6d, 0xca, 0x78, 0x14, 0x4c, 0xce,
-	0xb6, 0x59, 0xb1, 0x29, 0x5c, 0x9e, 0xc7, 0x7e, 0xd1, 0x0d, 0xca, 0x4b, 0xd0, 0xc6, 0x94, 0xf4,
-	0x77, 0xfc, 0x3e, 0x3d, 0x2e, 0xa0, 0x9c, 0x4d, 0xe1, 0x7c, 0xe6, 0xfb, 0xe2, 0xd2, 0x5d, 0x05,
-	0x90, 0x8f, 0x9c, 0x5c, 0x31, 0x91, 0x3e, 0x94, 0x88, 0xf4, 0xcc, 0x1b, 0x5f, 0x06, 0x48, 0x9f,
-	0x8b, 0x21, 0x80, 0xea, 0xeb, 0x41, 0x34, 0x22, 0x5e, 0xfb, 0x1c, 0xaa, 0x41, 0xe9, 0x7e, 0x70,
-	0xd4, 0x36, 0x90, 0x05, 0xe5, 0xbb, 0xee, 0x60, 0xd8, 0x36, 0x37, 0xd6, 0x60, 0x29, 0xff, 0x46,
-	0x0c, 0x55, 0xc1, 0xdc, 0xdb, 0x69, 0x9f, 0x13, 0xbf, 0x78, 0xbb, 0x6d, 0x6c, 0x3c, 0x04, 0xf3,
-	0x61, 0x28, 0x3e, 0xdd, 0x1d, 0x73, 0x35, 0xc7, 0x6d, 0xea, 0xa9, 0x39, 0x44, 0x09, 0x6e, 0x9b,
-	0xa8, 0x09, 0x96, 0x3e, 0xfc, 0x6f, 0x97, 0x04, 0xc3, 0x1d, 0x9f, 0xd1, 0x88, 0xb7, 0xcb, 0x68,
-	0x05, 0x96, 0xa7, 0x2e, 0x0f, 0xdb, 0x95, 0x8d, 0x4d, 0xa8, 0x27, 0x0f, 0x20, 0xc4, 0x2c, 0xaf,
-	0x07, 0x3e, 0x6d, 0x9f, 0x43, 0x75, 0xa8, 0xc8, 0x17, 0x8e, 0x6d, 0x43, 0x4c, 0xf8, 0x7a, 0xc0,
-	0xd5, 0xc8, 0xbc, 0x75, 0xf3, 0xef, 0xbf, 0xb5, 0x8c, 0x3f, 0x7f, 0x7a, 0xcd, 0xf8, 0xe4, 0xd3,
-	0x6b, 0xc6, 0x3f, 0x3e, 0xbd, 0x66, 0x7c, 0xf0, 0xcf, 0x6b, 0xe7, 0xa0, 0x1d, 0x44, 0x83, 0x4d,
-	0xee, 0x1e, 0x1c, 0x6e, 0x1e, 0x1c, 0xca, 0xc7, 0xad, 0x8f, 0xab, 0xf2, 0xe7, 0x85, 0x7f, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0xb9, 0xeb, 0xa5, 0xa2, 0x41, 0x2b, 0x00, 0x00,
+func init() { proto.RegisterFile("kvrpcpb.proto", fileDescriptor_kvrpcpb_399b45796d82b8de) }
+
+var fileDescriptor_kvrpcpb_399b45796d82b8de = []byte{
+	// 3134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xd5, 0x5e, 0x2e, 0xaf, 0x87, 0xa2, 0xb4, 0x5e, 0xc9, 0x36, 0x63, 0x7f, 0xb1, 0x95, 0xfd, 0x3e,
+	0xdb, 0x8a, 0xbe, 0x44, 0x69, 0x95, 0x20, 0x0f, 0x45, 0x11, 0x24, 0x96, 0x1d, 0xdb, 0xb1, 0x1c,
+	0x0b, 0x2b, 0xc6, 0x45, 0x80, 0x36, 0xcc, 0x78, 0x39, 0x12, 0xb7, 0x5a, 0xee, 0x6e, 0x66, 0x87,
+	0x14, 0x99, 0xa0, 0x40, 0x8a, 0xa2, 0x45, 0x83, 0xa6, 0x05, 0x7a, 0x01, 0x92, 0x87, 0xbe, 0x06,
+	0x68, 0x1f, 0xfb, 0x0f, 0x8a, 0xb6, 0x0f, 0x79, 0x29, 0x1a, 0xa0, 0x7d, 0xe8, 0x5b, 0x8b, 0x14,
+	0x45, 0xff, 0x46, 0x71, 0x66, 0x76, 0xf6, 0x42, 0xd2, 0xb6, 0xc0, 0x50, 0x4a, 0xd1, 0x27, 0x71,
+	0xce, 0x39, 0x3b, 0x73, 0xee, 0x73, 0x66, 0xce, 0x08, 0x1a, 0x07, 0x03, 0x16, 0x3a, 0xe1, 0x83,
+	0x8d, 0x90, 0x05, 0x3c, 0x30, 0x2b, 0xf1, 0xf0, 0xfc, 0x42, 0x8f, 0x72, 0xa2, 0xc0, 0xe7, 0x1b,
+	0x94, 0xb1, 0x80, 0x25, 0xc3, 0x95, 0xfd, 0x60, 0x3f, 0x10, 0x3f, 0x9f, 0xc3, 0x5f, 0x31, 0x74,
+	0x89, 0xf5, 0x23, 0x2e, 0x7e, 0x4a, 0x80, 0xf5, 0x3b, 0x0d, 0xaa, 0xdb, 0x81, 0x73, 0x70, 0xdb,
+	0xdf, 0x0b, 0xcc, 0xa7, 0x60, 0x21, 0x64, 0x6e, 0x8f, 0xb0, 0x51, 0xdb, 0x0b, 0x9c, 0x83, 0xa6,
+	0xb6, 0xaa, 0xad, 0x2d, 0xd8, 0xf5, 0x18, 0x86, 0x64, 0x48, 0x82, 0xa8, 0xf6, 0x80, 0xb2, 0xc8,
+	0x0d, 0xfc, 0x66, 0x61, 0x55, 0x5b, 0x2b, 0xda, 0x75, 0x84, 0xdd, 0x97, 0x20, 0xd3, 0x00, 0xfd,
+	0x80, 0x8e, 0x9a, 0xba, 0xf8, 0x18, 0x7f, 0x9a, 0x4f, 0x40, 0x55, 0x7c, 0xc4, 0xb9, 0xd7, 0x2c,
+	0x8a, 0x0f, 0x2a, 0x38, 0x6e, 0x71, 0x0f, 0x51, 0x7c, 0xe8, 0xb7, 0x23, 0xf7, 0x5d, 0xda, 0x2c,
+	0x49, 0x14, 0x1f, 0xfa, 0xbb, 0xee, 0xbb, 0xd4, 0x5c, 0x83, 0x9a, 0xfc, 0x6a, 0x14, 0xd2, 0x66,
+	0x79, 0x55, 0x5b, 0x5b, 0xdc, 0xac, 0x6f, 0x28, 0x55, 0xdc, 0x0b, 0x6d, 0x31, 0x67, 0x6b, 0x14,
+	0x52, 0x6b, 0x15, 0x16, 0x5e, 0xf1, 0x18, 0x25, 0x9d, 0xd1, 0x8d, 0xa1, 0x1b, 0x71, 0xc5, 0x81,
+	0x96, 0x70, 0x60, 0xfd, 0xb0, 0x00, 0xd5, 0x3b, 0x74, 0x74, 0x03, 0x55, 0x64, 0x3e, 0x0d, 0x65,
+	0xfc, 0x94, 0x76, 0x04, 0x45, 0x7d, 0xf3, 0x74, 0x32, 0xab, 0xd2, 0x84, 0x1d, 0x13, 0x98, 0xff,
+	0x03, 0x35, 0x46, 0x39, 0x1b, 0x91, 0x07, 0x1e, 0x15, 0xb2, 0xd6, 0xec, 0x14, 0x60, 0xae, 0x40,
+	0x89, 0x3c, 0x08, 0x18, 0x17, 0xb2, 0xd6, 0x6c, 0x39, 0x30, 0x37, 0xa1, 0xea, 0x04, 0xfe, 0x9e,
+	0xe7, 0x3a, 0x5c, 0x48, 0x5b, 0xdf, 0x3c, 0x9b, 0x2c, 0xf0, 0x0d, 0xe6, 0x72, 0xba, 0x15, 0x63,
+	0xed, 0x84, 0xce, 0xfc, 0x1a, 0x34, 0x88, 0x94, 0xa0, 0x4d, 0x51, 0x04, 0xa1, 0x8b, 0xfa, 0xe6,
+	0x99, 0xe4, 0xc3, 0xac, 0x7c, 0xf6, 0x02, 0xc9, 0x4a, 0xfb, 0x2c, 0x54, 0x3b, 0x94, 0x74, 0x84,
+	0xc5, 0xca, 0x63, 0x02, 0x5d, 0x8f, 0x11, 0x76, 0x42, 0x62, 0x7d, 0xa2, 0x41, 0x23, 0xc7, 0x06,
+	0xda, 0x20, 0xe2, 0x84, 0xf1, 0x36, 0x8f, 0x84, 0x46, 0x8a, 0x76, 0x45, 0x8c, 0x5b, 0x91, 0x79,
+	0x09, 0xea, 0x8a, 0x47, 0xc4, 0x4a, 0x6b, 0x83, 0x02, 0xb5, 0xa2, 0x29, 0xc6, 0x6e, 0x42, 0x25,
+	0x76, 0x18, 0x21, 0xfd, 0x82, 0xad, 0x86, 0xe6, 0x33, 0x60, 0x26, 0x93, 0x39, 0x41, 0xaf, 0xe7,
+	0x8a, 0x39, 0xa5, 0xd5, 0x0d, 0x85, 0xd9, 0x12, 0x88, 0x56, 0x64, 0x7d, 0x1b, 0xaa, 0x8a, 0x7b,
+	0xf3, 0x1c, 0x54, 0xa4, 0x2b, 0x28, 0x06, 0x85, 0x7d, 0x5a, 0x51, 0xe2, 0x59, 0xc8, 0x43, 0x41,
+	0xae, 0x86, 0xe3, 0x3b, 0x74, 0x64, 0xae, 0xc3, 0x69, 0x25, 0x33, 0xa2, 0xdb, 0x5d, 0x12, 0x75,
+	0x05, 0x9f, 0x45, 0x7b, 0x49, 0x21, 0xee, 0xd0, 0xd1, 0x2d, 0x12, 0x75, 0xad, 0x7f, 0xe9, 0x50,
+	0xd9, 0x0a, 0x7c, 0x4e, 0x87, 0xdc, 0xbc, 0x80, 0x26, 0xdf, 0x77, 0x03, 0xbf, 0xed, 0x76, 0xe2,
+	0xd5, 0xaa, 0x12, 0x70, 0xbb, 0x63, 0xbe, 0x08, 0x0b, 0x31, 0x92, 0x86, 0x81, 0xd3, 0x15, 0x6b,
+	0xd6, 0x37, 0x97, 0x37, 0xe2, 0x48, 0xb4, 0x05, 0xee, 0x06, 0xa2, 0xec, 0x3a, 0x4b, 0x07, 0xe6,
+	0x2a, 0x14, 0x43, 0x4a, 0x99, 0x58, 0xbf, 0xbe, 0xb9, 0xa0, 0xe8, 0x77, 0x28, 0x65, 0xb6, 0xc0,
+	0x98, 0x26, 0x14, 0x39, 0x65, 0xbd, 0x58, 0x1d, 0xe2, 0xb7, 0xf9, 0x1c, 0x54, 0x43, 0xe6, 0x06,
+	0xcc, 0xe5, 0xa3, 0x38, 0x00, 0x96, 0x13, 0xcb, 0xa2, 0x9e, 0x88, 0xdf, 0xd9, 0x61, 0xae, 0x9d,
+	0x10, 0x99, 0x2f, 0xc3, 0x92, 0x1b, 0x05, 0x1e, 0xe1, 0xc8, 0xa1, 0x47, 0x07, 0xd4, 0x6b, 0x56,
+	0xc4, 0x77, 0xe7, 0x92, 0xef, 0x6e, 0x2b, 0xfc, 0x36, 0xa2, 0xed, 0x45, 0x37, 0x37, 0x36, 0xff,
+	0x0f, 0x16, 0xfd, 0x80, 0xb7, 0xf7, 0x5c, 0xcf, 0x6b, 0x3b, 0xc4, 0xe9, 0xd2, 0x66, 0x75, 0x55,
+	0x5b, 0xab, 0xda, 0x0b, 0x7e, 0xc0, 0x5f, 0x75, 0x3d, 0x6f, 0x0b, 0x61, 0xc2, 0x63, 0x46, 0xbe,
+	0xd3, 0xf6, 0x82, 0xfd, 0x66, 0x4d, 0xe0, 0x2b, 0x38, 0xde, 0x0e, 0xf6, 0xd1, 0x63, 0xba, 0xc4,
+	0xef, 0x78, 0xb4, 0xcd, 0xdd, 0x1e, 0x6d, 0x82, 0xc0, 0x82, 0x04, 0xb5, 0xdc, 0x1e, 0x45, 0x82,
+	0xc8, 0x21, 0x7e, 0xbb, 0x43, 0x39, 0x71, 0xbd, 0x66, 0x5d, 0x12, 0x20, 0xe8, 0xba, 0x80, 0x60,
+	0x8a, 0x61, 0x34, 0xf4, 0x5c, 0x87, 0xb4, 0xd1, 0xcb, 0x9b, 0x0b, 0x82, 0xa2, 0x1e, 0xc3, 0x6c,
+	0x4a, 0x3a, 0xe6, 0x65, 0x58, 0x64, 0x34, 0x0a, 0xbc, 0x01, 0xed, 0x88, 0x4c, 0x15, 0x35, 0x1b,
+	0xab, 0xfa, 0x5a, 0xd1, 0x6e, 0x28, 0x28, 0x06, 0x72, 0xf4, 0x5a, 0xb1, 0x5a, 0x34, 0x4a, 0xf8,
+	0x25, 0xe9, 0xb4, 0xdf, 0xe9, 0x07, 0xac, 0xdf, 0xb3, 0xae, 0x03, 0xdc, 0x4a, 0x79, 0x39, 0x07,
+	0x95, 0x43, 0xe2, 0xf2, 0x76, 0x4f, 0xfa, 0x95, 0x6e, 0x97, 0x71, 0x78, 0x37, 0x32, 0x9f, 0x04,
+	0x08, 0x59, 0xe0, 0xd0, 0x28, 0x42, 0x5c, 0x41, 0xe0, 0x6a, 0x31, 0xe4, 0x6e, 0x64, 0xbd, 0x04,
+	0xd5, 0x5d, 0x87, 0xf8, 0x22, 0x69, 0xae, 0x40, 0x89, 0x07, 0x9c, 0x78, 0xf1, 0x0c, 0x72, 0x80,
+	0x89, 0x23, 0x26, 0xa7, 0x9d, 0xb1, 0xef, 0x69, 0xc7, 0xfa, 0x9e, 0x06, 0xb0, 0x9b, 0x4a, 0x7c,
+	0x15, 0x4a, 0x87, 0x18, 0x91, 0x13, 0xf9, 0x48, 0x2d, 0x62, 0x4b, 0xbc, 0x79, 0x19, 0x8a, 0x22,
+	0xcc, 0x0b, 0x0f, 0xa3, 0x13, 0x68, 0x24, 0xeb, 0x10, 0x4e, 0x9a, 0xfa, 0x43, 0xc9, 0x10, 0x6d,
+	0x8d, 0xa0, 0x7e, 0x63, 0x48, 0x1d, 0xc9, 0x44, 0x64, 0xbe, 0x90, 0xb7, 0x9c, 0x16, 0xbb, 0xb6,
+	0xfa, 0x38, 0x55, 0x5b, 0xce, 0x9c, 0x2f, 0xe4, 0xcd, 0x59, 0x18, 0xfb, 0x2a, 0x95, 0x32, 0x6b,
+	0x63, 0xab, 0x03, 0x70, 0x93, 0x72, 0x9b, 0xbe, 0xd3, 0xa7, 0x11, 0x37, 0xd7, 0xa1, 0xe2, 0xc8,
+	0xe8, 0x8b, 0x57, 0x35, 0x32, 0x6e, 0x2e, 0xe0, 0xb6, 0x22, 0x50, 0x09, 0xa7, 0x90, 0x4b, 0x38,
+	0x6a, 0x37, 0x92, 0xe1, 0xad, 0x86, 0xd6, 0x2f, 0x35, 0xa8, 0x8b, 0x65, 0xa2, 0x30, 0xf0, 0x23,
+	0x6a, 0x7e, 0x35, 0x8d, 0x5e, 0xc6, 0x02, 0x16, 0x2f, 0xb6, 0xb8, 0xa1, 0x76, 0x4e, 0xb1, 0x3d,
+	0x24, 0x81, 0x8b, 0x03, 0x34, 0x8d, 0xa4, 0x1d, 0x57, 0xb9, 0xda, 0x4d, 0x6c, 0x89, 0x47, 0x37,
+	0x18, 0x10, 0xaf, 0x4f, 0xe3, 0x54, 0x28, 0x07, 0x98, 0x4c, 0x44, 0x38, 0x05, 0x7d, 0xbf, 0x23,
+	0xd2, 0x61, 0xd5, 0xae, 0x62, 0x24, 0xe1, 0xd8, 0xfa, 0x8b, 0x06, 0x75, 0xd4, 0xcf, 0x2c, 0x6a,
+	0xb8, 0x00, 0x35, 0x99, 0xb3, 0x53, 0x65, 0xc8, 0x24, 0x8e, 0xa9, 0x6f, 0x05, 0x4a, 0x9e, 0xdb,
+	0x73, 0xe5, 0xbe, 0xd4, 0xb0, 0xe5, 0x20, 0xab, 0xa7, 0x62, 0x4e, 0x4f, 0x18, 0xce, 0x98, 0x21,
+	0x03, 0xdf, 0x1b, 0x89, 0xfc, 0x53, 0xb5, 0x2b, 0x07, 0x74, 0x74, 0xcf, 0xf7, 0x84, 0x72, 0x19,
+	0x45, 0x3a, 0xb9, 0x05, 0x57, 0x6d, 0x35, 0xc4, 0xd8, 0xa1, 0x7e, 0x47, 0xac, 0x5f, 0x11, 0xeb,
+	0x97, 0xa9, 0xdf, 0xb9, 0x43, 0x47, 0xd6, 0x9b, 0x50, 0xbe, 0x33, 0xd8, 0x21, 0x6e, 0x46, 0x79,
+	0xda, 0x63, 0x94, 0x37, 0x69, 0xd4, 0xa9, 0xea, 0xb4, 0xba, 0xb0, 0x20, 0x15, 0x36, 0xbb, 0x41,
+	0x2f, 0x43, 0x29, 0x24, 0x2e, 0xc3, 0xa0, 0xd6, 0xd7, 0xea, 0x9b, 0x4b, 0x29, 0x4f, 0x82, 0x67,
+	0x5b, 0x62, 0xad, 0x1f, 0x6b, 0x60, 0xe2, 0x52, 0xbb, 0x9c, 0x51, 0xd2, 0x3b, 0xfe, 0x05, 0x31,
+	0xe3, 0x30, 0x1a, 0xf5, 0x7b, 0xb4, 0x9d, 0xee, 0xa7, 0x35, 0x09, 0x41, 0xa5, 0x7e, 0x57, 0x83,
+	0xea, 0xdd, 0x3e, 0x17, 0x99, 0xda, 0xbc, 0x00, 0x85, 0x20, 0x6c, 0x6a, 0x93, 0x25, 0x51, 0x21,
+	0x08, 0x8f, 0xaa, 0x4b, 0xf3, 0x2b, 0x50, 0x23, 0x51, 0x44, 0x19, 0x57, 0x0e, 0xb1, 0xb8, 0x69,
+	0xa6, 0xe5, 0x86, 0xc2, 0xd8, 0x29, 0x91, 0xf5, 0xb1, 0x0e, 0x4b, 0x3b, 0x8c, 0x8a, 0x54, 0x34,
+	0x8b, 0xcf, 0x3e, 0x07, 0xb5, 0x5e, 0x2c, 0x82, 0xd2, 0x46, 0xea, 0x12, 0x4a, 0x38, 0x3b, 0xa5,
+	0x99, 0xa8, 0x47, 0xf5, 0xc9, 0x7a, 0xf4, 0x7f, 0xa1, 0x21, 0xe3, 0x20, 0xef, 0xda, 0x0b, 0x02,
+	0x78, 0x3f, 0xf5, 0xef, 0xa4, 0xfe, 0x2c, 0xe5, 0xeb, 0xcf, 0x4d, 0x38, 0x13, 0x1d, 0xb8, 0x61,
+	0xdb, 0x09, 0xfc, 0x88, 0x33, 0xe2, 0xfa, 0xbc, 0xed, 0x74, 0x69, 0x5c, 0x49, 0x55, 0xed, 0x65,
+	0x44, 0x6e, 0x25, 0xb8, 0x2d, 0x44, 0x99, 0x1b, 0xb0, 0xec, 0x46, 0xed, 0x90, 0x46, 0x91, 0xdb,
+	0x73, 0x23, 0xee, 0x3a, 0x92, 0xbb, 0xca, 0xaa, 0xbe, 0x56, 0xb5, 0x4f, 0xbb, 0xd1, 0x4e, 0x8a,
+	0x11, 0x3c, 0x66, 0x6b, 0xdc, 0x6a, 0xbe, 0xc6, 0xb5, 0xa0, 0xb1, 0x17, 0xb0, 0x76, 0x3f, 0xec,
+	0x10, 0x4e, 0xb1, 0xbc, 0xa9, 0x09, 0x7c, 0x7d, 0x2f, 0x60, 0x6f, 0x08, 0x58, 0x2b, 0x42, 0x9a,
+	0x9e, 0xeb, 0x67, 0x2a, 0x26, 0x90, 0x34, 0x3d, 0xd7, 0x4f, 0x8a, 0xa5, 0x10, 0x8c, 0xd4, 0x32,
+	0xb3, 0xfb, 0xea, 0xd3, 0x50, 0x16, 0xd8, 0x49, 0xf3, 0x24, 0x11, 0x1b, 0x13, 0x58, 0xbf, 0xd1,
+	0x60, 0xb9, 0x35, 0xf4, 0x6f, 0x51, 0xc2, 0xf8, 0x35, 0x4a, 0x66, 0xca, 0xe5, 0xe3, 0xf6, 0x2d,
+	0x1c, 0xc1, 0xbe, 0xfa, 0x14, 0xfb, 0x5e, 0x81, 0x25, 0xd2, 0x19, 0xb8, 0x11, 0x6d, 0x8f, 0x1d,
+	0x33, 0x1a, 0x12, 0xbc, 0x2d, 0x8d, 0x8d, 0x41, 0xbd, 0x92, 0xe7, 0xf9, 0x04, 0x36, 0x86, 0xac,
+	0xf3, 0xe9, 0x39, 0xe7, 0xb3, 0x7e, 0x5f, 0x80, 0xb3, 0x63, 0xce, 0xf2, 0xdf, 0x12, 0x57, 0x13,
+	0x8e, 0x5d, 0x9e, 0xea, 0xd8, 0x6e, 0xd4, 0xde, 0x73, 0x59, 0xc4, 0x55, 0x04, 0x89, 0x4a, 0xcf,
+	0x8d, 0x5e, 0x45, 0x98, 0x3a, 0x6f, 0x8a, 0x0a, 0x0d, 0x4b, 0x92, 0xa0, 0xcf, 0xe3, 0xf8, 0xa9,
+	0x23, 0xac, 0x25, 0x41, 0xd6, 0x21, 0x9c, 0x9b, 0x50, 0xe2, 0x89, 0x84, 0xc0, 0x27, 0x1a, 0x9c,
+	0xcf, 0xac, 0x6c, 0x07, 0x9e, 0xf7, 0x80, 0xcc, 0x66, 0xc2, 0x09, 0x75, 0x17, 0xa6, 0xa8, 0x7b,
+	0x42, 0xa7, 0xfa, 0xa4, 0x4e, 0x4d, 0x28, 0x1e, 0xd0, 0x51, 0xd4, 0x2c, 0xae, 0xea, 0x6b, 0x0b,
+	0xb6, 0xf8, 0x6d, 0xbd, 0x07, 0x17, 0xa6, 0xb2, 0x79, 0x22, 0x4a, 0xfa, 0xb5, 0x06, 0x0d, 0x99,
+	0xa6, 0x8e, 0x4d, 0x2f, 0x4a, 0x66, 0x3d, 0x95, 0x19, 0x4f, 0x08, 0x71, 0xc2, 0xcc, 0x3b, 0x70,
+	0x43, 0x42, 0xe3, 0x4f, 0x5f, 0x2b, 0x56, 0x4b, 0x46, 0xd9, 0x2e, 0x3f, 0x70, 0x7d, 0x2f, 0xd8,
+	0xb7, 0x7e, 0xae, 0xc1, 0xa2, 0xe2, 0xf5, 0x04, 0x32, 0xc3, 0x24, 0x8f, 0xfa, 0x14, 0x1e, 0xad,
+	0x7d, 0x68, 0xdc, 0xee, 0x85, 0x01, 0x4b, 0x14, 0x98, 0x8b, 0x77, 0xed, 0x08, 0xf1, 0x3e, 0xb9,
+	0x50, 0x61, 0xda, 0x42, 0x6f, 0xc2, 0xa2, 0x5a, 0x68, 0x76, 0xe9, 0x57, 0xb2, 0xd2, 0xd7, 0x62,
+	0x51, 0xad, 0xf7, 0x60, 0xe5, 0x1a, 0xe1, 0x4e, 0xf7, 0xd8, 0x63, 0x64, 0x8a, 0x2f, 0x58, 0x11,
+	0x9c, 0x19, 0x5b, 0xfc, 0xf8, 0x8d, 0x6b, 0xfd, 0x41, 0x83, 0x33, 0xa2, 0x5c, 0x68, 0x0d, 0xfd,
+	0x5d, 0x4e, 0x78, 0x3f, 0x9a, 0x45, 0xe6, 0x4b, 0xa0, 0xb2, 0x72, 0xa6, 0xd0, 0x87, 0x18, 0x84,
+	0xa5, 0x7e, 0xe6, 0x66, 0x44, 0xcf, 0xdd, 0x8c, 0x5c, 0x81, 0x25, 0x87, 0x78, 0x1e, 0x65, 0xed,
+	0xe4, 0x6e, 0x47, 0x45, 0x80, 0x00, 0xef, 0xc6, 0x37, 0x3c, 0x4f, 0x02, 0x38, 0x7d, 0xc6, 0xa8,
+	0x9f, 0xb9, 0x8c, 0xa9, 0xc5, 0x90, 0x56, 0x64, 0xfd, 0x4d, 0x83, 0xb3, 0xe3, 0x62, 0x7c, 0xa9,
+	0x9b, 0xe6, 0x11, 0x23, 0xdb, 0xbc, 0x0a, 0x65, 0xe2, 0x88, 0xda, 0xb6, 0x24, 0x6a, 0xdb, 0xb4,
+	0xee, 0x7e, 0x45, 0x80, 0xed, 0x18, 0x6d, 0xfd, 0x0c, 0x83, 0xde, 0xa3, 0xc4, 0xef, 0x87, 0xf3,
+	0x39, 0x8f, 0x1e, 0xa9, 0x64, 0xc9, 0xab, 0xbd, 0x38, 0xae, 0xf6, 0x5f, 0x68, 0xb0, 0x94, 0x30,
+	0xf5, 0x9f, 0x93, 0x8a, 0x0e, 0x60, 0x49, 0x44, 0xd2, 0x8c, 0x67, 0x77, 0x15, 0x9c, 0x85, 0x4c,
+	0xa2, 0x7e, 0xf8, 0xe9, 0xdd, 0x03, 0x23, 0x5d, 0xec, 0xd8, 0x0f, 0x7c, 0x3f, 0xd5, 0x60, 0x09,
+	0x0f, 0x7c, 0xb3, 0x16, 0x61, 0x97, 0xa0, 0xde, 0x23, 0xc3, 0xb1, 0xdc, 0x04, 0x3d, 0x32, 0x54,
+	0x16, 0xcf, 0x9d, 0xd8, 0xf5, 0x87, 0x9d, 0xd8, 0x8b, 0x99, 0x13, 0xbb, 0xf5, 0x91, 0x06, 0x46,
+	0xca, 0xd3, 0x09, 0xb8, 0xc1, 0x55, 0x28, 0xc9, 0xeb, 0x34, 0x7d, 0x6c, 0x57, 0x49, 0x2e, 0xc6,
+	0x25, 0xde, 0x7a, 0x1e, 0x2a, 0xad, 0xa1, 0xbc, 0xff, 0x32, 0x40, 0xe7, 0x43, 0x3f, 0xbe, 0x29,
+	0xc5, 0x9f, 0xe6, 0x59, 0x28, 0x47, 0x22, 0x55, 0xc4, 0x5a, 0x88, 0x47, 0xd6, 0x9f, 0x34, 0x30,
+	0x6d, 0x79, 0x41, 0x37, 0xab, 0x96, 0x8f, 0xb4, 0x07, 0x1c, 0xcd, 0x99, 0xcd, 0x67, 0xa1, 0x86,
+	0xc7, 0x32, 0xd7, 0xdf, 0x0b, 0x64, 0xbd, 0x94, 0x5d, 0x39, 0x96, 0xce, 0xae, 0x72, 0xf9, 0x23,
+	0xad, 0xac, 0x4a, 0x99, 0x9d, 0xe5, 0x1d, 0x58, 0xce, 0x09, 0x74, 0x02, 0xfb, 0xca, 0x7d, 0xa8,
+	0xdd, 0xdc, 0x9a, 0x45, 0x75, 0x4f, 0x02, 0x44, 0x64, 0x8f, 0xb6, 0xc3, 0xc0, 0xf5, 0x79, 0xac,
+	0xb7, 0x1a, 0x42, 0x76, 0x10, 0x60, 0x75, 0x01, 0x6e, 0x6e, 0x9d, 0x88, 0x04, 0xdf, 0x82, 0x86,
+	0x4d, 0x0e, 0xe7, 0x76, 0xfd, 0xb7, 0x08, 0x05, 0x67, 0x2f, 0xee, 0xc0, 0x14, 0x9c, 0x3d, 0xeb,
+	0x43, 0x0d, 0x16, 0xd5, 0xfc, 0x73, 0x2e, 0x63, 0x66, 0xb9, 0xe4, 0xfb, 0xbe, 0x26, 0xc4, 0xdd,
+	0xe9, 0xcf, 0x49, 0xdc, 0xe9, 0x2c, 0x48, 0x25, 0x14, 0x95, 0x12, 0xf0, 0xbb, 0xf4, 0x50, 0x86,
+	0x3f, 0xb1, 0xb8, 0x53, 0x6c, 0xcc, 0xbb, 0xb8, 0xfb, 0x11, 0xc6, 0x35, 0x39, 0x14, 0xc9, 0x7a,
+	0x46, 0x39, 0x8f, 0x78, 0x49, 0x36, 0x66, 0x6b, 0xd1, 0x34, 0xe1, 0x9e, 0x8c, 0x5e, 0x6c, 0x9a,
+	0x70, 0x2f, 0xb2, 0xde, 0x82, 0xe5, 0x1c, 0x33, 0xf3, 0x96, 0xd6, 0x11, 0xf3, 0xdf, 0xa4, 0x98,
+	0xb7, 0x5b, 0xad, 0xed, 0xe3, 0x71, 0xe2, 0x9f, 0x68, 0xb0, 0x92, 0x5f, 0x65, 0xde, 0xae, 0x1c,
+	0x7b, 0x88, 0x9e, 0x78, 0xc8, 0xa3, 0xdd, 0xb8, 0x93, 0x9a, 0x78, 0x8e, 0x9b, 0xff, 0xb8, 0xd8,
+	0x01, 0x2c, 0xe7, 0x56, 0x39, 0xf6, 0x5d, 0xff, 0x6d, 0x30, 0x6c, 0x72, 0x78, 0x9d, 0x7a, 0x94,
+	0xd3, 0xe3, 0xb1, 0xe4, 0x37, 0xe1, 0x74, 0x66, 0x85, 0x79, 0x3b, 0xe3, 0x3e, 0x9c, 0x51, 0x0a,
+	0x9b, 0x5d, 0x88, 0xa3, 0x58, 0x86, 0xc0, 0xd9, 0xf1, 0x85, 0xe6, 0x2d, 0xcb, 0x47, 0x1a, 0x98,
+	0xf1, 0xdc, 0xc4, 0xdf, 0xa7, 0x73, 0xef, 0x8a, 0x64, 0x1a, 0x16, 0x7a, 0xb6, 0x61, 0x81, 0xa5,
+	0x9b, 0x1f, 0x70, 0x77, 0x2f, 0xee, 0x80, 0x48, 0xd7, 0x07, 0x09, 0xc2, 0x26, 0x08, 0xa6, 0x94,
+	0x1c, 0x63, 0xf3, 0x96, 0xfc, 0x7d, 0xac, 0xf6, 0xf1, 0x90, 0x15, 0xf5, 0x7b, 0xb3, 0x88, 0x9d,
+	0xa9, 0xa1, 0x0b, 0xf9, 0xce, 0xce, 0x55, 0x28, 0x31, 0xe4, 0x79, 0xa2, 0x15, 0x78, 0x87, 0x8e,
+	0xa4, 0x30, 0x12, 0x6f, 0x7d, 0xaa, 0x81, 0x91, 0xb2, 0x70, 0x02, 0xa5, 0xe6, 0x79, 0xa8, 0x3a,
+	0xcc, 0x79, 0xf1, 0x85, 0x61, 0xc0, 0xe2, 0x24, 0x94, 0x8c, 0xd1, 0x8c, 0xa2, 0x8b, 0xda, 0x3e,
+	0x18, 0xa8, 0xb3, 0x51, 0x55, 0x00, 0xee, 0x0c, 0xc4, 0x93, 0x04, 0x89, 0x7c, 0x30, 0xe2, 0x54,
+	0x9d, 0x58, 0x41, 0x80, 0xae, 0x21, 0xc4, 0xfa, 0x40, 0x13, 0x41, 0xf1, 0xa5, 0xb8, 0xd2, 0xd8,
+	0x3e, 0x1c, 0x87, 0xcd, 0xb1, 0x3a, 0xcf, 0x1f, 0x65, 0xbd, 0x73, 0x82, 0x8d, 0xc4, 0x6c, 0xbb,
+	0xb0, 0x98, 0x6f, 0x17, 0x4a, 0xf9, 0x4b, 0xc9, 0x06, 0x3d, 0x43, 0xfb, 0x70, 0x1f, 0x96, 0x12,
+	0x71, 0x66, 0xd7, 0xd5, 0x53, 0xa0, 0x1f, 0x0c, 0x64, 0x4a, 0x9b, 0x92, 0xfd, 0x11, 0x67, 0x7d,
+	0x28, 0xfd, 0x64, 0x3e, 0x5d, 0xbe, 0xc7, 0xaf, 0xf7, 0xb8, 0x0e, 0xdf, 0xcb, 0xe2, 0x85, 0x92,
+	0x70, 0x92, 0xbc, 0x51, 0xb4, 0x87, 0x3b, 0x5f, 0x21, 0xa7, 0xb9, 0xcf, 0xb4, 0x74, 0xfb, 0x9c,
+	0xd5, 0x1d, 0x9e, 0x86, 0xb2, 0x48, 0x08, 0x53, 0x6f, 0x76, 0xa5, 0x07, 0xc7, 0x04, 0x28, 0x0f,
+	0x25, 0x4e, 0xb7, 0x9d, 0xf5, 0x90, 0x1a, 0x42, 0xb6, 0xe7, 0xe6, 0x25, 0x96, 0x07, 0x2b, 0x79,
+	0x89, 0x8e, 0xd5, 0x23, 0xfe, 0xa9, 0x41, 0xd3, 0x26, 0x87, 0x5b, 0x41, 0x2f, 0x24, 0x8c, 0xbe,
+	0xe2, 0x77, 0x76, 0x0f, 0x49, 0x78, 0x9c, 0x65, 0xfb, 0x33, 0x60, 0x86, 0x8c, 0x0e, 0xdc, 0xa0,
+	0x1f, 0xb5, 0xb1, 0xf6, 0x92, 0x6f, 0xbf, 0xa4, 0xb6, 0x0c, 0x85, 0x79, 0x3d, 0xe0, 0xf2, 0xa1,
+	0xd7, 0x65, 0x58, 0x4c, 0xa8, 0xe5, 0x64, 0x25, 0x31, 0x59, 0x43, 0x41, 0xef, 0x67, 0xce, 0x02,
+	0xe5, 0xf1, 0xb3, 0x40, 0x25, 0x3d, 0x0b, 0xfc, 0x59, 0x83, 0x27, 0xa6, 0xc8, 0x39, 0xef, 0x12,
+	0xb3, 0x09, 0x95, 0xa8, 0xef, 0x38, 0x94, 0x76, 0x9a, 0x7a, 0xfc, 0x48, 0x48, 0x0e, 0x8f, 0x45,
+	0x6e, 0x3c, 0x69, 0xd5, 0xee, 0x0e, 0x1c, 0x47, 0x3c, 0x6e, 0x33, 0x2f, 0x41, 0x51, 0x3c, 0x1c,
+	0x9c, 0xd2, 0x25, 0x17, 0x88, 0xdc, 0xab, 0xb7, 0x42, 0xfe, 0xd5, 0xdb, 0x05, 0xa8, 0xa5, 0xdd,
+	0x56, 0xb5, 0x39, 0xc5, 0xad, 0x56, 0xf1, 0x7e, 0xa9, 0x1b, 0xe0, 0x15, 0x84, 0x60, 0x45, 0xbe,
+	0x71, 0x03, 0x01, 0x92, 0x7c, 0x7c, 0x5d, 0xb2, 0x21, 0x06, 0x8f, 0x7a, 0x5b, 0x97, 0xb8, 0x44,
+	0x21, 0xfb, 0xc4, 0x41, 0x34, 0xfa, 0x07, 0x8e, 0xec, 0x1c, 0x7f, 0x11, 0x21, 0x32, 0xef, 0xf0,
+	0xf4, 0xfc, 0x3b, 0xbc, 0xc7, 0x4a, 0xf0, 0x41, 0xcc, 0x83, 0xb8, 0xdf, 0x51, 0x6f, 0x8e, 0xc6,
+	0xdf, 0x70, 0x28, 0x26, 0xe3, 0x37, 0x47, 0xeb, 0x50, 0x16, 0xed, 0x67, 0x15, 0x61, 0x66, 0x8e,
+	0x50, 0xd8, 0xc4, 0x8e, 0x29, 0x90, 0x56, 0x2c, 0xad, 0xee, 0x99, 0xf2, 0xb4, 0x82, 0x07, 0x3b,
+	0xa6, 0xb0, 0x76, 0x61, 0x19, 0x81, 0x37, 0x29, 0xbf, 0x86, 0x17, 0xde, 0x73, 0x89, 0x46, 0xeb,
+	0x07, 0x1a, 0xac, 0xe4, 0x67, 0x9d, 0xb7, 0xef, 0x5f, 0x86, 0x22, 0x5e, 0x2c, 0x4d, 0xd4, 0x5d,
+	0x4a, 0xad, 0xb6, 0x40, 0x5b, 0x6f, 0xc3, 0xb9, 0x84, 0x8f, 0xf8, 0x46, 0x7e, 0x16, 0x09, 0x1f,
+	0xee, 0x06, 0xf8, 0x06, 0xaa, 0x39, 0xb9, 0xc4, 0x31, 0x9c, 0x26, 0xc7, 0x9e, 0x81, 0x2a, 0x05,
+	0x14, 0x1f, 0xad, 0x80, 0xf7, 0xf1, 0x9d, 0x4d, 0xe8, 0xb9, 0x5c, 0x3e, 0x9d, 0x9c, 0xed, 0xe6,
+	0xb5, 0x16, 0xe1, 0x0c, 0xe9, 0x8e, 0x78, 0xad, 0xd0, 0xd4, 0xec, 0xaa, 0x00, 0xe2, 0x86, 0x89,
+	0x37, 0x5f, 0x8a, 0x40, 0x75, 0x86, 0x6a, 0x0a, 0x1b, 0x61, 0xa7, 0x66, 0x39, 0xc7, 0xc2, 0xec,
+	0xca, 0xb9, 0x02, 0x45, 0x8f, 0xee, 0xf1, 0xb8, 0xf8, 0x5d, 0xcc, 0x3f, 0x0b, 0x15, 0x5c, 0x09,
+	0xbc, 0xb9, 0x06, 0x25, 0xe6, 0xee, 0x77, 0x79, 0x53, 0x7f, 0x28, 0xa1, 0x24, 0x30, 0xd7, 0x70,
+	0x6b, 0xdc, 0x17, 0x9d, 0x3e, 0x79, 0x45, 0x39, 0x46, 0x6b, 0x2b, 0x34, 0xde, 0xc2, 0x9c, 0x11,
+	0x87, 0x66, 0x1c, 0x62, 0xab, 0x66, 0x26, 0x4f, 0x4a, 0x0e, 0x0c, 0x85, 0x47, 0x1f, 0x18, 0x44,
+	0x03, 0xa3, 0xdb, 0xf7, 0x0f, 0xe4, 0xb3, 0x16, 0x3d, 0x6e, 0x60, 0x20, 0x04, 0x1f, 0xb6, 0x58,
+	0xbf, 0xd5, 0xe0, 0xec, 0x38, 0x37, 0x5f, 0xa4, 0xdf, 0x6c, 0x90, 0x30, 0x64, 0xc1, 0xd0, 0xed,
+	0x61, 0xeb, 0x5b, 0x2c, 0x29, 0xfd, 0x7c, 0x29, 0x03, 0xc7, 0x85, 0xc7, 0x49, 0x63, 0x93, 0x8f,
+	0x93, 0xa2, 0xe1, 0xc7, 0xfc, 0xa2, 0x38, 0xee, 0x17, 0xdf, 0x81, 0x27, 0xde, 0xf0, 0xf1, 0x82,
+	0xf4, 0x3a, 0x8d, 0x38, 0x0b, 0x46, 0x27, 0x7b, 0x94, 0xb0, 0x28, 0x9c, 0x9f, 0xb6, 0xfc, 0xbc,
+	0x8f, 0x0f, 0x2f, 0x81, 0x81, 0x4f, 0x6a, 0x6f, 0xfb, 0x1d, 0x3a, 0x9c, 0x41, 0x38, 0x8b, 0xc2,
+	0xe9, 0xcc, 0xf7, 0xb3, 0x73, 0x27, 0xaa, 0x63, 0xd2, 0x69, 0xbb, 0x38, 0x91, 0xba, 0x9e, 0x66,
+	0x6a, 0xe6, 0xf5, 0xff, 0x07, 0x48, 0x5f, 0x3c, 0x9b, 0x00, 0xe5, 0xd7, 0x03, 0xd6, 0x23, 0x9e,
+	0x71, 0xca, 0xac, 0x80, 0xbe, 0x1d, 0x1c, 0x1a, 0x9a, 0x59, 0x85, 0xe2, 0x2d, 0x77, 0xbf, 0x6b,
+	0x14, 0xd6, 0x57, 0x61, 0x31, 0xff, 0xcc, 0xd9, 0x2c, 0x43, 0x61, 0xf7, 0xb6, 0x71, 0x0a, 0xff,
+	0xda, 0x5b, 0x86, 0xb6, 0x7e, 0x0f, 0x0a, 0xf7, 0x42, 0xfc, 0x74, 0xa7, 0xcf, 0xe5, 0x1c, 0xd7,
+	0xa9, 0x27, 0xe7, 0xc0, 0x3d, 0xcd, 0x28, 0x98, 0x0b, 0x50, 0x55, 0xfd, 0x62, 0x43, 0xc7, 0x05,
+	0x6f, 0xfb, 0x11, 0x65, 0xdc, 0x28, 0x9a, 0xcb, 0xb0, 0x34, 0xf6, 0xde, 0xc4, 0x28, 0xad, 0x6f,
+	0x40, 0x2d, 0x79, 0x33, 0x87, 0xb3, 0xbc, 0x1e, 0xf8, 0xd4, 0x38, 0x65, 0xd6, 0xa0, 0x24, 0x6a,
+	0x18, 0x43, 0xc3, 0x09, 0x55, 0x45, 0x63, 0x14, 0xd6, 0xdf, 0x82, 0xb2, 0xec, 0x43, 0x4a, 0xb8,
+	0xfc, 0x6d, 0x9c, 0x32, 0xcf, 0xc0, 0xe9, 0x56, 0x6b, 0xfb, 0xc6, 0x30, 0x74, 0x19, 0x4d, 0xd6,
+	0xd7, 0xcc, 0x26, 0xac, 0xe0, 0x42, 0x6a, 0x82, 0x04, 0x53, 0xc0, 0x0f, 0xee, 0x26, 0x0f, 0xc1,
+	0x76, 0x77, 0xfa, 0x51, 0x97, 0x76, 0x0c, 0xfd, 0xda, 0x95, 0xbf, 0xfe, 0xaa, 0xaa, 0x7d, 0xfa,
+	0xf9, 0x45, 0xed, 0xb3, 0xcf, 0x2f, 0x6a, 0x7f, 0xff, 0xfc, 0xa2, 0xf6, 0xf1, 0x3f, 0x2e, 0x9e,
+	0x02, 0x23, 0x60, 0xfb, 0x1b, 0xdc, 0x3d, 0x18, 0x6c, 0x1c, 0x0c, 0xc4, 0xff, 0x7f, 0x3c, 0x28,
+	0x8b, 0x3f, 0xcf, 0xff, 0x7b, 0x00, 0xe5, 0xf2, 0x59, 0xc5, 0x64, 0x32, 0x00, 0x00,
 }
@@ -0,0 +1,68 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metapb
+
+import (
+	"bytes"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// IsStale reports whether m is older than other, that is, whether either
+// its version or its conf_ver is behind. A stale epoch must not replace a
+// newer one.
+func (m *RegionEpoch) IsStale(other *RegionEpoch) bool {
+	return m.GetVersion() < other.GetVersion() || m.GetConfVer() < other.GetConfVer()
+}
+
+// ContainsKey reports whether key is in [start_key, end_key). An empty
+// end_key means +inf.
+func (m *Region) ContainsKey(key []byte) bool {
+	return bytes.Compare(key, m.GetStartKey()) >= 0 && lessThanEnd(key, m.GetEndKey())
+}
+
+// Overlaps reports whether the key ranges of m and other share a key.
+func (m *Region) Overlaps(other *Region) bool {
+	_, _, ok := m.Intersect(other.GetStartKey(), other.GetEndKey())
+	return ok
+}
+
+// Intersect returns the part of [startKey, endKey) inside m, and false if
+// there is none. An empty endKey, in the arguments or the result, means +inf.
+func (m *Region) Intersect(startKey, endKey []byte) ([]byte, []byte, bool) {
+	start, end := startKey, endKey
+	if bytes.Compare(m.GetStartKey(), start) > 0 {
+		start = m.GetStartKey()
+	}
+	if regionEnd := m.GetEndKey(); len(regionEnd) > 0 && (len(end) == 0 || bytes.Compare(regionEnd, end) < 0) {
+		end = regionEnd
+	}
+	if !lessThanEnd(start, end) {
+		return nil, nil, false
+	}
+	return start, end, true
+}
+
+// Clone returns a deep copy of m.
+func (m *Region) Clone() *Region {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Region)
+}
+
+// lessThanEnd reports whether key < end, where an empty end means +inf.
+func lessThanEnd(key, end []byte) bool {
+	return len(end) == 0 || bytes.Compare(key, end) < 0
+}
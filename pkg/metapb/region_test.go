@@ -0,0 +1,148 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metapb
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+// testKey is a key over a tiny alphabet, so that random keys collide and
+// the boundaries, including the empty key, are hit often.
+type testKey []byte
+
+func (testKey) Generate(r *rand.Rand, size int) reflect.Value {
+	key := make([]byte, r.Intn(3))
+	for i := range key {
+		key[i] = byte('a' + r.Intn(3))
+	}
+	return reflect.ValueOf(testKey(key))
+}
+
+func newRegion(start, end testKey) *Region {
+	if len(end) > 0 && bytes.Compare(start, end) >= 0 {
+		start, end = end, start
+	}
+	return &Region{StartKey: start, EndKey: end}
+}
+
+var quickConfig = &quick.Config{MaxCount: 5000}
+
+// allKeys returns every key of the test alphabet, plus one that sorts after
+// all of them, to check ranges exhaustively.
+func allKeys() [][]byte {
+	keys := [][]byte{{}, {0xff}}
+	for _, a := range "abc" {
+		keys = append(keys, []byte{byte(a)})
+		for _, b := range "abc" {
+			keys = append(keys, []byte{byte(a), byte(b)})
+		}
+	}
+	return keys
+}
+
+func inRange(key, start, end []byte) bool {
+	return bytes.Compare(key, start) >= 0 && (len(end) == 0 || bytes.Compare(key, end) < 0)
+}
+
+func TestContainsKey(t *testing.T) {
+	f := func(start, end, key testKey) bool {
+		region := newRegion(start, end)
+		return region.ContainsKey(key) == inRange(key, region.StartKey, region.EndKey)
+	}
+	if err := quick.Check(f, quickConfig); err != nil {
+		t.Fatal(err)
+	}
+	if !(&Region{}).ContainsKey([]byte{0xff, 0xff}) {
+		t.Fatal("a region without end key must contain the largest key")
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	f := func(s1, e1, s2, e2 testKey) bool {
+		a, b := newRegion(s1, e1), newRegion(s2, e2)
+		shared := false
+		for _, key := range allKeys() {
+			if a.ContainsKey(key) && b.ContainsKey(key) {
+				shared = true
+			}
+		}
+		return a.Overlaps(b) == shared && b.Overlaps(a) == shared
+	}
+	if err := quick.Check(f, quickConfig); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	f := func(s1, e1, s2, e2 testKey) bool {
+		region, r := newRegion(s1, e1), newRegion(s2, e2)
+		start, end, ok := region.Intersect(r.StartKey, r.EndKey)
+		for _, key := range allKeys() {
+			expect := region.ContainsKey(key) && r.ContainsKey(key)
+			if expect && !ok || ok && inRange(key, start, end) != expect {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, quickConfig); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	f := func(v1, c1, v2, c2 uint8) bool {
+		a := &RegionEpoch{Version: uint64(v1), ConfVer: uint64(c1)}
+		b := &RegionEpoch{Version: uint64(v2), ConfVer: uint64(c2)}
+		if a.IsStale(a) {
+			return false
+		}
+		// Epochs neither of which is stale against the other are equal.
+		if !a.IsStale(b) && !b.IsStale(a) && (v1 != v2 || c1 != c2) {
+			return false
+		}
+		return a.IsStale(b) == (v1 < v2 || c1 < c2)
+	}
+	if err := quick.Check(f, quickConfig); err != nil {
+		t.Fatal(err)
+	}
+	if (*RegionEpoch)(nil).IsStale(nil) || !(*RegionEpoch)(nil).IsStale(&RegionEpoch{Version: 1}) {
+		t.Fatal("a nil epoch is the zero epoch")
+	}
+}
+
+func TestClone(t *testing.T) {
+	region := &Region{
+		Id:          1,
+		StartKey:    []byte("a"),
+		RegionEpoch: &RegionEpoch{ConfVer: 1, Version: 2},
+		Peers:       []*Peer{{Id: 2, StoreId: 3}},
+	}
+	clone := region.Clone()
+	if !reflect.DeepEqual(clone, region) {
+		t.Fatalf("expect %v, got %v", region, clone)
+	}
+	clone.Peers[0].StoreId = 4
+	clone.StartKey[0] = 'b'
+	if region.Peers[0].StoreId != 3 || region.StartKey[0] != 'a' {
+		t.Fatal("clone shares memory with the original")
+	}
+	if (*Region)(nil).Clone() != nil {
+		t.Fatal("expect nil clone of nil")
+	}
+}
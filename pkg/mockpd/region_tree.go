@@ -0,0 +1,139 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockpd
+
+import (
+	"bytes"
+
+	"github.com/google/btree"
+	"github.com/pingcap/kvproto/pkg/metapb"
+)
+
+const btreeDegree = 32
+
+type regionItem struct {
+	region *metapb.Region
+	leader *metapb.Peer
+}
+
+// Less orders regions by start key.
+func (r *regionItem) Less(other btree.Item) bool {
+	return bytes.Compare(r.region.GetStartKey(), other.(*regionItem).region.GetStartKey()) < 0
+}
+
+// regionTree keeps non-overlapping regions ordered by key range.
+type regionTree struct {
+	tree    *btree.BTree
+	regions map[uint64]*regionItem
+}
+
+func newRegionTree() *regionTree {
+	return &regionTree{
+		tree:    btree.New(btreeDegree),
+		regions: make(map[uint64]*regionItem),
+	}
+}
+
+func pivot(key []byte) *regionItem {
+	return &regionItem{region: &metapb.Region{StartKey: key}}
+}
+
+// update inserts region, removing every region that overlaps with it.
+func (t *regionTree) update(region *metapb.Region, leader *metapb.Peer) {
+	for _, item := range t.overlaps(region) {
+		t.tree.Delete(item)
+		delete(t.regions, item.region.GetId())
+	}
+	item := &regionItem{region: region, leader: leader}
+	t.tree.ReplaceOrInsert(item)
+	t.regions[region.GetId()] = item
+}
+
+// overlaps returns the regions intersecting the range of region.
+func (t *regionTree) overlaps(region *metapb.Region) []*regionItem {
+	var items []*regionItem
+	start := region.GetStartKey()
+	if first := t.find(start); first != nil {
+		start = first.region.GetStartKey()
+	}
+	end := region.GetEndKey()
+	t.tree.AscendGreaterOrEqual(pivot(start), func(i btree.Item) bool {
+		item := i.(*regionItem)
+		if len(end) > 0 && bytes.Compare(item.region.GetStartKey(), end) >= 0 {
+			return false
+		}
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+// find returns the region containing key.
+func (t *regionTree) find(key []byte) *regionItem {
+	var result *regionItem
+	t.tree.DescendLessOrEqual(pivot(key), func(i btree.Item) bool {
+		result = i.(*regionItem)
+		return false
+	})
+	if result == nil || !result.region.ContainsKey(key) {
+		return nil
+	}
+	return result
+}
+
+// prev returns the region right before the region containing key. An empty
+// key means +inf, whose previous region is the last one.
+func (t *regionTree) prev(key []byte) *regionItem {
+	if len(key) == 0 {
+		if last := t.tree.Max(); last != nil {
+			return last.(*regionItem)
+		}
+		return nil
+	}
+	cur := t.find(key)
+	if cur == nil || len(cur.region.GetStartKey()) == 0 {
+		return nil
+	}
+	var result *regionItem
+	t.tree.DescendLessOrEqual(cur, func(i btree.Item) bool {
+		if i == btree.Item(cur) {
+			return true
+		}
+		result = i.(*regionItem)
+		return false
+	})
+	return result
+}
+
+// scan returns at most limit regions from the one containing startKey until
+// endKey. A limit <= 0 means no limit and an empty endKey means +inf.
+func (t *regionTree) scan(startKey, endKey []byte, limit int) []*regionItem {
+	if first := t.find(startKey); first != nil {
+		startKey = first.region.GetStartKey()
+	}
+	var items []*regionItem
+	t.tree.AscendGreaterOrEqual(pivot(startKey), func(i btree.Item) bool {
+		item := i.(*regionItem)
+		if len(endKey) > 0 && bytes.Compare(item.region.GetStartKey(), endKey) >= 0 {
+			return false
+		}
+		items = append(items, item)
+		return limit <= 0 || len(items) < limit
+	})
+	return items
+}
+
+func (t *regionTree) len() int {
+	return t.tree.Len()
+}
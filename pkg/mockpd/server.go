@@ -0,0 +1,728 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mockpd implements an in-memory PD server for testing clients
+// without a real cluster.
+package mockpd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a mock PD server. It keeps stores, a region tree, an ID
+// allocator, the TSO and the GC safe point in memory.
+type Server struct {
+	sync.RWMutex
+	clusterID    uint64
+	members      []*pdpb.Member
+	leader       *pdpb.Member
+	bootstrapped bool
+	cluster      *metapb.Cluster
+	id           uint64
+	tsPhysical   int64
+	tsLogical    int64
+	stores       map[uint64]*metapb.Store
+	storeStats   map[uint64]*pdpb.StoreStats
+	regions      *regionTree
+	safePoint    uint64
+}
+
+var _ pdpb.PDServer = (*Server)(nil)
+
+// NewServer creates a Server for clusterID which is not bootstrapped yet.
+func NewServer(clusterID uint64) *Server {
+	member := &pdpb.Member{Name: "pd", MemberId: 1}
+	return &Server{
+		clusterID:  clusterID,
+		members:    []*pdpb.Member{member},
+		leader:     member,
+		cluster:    &metapb.Cluster{Id: clusterID},
+		stores:     make(map[uint64]*metapb.Store),
+		storeStats: make(map[uint64]*pdpb.StoreStats),
+		regions:    newRegionTree(),
+	}
+}
+
+// ClusterID returns the cluster ID of the server.
+func (s *Server) ClusterID() uint64 {
+	return s.clusterID
+}
+
+func (s *Server) header() *pdpb.ResponseHeader {
+	return &pdpb.ResponseHeader{ClusterId: s.clusterID}
+}
+
+func (s *Server) errorHeader(typ pdpb.ErrorType, message string) *pdpb.ResponseHeader {
+	return &pdpb.ResponseHeader{
+		ClusterId: s.clusterID,
+		Error:     &pdpb.Error{Type: typ, Message: message},
+	}
+}
+
+func (s *Server) notBootstrappedHeader() *pdpb.ResponseHeader {
+	return s.errorHeader(pdpb.ErrorType_NOT_BOOTSTRAPPED, "cluster is not bootstrapped")
+}
+
+func (s *Server) validateRequest(header *pdpb.RequestHeader) error {
+	if header.GetClusterId() != s.clusterID {
+		return status.Errorf(codes.FailedPrecondition, "mismatch cluster id, need %d but got %d", s.clusterID, header.GetClusterId())
+	}
+	return nil
+}
+
+func (s *Server) allocID() uint64 {
+	s.id++
+	return s.id
+}
+
+// GetMembers implements pdpb.PDServer.
+func (s *Server) GetMembers(ctx context.Context, req *pdpb.GetMembersRequest) (*pdpb.GetMembersResponse, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return &pdpb.GetMembersResponse{
+		Header:     s.header(),
+		Members:    s.members,
+		Leader:     s.leader,
+		EtcdLeader: s.leader,
+	}, nil
+}
+
+// SetMembers replaces the PD members reported by GetMembers, and makes the
+// member with leaderID the leader.
+func (s *Server) SetMembers(members []*pdpb.Member, leaderID uint64) {
+	s.Lock()
+	defer s.Unlock()
+	s.members, s.leader = members, nil
+	for _, member := range members {
+		if member.GetMemberId() == leaderID {
+			s.leader = member
+		}
+	}
+}
+
+// Member returns the view of the server as the member with id. All members
+// share the state of the server, but only the leader serves TSO, so serving
+// each member on its own address simulates a PD cluster with failover.
+func (s *Server) Member(id uint64) pdpb.PDServer {
+	return &memberServer{Server: s, id: id}
+}
+
+type memberServer struct {
+	*Server
+	id uint64
+}
+
+// Tso implements pdpb.PDServer.
+func (m *memberServer) Tso(stream pdpb.PD_TsoServer) error {
+	return m.serveTso(stream, m.id)
+}
+
+// Tso implements pdpb.PDServer. Every response carries the largest of the
+// count timestamps allocated for the request.
+func (s *Server) Tso(stream pdpb.PD_TsoServer) error {
+	return s.serveTso(stream, 0)
+}
+
+// serveTso serves TSO as the member with memberID, or as whichever member is
+// the leader if memberID is 0.
+func (s *Server) serveTso(stream pdpb.PD_TsoServer, memberID uint64) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = s.validateRequest(req.GetHeader()); err != nil {
+			return err
+		}
+		s.RLock()
+		isLeader := memberID == 0 || s.leader.GetMemberId() == memberID
+		s.RUnlock()
+		if !isLeader {
+			return status.Error(codes.Unavailable, "not leader")
+		}
+		count := req.GetCount()
+		if count == 0 {
+			return status.Error(codes.InvalidArgument, "tso count should be positive")
+		}
+		ts, err := s.allocTimestamp(int64(count))
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&pdpb.TsoResponse{
+			Header:    s.header(),
+			Count:     count,
+			Timestamp: ts,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// allocTimestamp reserves count consecutive timestamps and returns the last
+// one. Timestamps never go backwards, even if the wall clock does. The count
+// must fit in the logical timestamps of one millisecond.
+func (s *Server) allocTimestamp(count int64) (*pdpb.Timestamp, error) {
+	if count >= pdpb.MaxLogical {
+		return nil, status.Errorf(codes.InvalidArgument, "tso count should be less than %d", pdpb.MaxLogical)
+	}
+	s.Lock()
+	defer s.Unlock()
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	if now > s.tsPhysical {
+		s.tsPhysical, s.tsLogical = now, 0
+	}
+	if s.tsLogical+count >= pdpb.MaxLogical {
+		s.tsPhysical, s.tsLogical = s.tsPhysical+1, 0
+	}
+	s.tsLogical += count
+	return &pdpb.Timestamp{Physical: s.tsPhysical, Logical: s.tsLogical}, nil
+}
+
+// Bootstrap implements pdpb.PDServer.
+func (s *Server) Bootstrap(ctx context.Context, req *pdpb.BootstrapRequest) (*pdpb.BootstrapResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.bootstrapped {
+		return &pdpb.BootstrapResponse{Header: s.errorHeader(pdpb.ErrorType_ALREADY_BOOTSTRAPPED, "cluster is already bootstrapped")}, nil
+	}
+	store, region := req.GetStore(), req.GetRegion()
+	if store.GetId() == 0 || region.GetId() == 0 || len(region.GetPeers()) == 0 {
+		return &pdpb.BootstrapResponse{Header: s.errorHeader(pdpb.ErrorType_UNKNOWN, "invalid bootstrap request")}, nil
+	}
+	s.stores[store.GetId()] = proto.Clone(store).(*metapb.Store)
+	region = region.Clone()
+	s.regions.update(region, region.GetPeers()[0])
+	s.bumpID(store.GetId())
+	s.bumpID(region.GetId())
+	for _, p := range region.GetPeers() {
+		s.bumpID(p.GetId())
+	}
+	s.bootstrapped = true
+	return &pdpb.BootstrapResponse{Header: s.header()}, nil
+}
+
+// bumpID makes sure the allocator never hands out an ID already in use.
+func (s *Server) bumpID(id uint64) {
+	if id > s.id {
+		s.id = id
+	}
+}
+
+// IsBootstrapped implements pdpb.PDServer.
+func (s *Server) IsBootstrapped(ctx context.Context, req *pdpb.IsBootstrappedRequest) (*pdpb.IsBootstrappedResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return &pdpb.IsBootstrappedResponse{Header: s.header(), Bootstrapped: s.bootstrapped}, nil
+}
+
+// AllocID implements pdpb.PDServer.
+func (s *Server) AllocID(ctx context.Context, req *pdpb.AllocIDRequest) (*pdpb.AllocIDResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	return &pdpb.AllocIDResponse{Header: s.header(), Id: s.allocID()}, nil
+}
+
+// GetStore implements pdpb.PDServer.
+func (s *Server) GetStore(ctx context.Context, req *pdpb.GetStoreRequest) (*pdpb.GetStoreResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.GetStoreResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	store, ok := s.stores[req.GetStoreId()]
+	if !ok {
+		return &pdpb.GetStoreResponse{Header: s.errorHeader(pdpb.ErrorType_UNKNOWN, "invalid store ID, not found")}, nil
+	}
+	return &pdpb.GetStoreResponse{
+		Header: s.header(),
+		Store:  proto.Clone(store).(*metapb.Store),
+		Stats:  s.storeStats[req.GetStoreId()],
+	}, nil
+}
+
+// PutStore implements pdpb.PDServer.
+func (s *Server) PutStore(ctx context.Context, req *pdpb.PutStoreRequest) (*pdpb.PutStoreResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.PutStoreResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	store := req.GetStore()
+	if old, ok := s.stores[store.GetId()]; ok && old.GetState() == metapb.StoreState_Tombstone {
+		return &pdpb.PutStoreResponse{Header: s.errorHeader(pdpb.ErrorType_STORE_TOMBSTONE, "store is tombstone")}, nil
+	}
+	for _, other := range s.stores {
+		if other.GetId() != store.GetId() && other.GetAddress() == store.GetAddress() && other.GetState() != metapb.StoreState_Tombstone {
+			return &pdpb.PutStoreResponse{Header: s.errorHeader(pdpb.ErrorType_UNKNOWN, "duplicated store address")}, nil
+		}
+	}
+	s.stores[store.GetId()] = proto.Clone(store).(*metapb.Store)
+	s.bumpID(store.GetId())
+	return &pdpb.PutStoreResponse{Header: s.header()}, nil
+}
+
+// GetAllStores implements pdpb.PDServer.
+func (s *Server) GetAllStores(ctx context.Context, req *pdpb.GetAllStoresRequest) (*pdpb.GetAllStoresResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.GetAllStoresResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	stores := make([]*metapb.Store, 0, len(s.stores))
+	for _, store := range s.stores {
+		if req.GetExcludeTombstoneStores() && store.GetState() == metapb.StoreState_Tombstone {
+			continue
+		}
+		stores = append(stores, proto.Clone(store).(*metapb.Store))
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].GetId() < stores[j].GetId() })
+	return &pdpb.GetAllStoresResponse{Header: s.header(), Stores: stores}, nil
+}
+
+// StoreHeartbeat implements pdpb.PDServer.
+func (s *Server) StoreHeartbeat(ctx context.Context, req *pdpb.StoreHeartbeatRequest) (*pdpb.StoreHeartbeatResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.StoreHeartbeatResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	storeID := req.GetStats().GetStoreId()
+	if _, ok := s.stores[storeID]; !ok {
+		return &pdpb.StoreHeartbeatResponse{Header: s.errorHeader(pdpb.ErrorType_UNKNOWN, "store not found")}, nil
+	}
+	s.storeStats[storeID] = req.GetStats()
+	return &pdpb.StoreHeartbeatResponse{Header: s.header()}, nil
+}
+
+// RegionHeartbeat implements pdpb.PDServer. Reported regions update the
+// region tree unless their epoch is stale; no operators are ever scheduled.
+func (s *Server) RegionHeartbeat(stream pdpb.PD_RegionHeartbeatServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = s.validateRequest(req.GetHeader()); err != nil {
+			return err
+		}
+		if req.GetRegion().GetId() == 0 || req.GetLeader() == nil {
+			continue
+		}
+		s.Lock()
+		s.putRegionIfNewer(req.GetRegion(), req.GetLeader())
+		s.Unlock()
+	}
+}
+
+func (s *Server) putRegionIfNewer(region *metapb.Region, leader *metapb.Peer) bool {
+	for _, item := range s.regions.overlaps(region) {
+		if region.GetRegionEpoch().IsStale(item.region.GetRegionEpoch()) {
+			return false
+		}
+	}
+	s.regions.update(region.Clone(), proto.Clone(leader).(*metapb.Peer))
+	s.bumpID(region.GetId())
+	return true
+}
+
+func (s *Server) regionResponse(item *regionItem) *pdpb.GetRegionResponse {
+	resp := &pdpb.GetRegionResponse{Header: s.header()}
+	if item == nil {
+		return resp
+	}
+	resp.Region = item.region.Clone()
+	if item.leader != nil {
+		resp.Leader = proto.Clone(item.leader).(*metapb.Peer)
+	}
+	for _, p := range item.region.GetPeers() {
+		if p.GetId() != item.leader.GetId() {
+			resp.Slaves = append(resp.Slaves, proto.Clone(p).(*metapb.Peer))
+		}
+	}
+	return resp
+}
+
+// GetRegion implements pdpb.PDServer.
+func (s *Server) GetRegion(ctx context.Context, req *pdpb.GetRegionRequest) (*pdpb.GetRegionResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.GetRegionResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	return s.regionResponse(s.regions.find(req.GetRegionKey())), nil
+}
+
+// GetPrevRegion implements pdpb.PDServer.
+func (s *Server) GetPrevRegion(ctx context.Context, req *pdpb.GetRegionRequest) (*pdpb.GetRegionResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.GetRegionResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	return s.regionResponse(s.regions.prev(req.GetRegionKey())), nil
+}
+
+// GetRegionByID implements pdpb.PDServer.
+func (s *Server) GetRegionByID(ctx context.Context, req *pdpb.GetRegionByIDRequest) (*pdpb.GetRegionResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.GetRegionResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	return s.regionResponse(s.regions.regions[req.GetRegionId()]), nil
+}
+
+// ScanRegions implements pdpb.PDServer.
+func (s *Server) ScanRegions(ctx context.Context, req *pdpb.ScanRegionsRequest) (*pdpb.ScanRegionsResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.ScanRegionsResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	resp := &pdpb.ScanRegionsResponse{Header: s.header()}
+	for _, item := range s.regions.scan(req.GetStartKey(), req.GetEndKey(), int(req.GetLimit())) {
+		resp.Regions = append(resp.Regions, item.region.Clone())
+		leader := &metapb.Peer{}
+		if item.leader != nil {
+			leader = proto.Clone(item.leader).(*metapb.Peer)
+		}
+		resp.Leaders = append(resp.Leaders, leader)
+	}
+	return resp, nil
+}
+
+// AskSplit implements pdpb.PDServer.
+func (s *Server) AskSplit(ctx context.Context, req *pdpb.AskSplitRequest) (*pdpb.AskSplitResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.AskSplitResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	id := s.allocSplitID(req.GetRegion())
+	return &pdpb.AskSplitResponse{
+		Header:      s.header(),
+		NewRegionId: id.GetNewRegionId(),
+		NewPeerIds:  id.GetNewPeerIds(),
+	}, nil
+}
+
+func (s *Server) allocSplitID(region *metapb.Region) *pdpb.SplitID {
+	id := &pdpb.SplitID{NewRegionId: s.allocID()}
+	for range region.GetPeers() {
+		id.NewPeerIds = append(id.NewPeerIds, s.allocID())
+	}
+	return id
+}
+
+// ReportSplit implements pdpb.PDServer.
+func (s *Server) ReportSplit(ctx context.Context, req *pdpb.ReportSplitRequest) (*pdpb.ReportSplitResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.ReportSplitResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	s.reportSplit([]*metapb.Region{req.GetLeft(), req.GetRight()})
+	return &pdpb.ReportSplitResponse{Header: s.header()}, nil
+}
+
+// AskBatchSplit implements pdpb.PDServer.
+func (s *Server) AskBatchSplit(ctx context.Context, req *pdpb.AskBatchSplitRequest) (*pdpb.AskBatchSplitResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.AskBatchSplitResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	resp := &pdpb.AskBatchSplitResponse{Header: s.header()}
+	for i := uint32(0); i < req.GetSplitCount(); i++ {
+		resp.Ids = append(resp.Ids, s.allocSplitID(req.GetRegion()))
+	}
+	return resp, nil
+}
+
+// ReportBatchSplit implements pdpb.PDServer.
+func (s *Server) ReportBatchSplit(ctx context.Context, req *pdpb.ReportBatchSplitRequest) (*pdpb.ReportBatchSplitResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.ReportBatchSplitResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	s.reportSplit(req.GetRegions())
+	return &pdpb.ReportBatchSplitResponse{Header: s.header()}, nil
+}
+
+// reportSplit records the regions produced by a split. The leader of each
+// new region sits on the same store as the leader of the region it came
+// from.
+func (s *Server) reportSplit(regions []*metapb.Region) {
+	leaders := make([]*metapb.Peer, len(regions))
+	for i, region := range regions {
+		if parent := s.regions.find(region.GetStartKey()); parent != nil && parent.leader != nil {
+			for _, p := range region.GetPeers() {
+				if p.GetStoreId() == parent.leader.GetStoreId() {
+					leaders[i] = p
+				}
+			}
+		}
+		if leaders[i] == nil && len(region.GetPeers()) > 0 {
+			leaders[i] = region.GetPeers()[0]
+		}
+	}
+	for i, region := range regions {
+		s.putRegionIfNewer(region, leaders[i])
+	}
+}
+
+// GetClusterConfig implements pdpb.PDServer.
+func (s *Server) GetClusterConfig(ctx context.Context, req *pdpb.GetClusterConfigRequest) (*pdpb.GetClusterConfigResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if !s.bootstrapped {
+		return &pdpb.GetClusterConfigResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	return &pdpb.GetClusterConfigResponse{Header: s.header(), Cluster: proto.Clone(s.cluster).(*metapb.Cluster)}, nil
+}
+
+// PutClusterConfig implements pdpb.PDServer.
+func (s *Server) PutClusterConfig(ctx context.Context, req *pdpb.PutClusterConfigRequest) (*pdpb.PutClusterConfigResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.PutClusterConfigResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	if req.GetCluster().GetId() != s.clusterID {
+		return &pdpb.PutClusterConfigResponse{Header: s.errorHeader(pdpb.ErrorType_UNKNOWN, "invalid cluster ID")}, nil
+	}
+	s.cluster = proto.Clone(req.GetCluster()).(*metapb.Cluster)
+	return &pdpb.PutClusterConfigResponse{Header: s.header()}, nil
+}
+
+// ScatterRegion implements pdpb.PDServer. It only records the region if PD
+// does not know it yet.
+func (s *Server) ScatterRegion(ctx context.Context, req *pdpb.ScatterRegionRequest) (*pdpb.ScatterRegionResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.bootstrapped {
+		return &pdpb.ScatterRegionResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	if _, ok := s.regions.regions[req.GetRegionId()]; !ok {
+		if req.GetRegion() == nil {
+			return &pdpb.ScatterRegionResponse{Header: s.errorHeader(pdpb.ErrorType_REGION_NOT_FOUND, "region not found")}, nil
+		}
+		s.putRegionIfNewer(req.GetRegion(), req.GetLeader())
+	}
+	return &pdpb.ScatterRegionResponse{Header: s.header()}, nil
+}
+
+// GetGCSafePoint implements pdpb.PDServer.
+func (s *Server) GetGCSafePoint(ctx context.Context, req *pdpb.GetGCSafePointRequest) (*pdpb.GetGCSafePointResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return &pdpb.GetGCSafePointResponse{Header: s.header(), SafePoint: s.safePoint}, nil
+}
+
+// UpdateGCSafePoint implements pdpb.PDServer. The safe point never moves
+// backwards; the response always carries the current one.
+func (s *Server) UpdateGCSafePoint(ctx context.Context, req *pdpb.UpdateGCSafePointRequest) (*pdpb.UpdateGCSafePointResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if req.GetSafePoint() > s.safePoint {
+		s.safePoint = req.GetSafePoint()
+	}
+	return &pdpb.UpdateGCSafePointResponse{Header: s.header(), NewSafePoint: s.safePoint}, nil
+}
+
+// SyncRegions implements pdpb.PDServer. It is not supported.
+func (s *Server) SyncRegions(stream pdpb.PD_SyncRegionsServer) error {
+	return status.Error(codes.Unimplemented, "mockpd: region syncer is not supported")
+}
+
+// GetOperator implements pdpb.PDServer. There are never any operators.
+func (s *Server) GetOperator(ctx context.Context, req *pdpb.GetOperatorRequest) (*pdpb.GetOperatorResponse, error) {
+	if err := s.validateRequest(req.GetHeader()); err != nil {
+		return nil, err
+	}
+	return &pdpb.GetOperatorResponse{
+		Header:   s.errorHeader(pdpb.ErrorType_REGION_NOT_FOUND, "operator not found"),
+		RegionId: req.GetRegionId(),
+	}, nil
+}
+
+// RegionByID returns a region and its leader. Together with Split it lets a
+// Server act as the region topology of mock TiKV servers.
+func (s *Server) RegionByID(regionID uint64) (*metapb.Region, *metapb.Peer) {
+	s.RLock()
+	defer s.RUnlock()
+	item, ok := s.regions.regions[regionID]
+	if !ok {
+		return nil, nil
+	}
+	resp := s.regionResponse(item)
+	return resp.GetRegion(), resp.GetLeader()
+}
+
+// RegionByKey returns the region containing key and its leader.
+func (s *Server) RegionByKey(key []byte) (*metapb.Region, *metapb.Peer) {
+	s.RLock()
+	defer s.RUnlock()
+	item := s.regions.find(key)
+	if item == nil {
+		return nil, nil
+	}
+	resp := s.regionResponse(item)
+	return resp.GetRegion(), resp.GetLeader()
+}
+
+// Split splits a region at splitKeys as TiKV would after asking PD for IDs,
+// and returns all result regions. The last one keeps the original ID.
+func (s *Server) Split(regionID uint64, splitKeys [][]byte) ([]*metapb.Region, error) {
+	s.Lock()
+	defer s.Unlock()
+	item, ok := s.regions.regions[regionID]
+	if !ok {
+		return nil, errors.New("region not found")
+	}
+	region := item.region
+	keys := append([][]byte(nil), splitKeys...)
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	for i, key := range keys {
+		if (i > 0 && bytes.Equal(keys[i-1], key)) || !item.region.ContainsKey(key) || bytes.Equal(key, region.GetStartKey()) {
+			return nil, errors.New("invalid split key")
+		}
+	}
+	epoch := &metapb.RegionEpoch{
+		ConfVer: region.GetRegionEpoch().GetConfVer(),
+		Version: region.GetRegionEpoch().GetVersion() + uint64(len(keys)),
+	}
+	var regions []*metapb.Region
+	start := region.GetStartKey()
+	for _, key := range keys {
+		id := s.allocSplitID(region)
+		r := &metapb.Region{
+			Id:          id.GetNewRegionId(),
+			StartKey:    start,
+			EndKey:      key,
+			RegionEpoch: proto.Clone(epoch).(*metapb.RegionEpoch),
+		}
+		for i, p := range region.GetPeers() {
+			r.Peers = append(r.Peers, &metapb.Peer{Id: id.GetNewPeerIds()[i], StoreId: p.GetStoreId(), IsLearner: p.GetIsLearner()})
+		}
+		regions = append(regions, r)
+		start = key
+	}
+	last := region.Clone()
+	last.StartKey, last.RegionEpoch = start, epoch
+	regions = append(regions, last)
+	s.reportSplit(regions)
+
+	result := make([]*metapb.Region, 0, len(regions))
+	for _, r := range regions {
+		result = append(result, r.Clone())
+	}
+	return result, nil
+}
+
+// ChangeLeader moves the leader of a region to the peer on storeID.
+func (s *Server) ChangeLeader(regionID, storeID uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	item, ok := s.regions.regions[regionID]
+	if !ok {
+		return errors.New("region not found")
+	}
+	for _, p := range item.region.GetPeers() {
+		if p.GetStoreId() == storeID {
+			item.leader = p
+			return nil
+		}
+	}
+	return errors.New("store has no peer of the region")
+}
@@ -0,0 +1,238 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockpd
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/mocktikv"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// A Server can be the region topology of mock TiKV servers.
+var _ mocktikv.Cluster = (*Server)(nil)
+
+const testClusterID = 42
+
+func newTestClient(t *testing.T, server *Server) (pdpb.PDClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pdpb.RegisterPDServer(s, server)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pdpb.NewPDClient(conn), func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func bootstrap(t *testing.T, client pdpb.PDClient) {
+	resp, err := client.Bootstrap(context.Background(), &pdpb.BootstrapRequest{
+		Header: &pdpb.RequestHeader{ClusterId: testClusterID},
+		Store:  &metapb.Store{Id: 1, Address: "store1"},
+		Region: &metapb.Region{
+			Id:          2,
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+			Peers:       []*metapb.Peer{{Id: 3, StoreId: 1}},
+		},
+	})
+	if err != nil || resp.GetHeader().GetError() != nil {
+		t.Fatal(err, resp.GetHeader().GetError())
+	}
+}
+
+func TestBootstrapAndStores(t *testing.T) {
+	client, cleanup := newTestClient(t, NewServer(testClusterID))
+	defer cleanup()
+	ctx := context.Background()
+	header := &pdpb.RequestHeader{ClusterId: testClusterID}
+
+	if _, err := client.IsBootstrapped(ctx, &pdpb.IsBootstrappedRequest{Header: &pdpb.RequestHeader{ClusterId: 1}}); err == nil {
+		t.Fatal("expect cluster id mismatch")
+	}
+	stores, err := client.GetAllStores(ctx, &pdpb.GetAllStoresRequest{Header: header})
+	if err != nil || stores.GetHeader().GetError().GetType() != pdpb.ErrorType_NOT_BOOTSTRAPPED {
+		t.Fatalf("expect not bootstrapped, got %v %v", stores, err)
+	}
+
+	bootstrap(t, client)
+	bootstrapped, err := client.IsBootstrapped(ctx, &pdpb.IsBootstrappedRequest{Header: header})
+	if err != nil || !bootstrapped.GetBootstrapped() {
+		t.Fatal(err, bootstrapped)
+	}
+
+	id, err := client.AllocID(ctx, &pdpb.AllocIDRequest{Header: header})
+	if err != nil || id.GetId() <= 3 {
+		t.Fatalf("expect allocated id after bootstrap ids, got %v %v", id, err)
+	}
+
+	if _, err = client.PutStore(ctx, &pdpb.PutStoreRequest{Header: header, Store: &metapb.Store{Id: 10, Address: "store10"}}); err != nil {
+		t.Fatal(err)
+	}
+	putResp, err := client.PutStore(ctx, &pdpb.PutStoreRequest{Header: header, Store: &metapb.Store{Id: 11, Address: "store10"}})
+	if err != nil || putResp.GetHeader().GetError() == nil {
+		t.Fatalf("expect duplicated address error, got %v %v", putResp, err)
+	}
+	store, err := client.GetStore(ctx, &pdpb.GetStoreRequest{Header: header, StoreId: 10})
+	if err != nil || store.GetStore().GetAddress() != "store10" {
+		t.Fatal(err, store)
+	}
+	stores, err = client.GetAllStores(ctx, &pdpb.GetAllStoresRequest{Header: header})
+	if err != nil || len(stores.GetStores()) != 2 {
+		t.Fatal(err, stores)
+	}
+}
+
+func TestTso(t *testing.T) {
+	client, cleanup := newTestClient(t, NewServer(testClusterID))
+	defer cleanup()
+
+	stream, err := client.Tso(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var last uint64
+	for i := 0; i < 100; i++ {
+		if err = stream.Send(&pdpb.TsoRequest{Header: &pdpb.RequestHeader{ClusterId: testClusterID}, Count: 10}); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ts := resp.GetTimestamp()
+		cur := ts.Uint64()
+		// The first of the 10 timestamps must be newer than the last batch.
+		if cur-9 <= last || resp.GetCount() != 10 {
+			t.Fatalf("tso is not monotonic: %d after %d", cur, last)
+		}
+		last = cur
+	}
+
+	if err = stream.Send(&pdpb.TsoRequest{Header: &pdpb.RequestHeader{ClusterId: testClusterID}, Count: pdpb.MaxLogical}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expect InvalidArgument for a count of MaxLogical, got %v", err)
+	}
+}
+
+func TestRegions(t *testing.T) {
+	server := NewServer(testClusterID)
+	client, cleanup := newTestClient(t, server)
+	defer cleanup()
+	ctx := context.Background()
+	header := &pdpb.RequestHeader{ClusterId: testClusterID}
+	bootstrap(t, client)
+
+	regions, err := server.Split(2, [][]byte{[]byte("m"), []byte("f")})
+	if err != nil || len(regions) != 3 {
+		t.Fatal(err, regions)
+	}
+	if regions[2].GetId() != 2 || string(regions[2].GetStartKey()) != "m" || regions[2].GetRegionEpoch().GetVersion() != 3 {
+		t.Fatalf("unexpected last region %v", regions[2])
+	}
+
+	region, err := client.GetRegion(ctx, &pdpb.GetRegionRequest{Header: header, RegionKey: []byte("g")})
+	if err != nil || string(region.GetRegion().GetStartKey()) != "f" || region.GetLeader().GetStoreId() != 1 {
+		t.Fatal(err, region)
+	}
+	prev, err := client.GetPrevRegion(ctx, &pdpb.GetRegionRequest{Header: header, RegionKey: []byte("g")})
+	if err != nil || prev.GetRegion().GetId() != regions[0].GetId() {
+		t.Fatal(err, prev)
+	}
+	prev, err = client.GetPrevRegion(ctx, &pdpb.GetRegionRequest{Header: header, RegionKey: []byte("a")})
+	if err != nil || prev.GetRegion() != nil {
+		t.Fatal(err, prev)
+	}
+	prev, err = client.GetPrevRegion(ctx, &pdpb.GetRegionRequest{Header: header})
+	if err != nil || prev.GetRegion().GetId() != 2 {
+		t.Fatal(err, prev)
+	}
+	byID, err := client.GetRegionByID(ctx, &pdpb.GetRegionByIDRequest{Header: header, RegionId: 2})
+	if err != nil || string(byID.GetRegion().GetStartKey()) != "m" {
+		t.Fatal(err, byID)
+	}
+
+	scan, err := client.ScanRegions(ctx, &pdpb.ScanRegionsRequest{Header: header, StartKey: []byte("g"), EndKey: []byte("z")})
+	if err != nil || len(scan.GetRegions()) != 2 || len(scan.GetLeaders()) != 2 {
+		t.Fatal(err, scan)
+	}
+	scan, err = client.ScanRegions(ctx, &pdpb.ScanRegionsRequest{Header: header, Limit: 1})
+	if err != nil || len(scan.GetRegions()) != 1 {
+		t.Fatal(err, scan)
+	}
+
+	// Split the first region through the RPCs TiKV uses.
+	first := regions[0]
+	ask, err := client.AskBatchSplit(ctx, &pdpb.AskBatchSplitRequest{Header: header, Region: first, SplitCount: 1})
+	if err != nil || len(ask.GetIds()) != 1 {
+		t.Fatal(err, ask)
+	}
+	left := &metapb.Region{
+		Id:          ask.GetIds()[0].GetNewRegionId(),
+		EndKey:      []byte("c"),
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: first.GetRegionEpoch().GetVersion() + 1},
+		Peers:       []*metapb.Peer{{Id: ask.GetIds()[0].GetNewPeerIds()[0], StoreId: 1}},
+	}
+	right := &metapb.Region{
+		Id:          first.GetId(),
+		StartKey:    []byte("c"),
+		EndKey:      first.GetEndKey(),
+		RegionEpoch: left.GetRegionEpoch(),
+		Peers:       first.GetPeers(),
+	}
+	if _, err = client.ReportBatchSplit(ctx, &pdpb.ReportBatchSplitRequest{Header: header, Regions: []*metapb.Region{left, right}}); err != nil {
+		t.Fatal(err)
+	}
+	region, err = client.GetRegion(ctx, &pdpb.GetRegionRequest{Header: header, RegionKey: []byte("b")})
+	if err != nil || region.GetRegion().GetId() != left.GetId() {
+		t.Fatal(err, region)
+	}
+	scan, err = client.ScanRegions(ctx, &pdpb.ScanRegionsRequest{Header: header})
+	if err != nil || len(scan.GetRegions()) != 4 {
+		t.Fatal(err, scan)
+	}
+}
+
+func TestGCSafePoint(t *testing.T) {
+	client, cleanup := newTestClient(t, NewServer(testClusterID))
+	defer cleanup()
+	ctx := context.Background()
+	header := &pdpb.RequestHeader{ClusterId: testClusterID}
+
+	for _, c := range []struct{ update, expect uint64 }{{10, 10}, {5, 10}, {20, 20}} {
+		resp, err := client.UpdateGCSafePoint(ctx, &pdpb.UpdateGCSafePointRequest{Header: header, SafePoint: c.update})
+		if err != nil || resp.GetNewSafePoint() != c.expect {
+			t.Fatal(err, resp)
+		}
+	}
+	resp, err := client.GetGCSafePoint(ctx, &pdpb.GetGCSafePointRequest{Header: header})
+	if err != nil || resp.GetSafePoint() != 20 {
+		t.Fatal(err, resp)
+	}
+}
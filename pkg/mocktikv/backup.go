@@ -0,0 +1,116 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/checksum"
+	"github.com/pingcap/kvproto/pkg/metapb"
+)
+
+var _ backup.BackupServer = (*Server)(nil)
+
+// Backup implements backup.BackupServer. The store backs up the part of
+// every region in the range it leads, and sends one response per region.
+// Only full backups are supported: the keys are read at end_version. The
+// files are written to the local directory of path, which may start with
+// "local://", and hold the pairs as length prefixed keys and values.
+func (s *Server) Backup(req *backup.BackupRequest, stream backup.Backup_BackupServer) error {
+	if req.GetStartVersion() != 0 && req.GetStartVersion() != req.GetEndVersion() {
+		return stream.Send(&backup.BackupResponse{
+			Error:    &backup.Error{Msg: "incremental backup is not supported"},
+			StartKey: req.GetStartKey(),
+			EndKey:   req.GetEndKey(),
+		})
+	}
+	dir := strings.TrimPrefix(req.GetPath(), "local://")
+	key := req.GetStartKey()
+	for len(req.GetEndKey()) == 0 || bytes.Compare(key, req.GetEndKey()) < 0 {
+		region, leader := s.cluster.RegionByKey(key)
+		if region == nil {
+			return nil
+		}
+		if leader.GetStoreId() == s.storeID {
+			startKey, endKey := clipRange(region, key, req.GetEndKey())
+			if err := stream.Send(s.backupRange(req, dir, region, startKey, endKey)); err != nil {
+				return err
+			}
+		}
+		if len(region.GetEndKey()) == 0 {
+			return nil
+		}
+		key = region.GetEndKey()
+	}
+	return nil
+}
+
+func (s *Server) backupRange(req *backup.BackupRequest, dir string, region *metapb.Region, startKey, endKey []byte) *backup.BackupResponse {
+	resp := &backup.BackupResponse{StartKey: startKey, EndKey: endKey}
+	pairs := s.store.Scan(nil, startKey, endKey, math.MaxInt32, req.GetEndVersion())
+	if len(pairs) == 0 {
+		return resp
+	}
+	var (
+		data []byte
+		sum  checksum.Checksum
+	)
+	for _, pair := range pairs {
+		if pair.Err != nil {
+			resp.Error = &backup.Error{
+				Msg:    pair.Err.Error(),
+				Detail: &backup.Error_KvError{KvError: convertToKeyError(pair.Err)},
+			}
+			return resp
+		}
+		data = appendBytes(data, pair.Key)
+		data = appendBytes(data, pair.Value)
+		sum.Update(pair.Key, pair.Value)
+	}
+	hash := sha256.Sum256(data)
+	name := fmt.Sprintf("%d_%d_%x.kv", s.storeID, region.GetId(), hash[:8])
+	err := os.MkdirAll(dir, 0755)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, name), data, 0644)
+	}
+	if err != nil {
+		resp.Error = &backup.Error{Msg: err.Error()}
+		return resp
+	}
+	resp.Files = []*backup.File{{
+		Name:         name,
+		Sha256:       hash[:],
+		StartKey:     startKey,
+		EndKey:       endKey,
+		StartVersion: req.GetStartVersion(),
+		EndVersion:   req.GetEndVersion(),
+		Crc64Xor:     sum.Crc64Xor,
+		TotalKvs:     sum.TotalKvs,
+		TotalBytes:   sum.TotalBytes,
+	}}
+	return resp
+}
+
+func appendBytes(data, b []byte) []byte {
+	data = binary.AppendUvarint(data, uint64(len(b)))
+	return append(data, b...)
+}
@@ -0,0 +1,166 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/kvproto/pkg/metapb"
+)
+
+// Cluster is the region topology a Server checks requests against.
+type Cluster interface {
+	// RegionByID returns the region with the given ID and its leader, or nil
+	// if the region does not exist.
+	RegionByID(regionID uint64) (*metapb.Region, *metapb.Peer)
+	// RegionByKey returns the region containing key and its leader, or nil
+	// if no region contains it.
+	RegionByKey(key []byte) (*metapb.Region, *metapb.Peer)
+	// Split splits the region at splitKeys and returns all result regions.
+	Split(regionID uint64, splitKeys [][]byte) ([]*metapb.Region, error)
+}
+
+// MemCluster is a Cluster kept in memory. Every region has a single peer
+// which is also its leader.
+type MemCluster struct {
+	sync.RWMutex
+	id      uint64
+	regions map[uint64]*metapb.Region
+}
+
+// NewMemCluster creates a MemCluster with one region covering the whole key
+// space, led by a peer on storeID.
+func NewMemCluster(storeID uint64) *MemCluster {
+	c := &MemCluster{
+		regions: make(map[uint64]*metapb.Region),
+	}
+	region := &metapb.Region{
+		Id:          c.allocID(),
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+		Peers:       []*metapb.Peer{{Id: c.allocID(), StoreId: storeID}},
+	}
+	c.regions[region.GetId()] = region
+	return c
+}
+
+func (c *MemCluster) allocID() uint64 {
+	c.id++
+	return c.id
+}
+
+// RegionByID implements Cluster.
+func (c *MemCluster) RegionByID(regionID uint64) (*metapb.Region, *metapb.Peer) {
+	c.RLock()
+	defer c.RUnlock()
+	region, ok := c.regions[regionID]
+	if !ok {
+		return nil, nil
+	}
+	region = region.Clone()
+	return region, region.GetPeers()[0]
+}
+
+// RegionByKey implements Cluster.
+func (c *MemCluster) RegionByKey(key []byte) (*metapb.Region, *metapb.Peer) {
+	c.RLock()
+	defer c.RUnlock()
+	for _, region := range c.regions {
+		if region.ContainsKey(key) {
+			region = region.Clone()
+			return region, region.GetPeers()[0]
+		}
+	}
+	return nil, nil
+}
+
+// Regions returns all regions ordered by start key.
+func (c *MemCluster) Regions() []*metapb.Region {
+	c.RLock()
+	defer c.RUnlock()
+	regions := make([]*metapb.Region, 0, len(c.regions))
+	for _, region := range c.regions {
+		regions = append(regions, region.Clone())
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		return bytes.Compare(regions[i].GetStartKey(), regions[j].GetStartKey()) < 0
+	})
+	return regions
+}
+
+// Split implements Cluster.
+func (c *MemCluster) Split(regionID uint64, splitKeys [][]byte) ([]*metapb.Region, error) {
+	c.Lock()
+	defer c.Unlock()
+	region, ok := c.regions[regionID]
+	if !ok {
+		return nil, errors.New("region not found")
+	}
+	regions, err := splitRegion(region, splitKeys, c.allocID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range regions {
+		c.regions[r.GetId()] = r
+	}
+	result := make([]*metapb.Region, 0, len(regions))
+	for _, r := range regions {
+		result = append(result, r.Clone())
+	}
+	return result, nil
+}
+
+// splitRegion cuts region at the sorted, deduplicated splitKeys. The last
+// result keeps the ID of the original region, matching TiKV's behaviour, and
+// every result gets its version bumped.
+func splitRegion(region *metapb.Region, splitKeys [][]byte, allocID func() uint64) ([]*metapb.Region, error) {
+	keys := append([][]byte(nil), splitKeys...)
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	for i, key := range keys {
+		if len(key) == 0 {
+			return nil, errors.New("split key is empty")
+		}
+		if i > 0 && bytes.Equal(keys[i-1], key) {
+			return nil, errors.New("split keys are duplicated")
+		}
+		if bytes.Compare(key, region.GetStartKey()) <= 0 ||
+			(len(region.GetEndKey()) > 0 && bytes.Compare(key, region.GetEndKey()) >= 0) {
+			return nil, errors.New("split key is not in region")
+		}
+	}
+	version := region.GetRegionEpoch().GetVersion() + uint64(len(keys))
+	confVer := region.GetRegionEpoch().GetConfVer()
+	regions := make([]*metapb.Region, 0, len(keys)+1)
+	start := region.GetStartKey()
+	for _, key := range keys {
+		r := &metapb.Region{
+			Id:          allocID(),
+			StartKey:    start,
+			EndKey:      key,
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: confVer, Version: version},
+		}
+		for _, p := range region.GetPeers() {
+			r.Peers = append(r.Peers, &metapb.Peer{Id: allocID(), StoreId: p.GetStoreId(), IsLearner: p.GetIsLearner()})
+		}
+		regions = append(regions, r)
+		start = key
+	}
+	last := region.Clone()
+	last.StartKey = start
+	last.RegionEpoch = &metapb.RegionEpoch{ConfVer: confVer, Version: version}
+	regions = append(regions, last)
+	return regions, nil
+}
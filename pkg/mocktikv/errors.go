@@ -0,0 +1,126 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"fmt"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+)
+
+// ErrLocked is returned when a key is locked by another transaction.
+type ErrLocked struct {
+	Key      []byte
+	Primary  []byte
+	StartTS  uint64
+	TTL      uint64
+	TxnSize  uint64
+	LockType kvrpcpb.Op
+}
+
+func (e *ErrLocked) Error() string {
+	return fmt.Sprintf("key is locked, key: %q, primary: %q, start_ts: %d", e.Key, e.Primary, e.StartTS)
+}
+
+// ErrConflict is returned when a newer write exists after the transaction
+// started.
+type ErrConflict struct {
+	StartTS          uint64
+	ConflictTS       uint64
+	ConflictCommitTS uint64
+	Key              []byte
+	Primary          []byte
+}
+
+func (e *ErrConflict) Error() string {
+	return fmt.Sprintf("write conflict, key: %q, start_ts: %d, conflict_ts: %d", e.Key, e.StartTS, e.ConflictTS)
+}
+
+// ErrAlreadyExist is returned when an Insert mutation meets an existing key.
+type ErrAlreadyExist struct {
+	Key []byte
+}
+
+func (e *ErrAlreadyExist) Error() string {
+	return fmt.Sprintf("key already exists, key: %q", e.Key)
+}
+
+// ErrAlreadyCommitted is returned when rolling back a committed transaction.
+type ErrAlreadyCommitted uint64
+
+func (e ErrAlreadyCommitted) Error() string {
+	return fmt.Sprintf("txn already committed, commit_ts: %d", uint64(e))
+}
+
+// ErrRetryable suggests the client retry the transaction.
+type ErrRetryable string
+
+func (e ErrRetryable) Error() string {
+	return string(e)
+}
+
+// ErrAbort means the transaction cannot go on.
+type ErrAbort string
+
+func (e ErrAbort) Error() string {
+	return string(e)
+}
+
+// ErrLockNotFound is returned when the lock of a transaction is gone, usually
+// because it has been rolled back by another reader.
+var ErrLockNotFound = ErrAbort("txn lock not found")
+
+func convertToKeyError(err error) *kvrpcpb.KeyError {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *ErrLocked:
+		return &kvrpcpb.KeyError{
+			Locked: &kvrpcpb.LockInfo{
+				Key:         e.Key,
+				PrimaryLock: e.Primary,
+				LockVersion: e.StartTS,
+				LockTtl:     e.TTL,
+				TxnSize:     e.TxnSize,
+				LockType:    e.LockType,
+			},
+		}
+	case *ErrConflict:
+		return &kvrpcpb.KeyError{
+			Conflict: &kvrpcpb.WriteConflict{
+				StartTs:          e.StartTS,
+				ConflictTs:       e.ConflictTS,
+				ConflictCommitTs: e.ConflictCommitTS,
+				Key:              e.Key,
+				Primary:          e.Primary,
+			},
+		}
+	case *ErrAlreadyExist:
+		return &kvrpcpb.KeyError{AlreadyExist: &kvrpcpb.AlreadyExist{Key: e.Key}}
+	case ErrRetryable:
+		return &kvrpcpb.KeyError{Retryable: e.Error()}
+	default:
+		return &kvrpcpb.KeyError{Abort: err.Error()}
+	}
+}
+
+func convertToKeyErrors(errs []error) []*kvrpcpb.KeyError {
+	var keyErrors []*kvrpcpb.KeyError
+	for _, err := range errs {
+		if err != nil {
+			keyErrors = append(keyErrors, convertToKeyError(err))
+		}
+	}
+	return keyErrors
+}
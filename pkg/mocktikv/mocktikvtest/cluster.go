@@ -0,0 +1,164 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mocktikvtest runs a mock PD and mock TiKV servers in process, over
+// in-memory connections, for the tests of the clients.
+package mocktikvtest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/backup"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/mockpd"
+	"github.com/pingcap/kvproto/pkg/mocktikv"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// ClusterID is the ID of the clusters started by NewCluster.
+const ClusterID = 42
+
+// Cluster is a mock PD and a mock TiKV server on each of its stores, all
+// sharing one MVCCStore.
+type Cluster struct {
+	PD       *mockpd.Server
+	PDClient pdpb.PDClient
+	MVCC     *mocktikv.MVCCStore
+
+	conns   map[uint64]*grpc.ClientConn
+	cleanup []func()
+}
+
+// NewCluster starts a cluster of stores 1 to stores, bootstrapped with the
+// region 2 covering the whole key space. The region has a peer on every
+// store, with ID 2 + store ID, and is led by store 1.
+func NewCluster(t *testing.T, stores int) *Cluster {
+	c := &Cluster{
+		PD:    mockpd.NewServer(ClusterID),
+		MVCC:  mocktikv.NewMVCCStore(),
+		conns: make(map[uint64]*grpc.ClientConn),
+	}
+	c.PDClient = pdpb.NewPDClient(c.serve(t, func(s *grpc.Server) { pdpb.RegisterPDServer(s, c.PD) }))
+
+	region := &metapb.Region{Id: 2, RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1}}
+	for storeID := uint64(1); storeID <= uint64(stores); storeID++ {
+		region.Peers = append(region.Peers, &metapb.Peer{Id: 2 + storeID, StoreId: storeID})
+	}
+	ctx := context.Background()
+	resp, err := c.PDClient.Bootstrap(ctx, &pdpb.BootstrapRequest{
+		Header: c.Header(),
+		Store:  &metapb.Store{Id: 1, Address: "store1"},
+		Region: region,
+	})
+	if err != nil || resp.GetHeader().GetError() != nil {
+		c.Close()
+		t.Fatal(err, resp.GetHeader().GetError())
+	}
+	for _, peer := range region.Peers {
+		storeID := peer.GetStoreId()
+		if storeID != 1 {
+			store := &metapb.Store{Id: storeID, Address: fmt.Sprintf("store%d", storeID)}
+			if _, err = c.PDClient.PutStore(ctx, &pdpb.PutStoreRequest{Header: c.Header(), Store: store}); err != nil {
+				c.Close()
+				t.Fatal(err)
+			}
+		}
+		server := mocktikv.NewServer(storeID, c.PD, c.MVCC)
+		c.conns[storeID] = c.serve(t, func(s *grpc.Server) {
+			tikvpb.RegisterTikvServer(s, server)
+			backup.RegisterBackupServer(s, server)
+		})
+	}
+	return c
+}
+
+// serve starts a gRPC server registered by register, and returns a
+// connection to it.
+func (c *Cluster) serve(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	register(s)
+	go s.Serve(lis)
+	c.cleanup = append(c.cleanup, s.Stop)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		c.Close()
+		t.Fatal(err)
+	}
+	c.cleanup = append(c.cleanup, func() { conn.Close() })
+	return conn
+}
+
+// Close stops the servers.
+func (c *Cluster) Close() {
+	for i := len(c.cleanup) - 1; i >= 0; i-- {
+		c.cleanup[i]()
+	}
+	c.cleanup = nil
+}
+
+// Header returns the request header of the cluster.
+func (c *Cluster) Header() *pdpb.RequestHeader {
+	return &pdpb.RequestHeader{ClusterId: ClusterID}
+}
+
+// Conn returns the connection to the TiKV server of the store with storeID.
+func (c *Cluster) Conn(storeID uint64) *grpc.ClientConn {
+	return c.conns[storeID]
+}
+
+// TikvClient returns a client of the store with storeID.
+func (c *Cluster) TikvClient(storeID uint64) tikvpb.TikvClient {
+	return tikvpb.NewTikvClient(c.conns[storeID])
+}
+
+// NewRegionCache returns a region cache loading the regions from the PD.
+func (c *Cluster) NewRegionCache() *regioncache.RegionCache {
+	return regioncache.NewRegionCache(regioncache.NewPDLoader(c.PDClient, ClusterID))
+}
+
+// Split splits the regions containing keys at them.
+func (c *Cluster) Split(t *testing.T, keys ...string) {
+	for _, key := range keys {
+		if _, err := c.PD.Split(c.region(t, key).GetId(), [][]byte{[]byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// ChangeLeader moves the leader of the region containing key to the store
+// with storeID.
+func (c *Cluster) ChangeLeader(t *testing.T, key string, storeID uint64) {
+	if err := c.PD.ChangeLeader(c.region(t, key).GetId(), storeID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// region returns the region containing key.
+func (c *Cluster) region(t *testing.T, key string) *metapb.Region {
+	resp, err := c.PDClient.GetRegion(context.Background(), &pdpb.GetRegionRequest{Header: c.Header(), RegionKey: []byte(key)})
+	if err != nil || resp.GetRegion() == nil {
+		t.Fatalf("no region contains %q: %v", key, err)
+	}
+	return resp.GetRegion()
+}
@@ -0,0 +1,892 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"bytes"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/btree"
+	"github.com/pingcap/kvproto/pkg/checksum"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+)
+
+const btreeDegree = 32
+
+type mvccLock struct {
+	startTS     uint64
+	forUpdateTS uint64
+	ttl         uint64
+	txnSize     uint64
+	minCommitTS uint64
+	primary     []byte
+	value       []byte
+	op          kvrpcpb.Op
+}
+
+func (l *mvccLock) lockErr(key []byte) error {
+	return &ErrLocked{
+		Key:      key,
+		Primary:  l.primary,
+		StartTS:  l.startTS,
+		TTL:      l.ttl,
+		TxnSize:  l.txnSize,
+		LockType: l.op,
+	}
+}
+
+// expired reports whether the lock TTL, counted in milliseconds from the
+// physical part of its start ts, has passed at currentTS.
+func (l *mvccLock) expired(currentTS uint64) bool {
+	return pdpb.ExtractPhysical(l.startTS)+int64(l.ttl) < pdpb.ExtractPhysical(currentTS)
+}
+
+type mvccWrite struct {
+	startTS  uint64
+	commitTS uint64
+	op       kvrpcpb.Op
+	value    []byte
+}
+
+type mvccEntry struct {
+	key  []byte
+	lock *mvccLock
+	// writes are ordered by commitTS descending.
+	writes []mvccWrite
+}
+
+func (e *mvccEntry) Less(than btree.Item) bool {
+	return bytes.Compare(e.key, than.(*mvccEntry).key) < 0
+}
+
+func (e *mvccEntry) addWrite(w mvccWrite) {
+	i := 0
+	for i < len(e.writes) && e.writes[i].commitTS > w.commitTS {
+		i++
+	}
+	e.writes = append(e.writes, mvccWrite{})
+	copy(e.writes[i+1:], e.writes[i:])
+	e.writes[i] = w
+}
+
+// checkLock returns ErrLocked if a reader at startTS must wait for the lock.
+// A lock whose min_commit_ts is after startTS will commit after it, so it
+// does not block the reader.
+func (e *mvccEntry) checkLock(startTS uint64, ctx *kvrpcpb.Context) error {
+	l := e.lock
+	if l == nil || l.startTS > startTS || l.op == kvrpcpb.Op_Lock || l.op == kvrpcpb.Op_PessimisticLock {
+		return nil
+	}
+	if l.minCommitTS > startTS {
+		return nil
+	}
+	if ctx.GetIsolationLevel() == kvrpcpb.IsolationLevel_RC {
+		return nil
+	}
+	for _, ts := range ctx.GetResolvedLocks() {
+		if ts == l.startTS {
+			return nil
+		}
+	}
+	return l.lockErr(e.key)
+}
+
+// read returns the value visible at startTS, or nil if there is none.
+func (e *mvccEntry) read(startTS uint64) []byte {
+	for _, w := range e.writes {
+		if w.commitTS > startTS {
+			continue
+		}
+		switch w.op {
+		case kvrpcpb.Op_Put:
+			return w.value
+		case kvrpcpb.Op_Del:
+			return nil
+		}
+	}
+	return nil
+}
+
+// findWrite returns the write record of the transaction started at startTS.
+func (e *mvccEntry) findWrite(startTS uint64) (mvccWrite, bool) {
+	for _, w := range e.writes {
+		if w.startTS == startTS {
+			return w, true
+		}
+	}
+	return mvccWrite{}, false
+}
+
+// latestCommit returns the newest write that is not a rollback.
+func (e *mvccEntry) latestCommit() (mvccWrite, bool) {
+	for _, w := range e.writes {
+		if w.op != kvrpcpb.Op_Rollback {
+			return w, true
+		}
+	}
+	return mvccWrite{}, false
+}
+
+// Pair is a key-value pair returned by scans. Err is set instead of Value if
+// the key cannot be read.
+type Pair struct {
+	Key   []byte
+	Value []byte
+	Err   error
+}
+
+// MVCCStore is an in-memory implementation of the Percolator model used by
+// TiKV, plus a plain key-value space for RawKV.
+type MVCCStore struct {
+	sync.RWMutex
+	tree *btree.BTree
+	raw  map[string]*btree.BTree
+	// now is the clock raw key TTLs are counted with.
+	now func() time.Time
+	// maxReadTS is the highest ts the store has served reads at. It is
+	// updated atomically, as reads only hold the read lock.
+	maxReadTS uint64
+}
+
+// NewMVCCStore creates an empty MVCCStore.
+func NewMVCCStore() *MVCCStore {
+	return &MVCCStore{
+		tree: btree.New(btreeDegree),
+		raw:  make(map[string]*btree.BTree),
+		now:  time.Now,
+	}
+}
+
+// SetClock replaces the clock raw key TTLs are counted with, so that tests
+// can expire keys without waiting.
+func (s *MVCCStore) SetClock(now func() time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.now = now
+}
+
+// observeRead raises the max read ts to startTS. Reads at math.MaxUint64,
+// which see the latest data rather than a snapshot, are not recorded.
+func (s *MVCCStore) observeRead(startTS uint64) {
+	for {
+		max := atomic.LoadUint64(&s.maxReadTS)
+		if startTS <= max || startTS == math.MaxUint64 || atomic.CompareAndSwapUint64(&s.maxReadTS, max, startTS) {
+			return
+		}
+	}
+}
+
+func (s *MVCCStore) getEntry(key []byte) *mvccEntry {
+	item := s.tree.Get(&mvccEntry{key: key})
+	if item == nil {
+		return nil
+	}
+	return item.(*mvccEntry)
+}
+
+func (s *MVCCStore) getOrNewEntry(key []byte) *mvccEntry {
+	if e := s.getEntry(key); e != nil {
+		return e
+	}
+	e := &mvccEntry{key: append([]byte{}, key...)}
+	s.tree.ReplaceOrInsert(e)
+	return e
+}
+
+// ascend calls fn on entries in [startKey, endKey) in order. An empty endKey
+// means +inf.
+func (s *MVCCStore) ascend(startKey, endKey []byte, fn func(e *mvccEntry) bool) {
+	var end btree.Item
+	if len(endKey) > 0 {
+		end = &mvccEntry{key: endKey}
+	}
+	ascendRange(s.tree, &mvccEntry{key: startKey}, end, func(item btree.Item) bool {
+		return fn(item.(*mvccEntry))
+	})
+}
+
+// descend calls fn on entries in [startKey, endKey) in reverse order. An
+// empty endKey means +inf.
+func (s *MVCCStore) descend(startKey, endKey []byte, fn func(e *mvccEntry) bool) {
+	var end btree.Item
+	if len(endKey) > 0 {
+		end = &mvccEntry{key: endKey}
+	}
+	descendRange(s.tree, &mvccEntry{key: startKey}, end, func(item btree.Item) bool {
+		return fn(item.(*mvccEntry))
+	})
+}
+
+// ascendRange iterates [start, end) of tree. A nil end means +inf.
+func ascendRange(tree *btree.BTree, start, end btree.Item, fn btree.ItemIterator) {
+	if end == nil {
+		tree.AscendGreaterOrEqual(start, fn)
+		return
+	}
+	tree.AscendRange(start, end, fn)
+}
+
+// descendRange iterates [start, end) of tree in reverse order. A nil end
+// means +inf.
+func descendRange(tree *btree.BTree, start, end btree.Item, fn btree.ItemIterator) {
+	iter := func(item btree.Item) bool {
+		if item.Less(start) {
+			return false
+		}
+		return fn(item)
+	}
+	if end == nil {
+		tree.Descend(iter)
+		return
+	}
+	tree.DescendLessOrEqual(end, func(item btree.Item) bool {
+		if !item.Less(end) {
+			return true
+		}
+		return iter(item)
+	})
+}
+
+// Get reads the value of key at startTS. A nil value means not found.
+func (s *MVCCStore) Get(ctx *kvrpcpb.Context, key []byte, startTS uint64) ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+	s.observeRead(startTS)
+	e := s.getEntry(key)
+	if e == nil {
+		return nil, nil
+	}
+	if err := e.checkLock(startTS, ctx); err != nil {
+		return nil, err
+	}
+	return e.read(startTS), nil
+}
+
+// BatchGet reads keys at startTS. Keys which are not found are omitted.
+func (s *MVCCStore) BatchGet(ctx *kvrpcpb.Context, keys [][]byte, startTS uint64) []Pair {
+	s.RLock()
+	defer s.RUnlock()
+	s.observeRead(startTS)
+	var pairs []Pair
+	for _, key := range keys {
+		e := s.getEntry(key)
+		if e == nil {
+			continue
+		}
+		if err := e.checkLock(startTS, ctx); err != nil {
+			pairs = append(pairs, Pair{Key: key, Err: err})
+			continue
+		}
+		if value := e.read(startTS); value != nil {
+			pairs = append(pairs, Pair{Key: key, Value: value})
+		}
+	}
+	return pairs
+}
+
+// Scan reads at most limit keys in [startKey, endKey) at startTS.
+func (s *MVCCStore) Scan(ctx *kvrpcpb.Context, startKey, endKey []byte, limit int, startTS uint64) []Pair {
+	if limit <= 0 {
+		return nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	s.observeRead(startTS)
+	var pairs []Pair
+	s.ascend(startKey, endKey, func(e *mvccEntry) bool {
+		return s.scanEntry(ctx, e, startTS, &pairs, limit)
+	})
+	return pairs
+}
+
+// ReverseScan reads at most limit keys in [startKey, endKey) at startTS in
+// descending order.
+func (s *MVCCStore) ReverseScan(ctx *kvrpcpb.Context, startKey, endKey []byte, limit int, startTS uint64) []Pair {
+	if limit <= 0 {
+		return nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	s.observeRead(startTS)
+	var pairs []Pair
+	s.descend(startKey, endKey, func(e *mvccEntry) bool {
+		return s.scanEntry(ctx, e, startTS, &pairs, limit)
+	})
+	return pairs
+}
+
+// Checksum returns the checksum of the keys in [startKey, endKey) at
+// startTS, or the error of the first lock in the way.
+func (s *MVCCStore) Checksum(ctx *kvrpcpb.Context, startKey, endKey []byte, startTS uint64) (checksum.Checksum, error) {
+	s.RLock()
+	defer s.RUnlock()
+	s.observeRead(startTS)
+	var (
+		c   checksum.Checksum
+		err error
+	)
+	s.ascend(startKey, endKey, func(e *mvccEntry) bool {
+		if err = e.checkLock(startTS, ctx); err != nil {
+			return false
+		}
+		if value := e.read(startTS); value != nil {
+			c.Update(e.key, value)
+		}
+		return true
+	})
+	return c, err
+}
+
+func (s *MVCCStore) scanEntry(ctx *kvrpcpb.Context, e *mvccEntry, startTS uint64, pairs *[]Pair, limit int) bool {
+	if err := e.checkLock(startTS, ctx); err != nil {
+		*pairs = append(*pairs, Pair{Key: e.key, Err: err})
+	} else if value := e.read(startTS); value != nil {
+		*pairs = append(*pairs, Pair{Key: e.key, Value: value})
+	}
+	return len(*pairs) < limit
+}
+
+// Prewrite locks the mutations of a transaction. If any mutation fails, no
+// lock is written and the errors are returned.
+func (s *MVCCStore) Prewrite(req *kvrpcpb.PrewriteRequest) []error {
+	s.Lock()
+	defer s.Unlock()
+	errs := make([]error, 0, len(req.GetMutations()))
+	anyError := false
+	for i, m := range req.GetMutations() {
+		isPessimisticLock := i < len(req.GetIsPessimisticLock()) && req.GetIsPessimisticLock()[i]
+		err := s.checkPrewrite(req, m, isPessimisticLock)
+		if err != nil {
+			anyError = true
+		}
+		errs = append(errs, err)
+	}
+	if anyError {
+		return errs
+	}
+	// Like TiKV, a min_commit_ts is raised past the max read ts, so that
+	// the transaction commits after the reads that did not see its locks.
+	minCommitTS := req.GetMinCommitTs()
+	if maxReadTS := atomic.LoadUint64(&s.maxReadTS); minCommitTS > 0 && minCommitTS <= maxReadTS {
+		minCommitTS = maxReadTS + 1
+	}
+	for _, m := range req.GetMutations() {
+		e := s.getOrNewEntry(m.GetKey())
+		if e.lock != nil && e.lock.op != kvrpcpb.Op_PessimisticLock {
+			// Prewrite is idempotent.
+			continue
+		}
+		op := m.GetOp()
+		if op == kvrpcpb.Op_Insert {
+			op = kvrpcpb.Op_Put
+		}
+		e.lock = &mvccLock{
+			startTS:     req.GetStartVersion(),
+			forUpdateTS: req.GetForUpdateTs(),
+			ttl:         req.GetLockTtl(),
+			txnSize:     req.GetTxnSize(),
+			minCommitTS: minCommitTS,
+			primary:     req.GetPrimaryLock(),
+			value:       mutationValue(op, m.GetValue()),
+			op:          op,
+		}
+	}
+	return nil
+}
+
+func (s *MVCCStore) checkPrewrite(req *kvrpcpb.PrewriteRequest, m *kvrpcpb.Mutation, isPessimisticLock bool) error {
+	startTS := req.GetStartVersion()
+	e := s.getEntry(m.GetKey())
+	if e == nil {
+		if isPessimisticLock {
+			return ErrAbort("pessimistic lock not found")
+		}
+		if m.GetAssertion() == kvrpcpb.Assertion_Exist {
+			return ErrAbort("assertion failed: key does not exist")
+		}
+		return nil
+	}
+	if e.lock != nil {
+		if e.lock.startTS != startTS {
+			return e.lock.lockErr(e.key)
+		}
+		return nil
+	}
+	if isPessimisticLock {
+		return ErrAbort("pessimistic lock not found")
+	}
+	if w, ok := e.findWrite(startTS); ok && w.op == kvrpcpb.Op_Rollback {
+		return ErrAbort("txn already rolled back")
+	}
+	if w, ok := e.latestCommit(); ok && w.commitTS >= startTS && !req.GetSkipConstraintCheck() {
+		return &ErrConflict{
+			StartTS:          startTS,
+			ConflictTS:       w.startTS,
+			ConflictCommitTS: w.commitTS,
+			Key:              e.key,
+			Primary:          req.GetPrimaryLock(),
+		}
+	}
+	exists := e.read(startTS) != nil
+	if m.GetOp() == kvrpcpb.Op_Insert && exists {
+		return &ErrAlreadyExist{Key: e.key}
+	}
+	switch {
+	case m.GetAssertion() == kvrpcpb.Assertion_Exist && !exists:
+		return ErrAbort("assertion failed: key does not exist")
+	case m.GetAssertion() == kvrpcpb.Assertion_NotExist && exists:
+		return ErrAbort("assertion failed: key exists")
+	}
+	return nil
+}
+
+// PessimisticLock acquires pessimistic locks on the keys of the mutations.
+// Like Prewrite, nothing is written if any key fails.
+func (s *MVCCStore) PessimisticLock(req *kvrpcpb.PessimisticLockRequest) []error {
+	s.Lock()
+	defer s.Unlock()
+	errs := make([]error, 0, len(req.GetMutations()))
+	anyError := false
+	for _, m := range req.GetMutations() {
+		err := s.checkPessimisticLock(req, m.GetKey())
+		if err != nil {
+			anyError = true
+		}
+		errs = append(errs, err)
+	}
+	if anyError {
+		return errs
+	}
+	for _, m := range req.GetMutations() {
+		e := s.getOrNewEntry(m.GetKey())
+		if e.lock != nil {
+			if req.GetForUpdateTs() > e.lock.forUpdateTS {
+				e.lock.forUpdateTS = req.GetForUpdateTs()
+			}
+			continue
+		}
+		e.lock = &mvccLock{
+			startTS:     req.GetStartVersion(),
+			forUpdateTS: req.GetForUpdateTs(),
+			ttl:         req.GetLockTtl(),
+			primary:     req.GetPrimaryLock(),
+			op:          kvrpcpb.Op_PessimisticLock,
+		}
+	}
+	return nil
+}
+
+func (s *MVCCStore) checkPessimisticLock(req *kvrpcpb.PessimisticLockRequest, key []byte) error {
+	e := s.getEntry(key)
+	if e == nil {
+		return nil
+	}
+	if e.lock != nil {
+		if e.lock.startTS != req.GetStartVersion() {
+			return e.lock.lockErr(e.key)
+		}
+		return nil
+	}
+	if w, ok := e.findWrite(req.GetStartVersion()); ok {
+		if w.op == kvrpcpb.Op_Rollback {
+			return ErrAbort("txn already rolled back")
+		}
+		return ErrAlreadyCommitted(w.commitTS)
+	}
+	if w, ok := e.latestCommit(); ok && w.commitTS > req.GetForUpdateTs() {
+		return &ErrConflict{
+			StartTS:          req.GetStartVersion(),
+			ConflictTS:       w.startTS,
+			ConflictCommitTS: w.commitTS,
+			Key:              e.key,
+			Primary:          req.GetPrimaryLock(),
+		}
+	}
+	return nil
+}
+
+// PessimisticRollback removes pessimistic locks which are not yet prewritten.
+func (s *MVCCStore) PessimisticRollback(keys [][]byte, startTS, forUpdateTS uint64) {
+	s.Lock()
+	defer s.Unlock()
+	for _, key := range keys {
+		e := s.getEntry(key)
+		if e == nil || e.lock == nil {
+			continue
+		}
+		l := e.lock
+		if l.startTS == startTS && l.op == kvrpcpb.Op_PessimisticLock && l.forUpdateTS <= forUpdateTS {
+			e.lock = nil
+		}
+	}
+}
+
+// Commit commits the locks of keys. It returns the commit ts actually used,
+// which differs from commitTS only when commitTS is 0 and the lock carries a
+// min_commit_ts. The commit ts is then also raised past the max read ts, as
+// the locks of the other keys of the transaction may have got a higher
+// min_commit_ts than the ones committed here.
+func (s *MVCCStore) Commit(keys [][]byte, startTS, commitTS uint64) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+	if commitTS == 0 {
+		for _, key := range keys {
+			if e := s.getEntry(key); e != nil && e.lock != nil && e.lock.startTS == startTS && e.lock.minCommitTS > commitTS {
+				commitTS = e.lock.minCommitTS
+			}
+		}
+		if commitTS == 0 {
+			return 0, ErrAbort("commit ts is required")
+		}
+		if maxReadTS := atomic.LoadUint64(&s.maxReadTS); commitTS <= maxReadTS {
+			commitTS = maxReadTS + 1
+		}
+	}
+	for _, key := range keys {
+		if err := s.checkCommit(key, startTS, commitTS); err != nil {
+			return 0, err
+		}
+	}
+	for _, key := range keys {
+		s.commitKey(key, startTS, commitTS)
+	}
+	return commitTS, nil
+}
+
+func (s *MVCCStore) checkCommit(key []byte, startTS, commitTS uint64) error {
+	e := s.getEntry(key)
+	if e != nil && e.lock != nil && e.lock.startTS == startTS {
+		if e.lock.op == kvrpcpb.Op_PessimisticLock {
+			return ErrAbort("pessimistic lock is not prewritten")
+		}
+		if e.lock.minCommitTS > commitTS {
+			return ErrAbort("commit ts is less than min_commit_ts")
+		}
+		return nil
+	}
+	if e != nil {
+		if w, ok := e.findWrite(startTS); ok {
+			if w.op == kvrpcpb.Op_Rollback {
+				return ErrAbort("txn already rolled back")
+			}
+			// Commit is idempotent.
+			return nil
+		}
+	}
+	return ErrLockNotFound
+}
+
+func (s *MVCCStore) commitKey(key []byte, startTS, commitTS uint64) {
+	e := s.getEntry(key)
+	if e == nil || e.lock == nil || e.lock.startTS != startTS {
+		return
+	}
+	e.addWrite(mvccWrite{
+		startTS:  startTS,
+		commitTS: commitTS,
+		op:       e.lock.op,
+		value:    e.lock.value,
+	})
+	e.lock = nil
+}
+
+// Rollback rolls back the transaction on keys and leaves rollback records to
+// prevent a late prewrite from succeeding.
+func (s *MVCCStore) Rollback(keys [][]byte, startTS uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	for _, key := range keys {
+		if e := s.getEntry(key); e != nil {
+			if w, ok := e.findWrite(startTS); ok && w.op != kvrpcpb.Op_Rollback {
+				return ErrAlreadyCommitted(w.commitTS)
+			}
+		}
+	}
+	for _, key := range keys {
+		s.rollbackKey(key, startTS)
+	}
+	return nil
+}
+
+func (s *MVCCStore) rollbackKey(key []byte, startTS uint64) {
+	e := s.getOrNewEntry(key)
+	if e.lock != nil && e.lock.startTS == startTS {
+		e.lock = nil
+	}
+	if _, ok := e.findWrite(startTS); ok {
+		return
+	}
+	e.addWrite(mvccWrite{startTS: startTS, commitTS: startTS, op: kvrpcpb.Op_Rollback})
+}
+
+// Cleanup rolls back the lock of key if it has expired at currentTS. A
+// currentTS of 0 rolls back unconditionally. If the transaction is already
+// committed, its commit ts is returned.
+func (s *MVCCStore) Cleanup(key []byte, startTS, currentTS uint64) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+	e := s.getEntry(key)
+	if e != nil {
+		if e.lock != nil && e.lock.startTS == startTS {
+			if currentTS != 0 && !e.lock.expired(currentTS) {
+				return 0, e.lock.lockErr(e.key)
+			}
+		} else if w, ok := e.findWrite(startTS); ok {
+			if w.op == kvrpcpb.Op_Rollback {
+				return 0, nil
+			}
+			return w.commitTS, nil
+		}
+	}
+	s.rollbackKey(key, startTS)
+	return 0, nil
+}
+
+// CheckTxnStatus reports the status of the transaction whose primary lock is
+// on primary. It rolls back an expired lock, and pushes the lock's
+// min_commit_ts past callerStartTS so that the reader does not need to wait.
+// It returns the lock TTL if the transaction is alive, or the commit ts if it
+// is committed; both are zero if it is rolled back. The action tells what was
+// done to the lock.
+func (s *MVCCStore) CheckTxnStatus(primary []byte, lockTS, callerStartTS, currentTS uint64) (ttl uint64, commitTS uint64, action kvrpcpb.Action, err error) {
+	s.Lock()
+	defer s.Unlock()
+	e := s.getEntry(primary)
+	if e != nil {
+		if l := e.lock; l != nil && l.startTS == lockTS {
+			if l.expired(currentTS) {
+				s.rollbackKey(primary, lockTS)
+				return 0, 0, kvrpcpb.Action_TTLExpireRollback, nil
+			}
+			if l.minCommitTS > 0 && callerStartTS > 0 {
+				if callerStartTS >= l.minCommitTS {
+					l.minCommitTS = callerStartTS + 1
+				}
+				action = kvrpcpb.Action_MinCommitTSPushed
+			}
+			return l.ttl, 0, action, nil
+		}
+		if w, ok := e.findWrite(lockTS); ok {
+			if w.op == kvrpcpb.Op_Rollback {
+				return 0, 0, kvrpcpb.Action_NoAction, nil
+			}
+			return 0, w.commitTS, kvrpcpb.Action_NoAction, nil
+		}
+	}
+	s.rollbackKey(primary, lockTS)
+	return 0, 0, kvrpcpb.Action_LockNotExistRollback, nil
+}
+
+// TxnHeartBeat raises the TTL of the primary lock to adviseTTL if it is
+// larger, and returns the resulting TTL.
+func (s *MVCCStore) TxnHeartBeat(primary []byte, startTS, adviseTTL uint64) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+	e := s.getEntry(primary)
+	if e == nil || e.lock == nil || e.lock.startTS != startTS {
+		return 0, ErrLockNotFound
+	}
+	if adviseTTL > e.lock.ttl {
+		e.lock.ttl = adviseTTL
+	}
+	return e.lock.ttl, nil
+}
+
+// ScanLock returns at most limit locks in [startKey, endKey) whose start ts
+// is not greater than maxTS. A limit of 0 means no limit.
+func (s *MVCCStore) ScanLock(startKey, endKey []byte, maxTS uint64, limit int) []*kvrpcpb.LockInfo {
+	s.RLock()
+	defer s.RUnlock()
+	var locks []*kvrpcpb.LockInfo
+	s.ascend(startKey, endKey, func(e *mvccEntry) bool {
+		if l := e.lock; l != nil && l.startTS <= maxTS {
+			locks = append(locks, &kvrpcpb.LockInfo{
+				PrimaryLock: l.primary,
+				LockVersion: l.startTS,
+				Key:         e.key,
+				LockTtl:     l.ttl,
+				TxnSize:     l.txnSize,
+				LockType:    l.op,
+			})
+		}
+		return limit == 0 || len(locks) < limit
+	})
+	return locks
+}
+
+// ResolveLock commits or rolls back the locks in [startKey, endKey) according
+// to txnStatus, which maps start ts to commit ts (0 means roll back). If keys
+// is not empty, only those keys are resolved.
+func (s *MVCCStore) ResolveLock(startKey, endKey []byte, keys [][]byte, txnStatus map[uint64]uint64) {
+	s.Lock()
+	defer s.Unlock()
+	resolve := func(e *mvccEntry) bool {
+		if e.lock == nil {
+			return true
+		}
+		commitTS, ok := txnStatus[e.lock.startTS]
+		if !ok {
+			return true
+		}
+		if commitTS > 0 {
+			s.commitKey(e.key, e.lock.startTS, commitTS)
+		} else {
+			s.rollbackKey(e.key, e.lock.startTS)
+		}
+		return true
+	}
+	if len(keys) > 0 {
+		for _, key := range keys {
+			if e := s.getEntry(key); e != nil {
+				resolve(e)
+			}
+		}
+		return
+	}
+	s.ascend(startKey, endKey, resolve)
+}
+
+// GC removes versions which are not visible to any reader at or after
+// safePoint.
+func (s *MVCCStore) GC(startKey, endKey []byte, safePoint uint64) {
+	s.Lock()
+	defer s.Unlock()
+	var empty []*mvccEntry
+	s.ascend(startKey, endKey, func(e *mvccEntry) bool {
+		writes := e.writes[:0]
+		keep := true
+		for _, w := range e.writes {
+			if w.commitTS > safePoint {
+				writes = append(writes, w)
+				continue
+			}
+			if !keep || w.op == kvrpcpb.Op_Rollback || w.op == kvrpcpb.Op_Lock {
+				continue
+			}
+			// The newest Put below the safe point is still visible; a
+			// Del there hides everything older, so it can go too.
+			if w.op == kvrpcpb.Op_Put {
+				writes = append(writes, w)
+			}
+			keep = false
+		}
+		e.writes = writes
+		if len(e.writes) == 0 && e.lock == nil {
+			empty = append(empty, e)
+		}
+		return true
+	})
+	for _, e := range empty {
+		s.tree.Delete(e)
+	}
+}
+
+// DeleteRange removes all data in [startKey, endKey), including locks.
+func (s *MVCCStore) DeleteRange(startKey, endKey []byte) {
+	s.Lock()
+	defer s.Unlock()
+	var entries []*mvccEntry
+	s.ascend(startKey, endKey, func(e *mvccEntry) bool {
+		entries = append(entries, e)
+		return true
+	})
+	for _, e := range entries {
+		s.tree.Delete(e)
+	}
+}
+
+// Import writes the mutations directly as committed at commitTS.
+func (s *MVCCStore) Import(mutations []*kvrpcpb.Mutation, commitTS uint64) {
+	s.Lock()
+	defer s.Unlock()
+	for _, m := range mutations {
+		op := m.GetOp()
+		if op == kvrpcpb.Op_Insert {
+			op = kvrpcpb.Op_Put
+		}
+		e := s.getOrNewEntry(m.GetKey())
+		e.addWrite(mvccWrite{startTS: commitTS, commitTS: commitTS, op: op, value: mutationValue(op, m.GetValue())})
+	}
+}
+
+// mutationValue copies the value of a Put so that an empty value is still
+// distinguishable from a missing one.
+func mutationValue(op kvrpcpb.Op, value []byte) []byte {
+	if op != kvrpcpb.Op_Put {
+		return nil
+	}
+	return append([]byte{}, value...)
+}
+
+// MvccInfo returns the MVCC data of key for debugging.
+func (s *MVCCStore) MvccInfo(key []byte) *kvrpcpb.MvccInfo {
+	s.RLock()
+	defer s.RUnlock()
+	e := s.getEntry(key)
+	if e == nil {
+		return nil
+	}
+	return e.info()
+}
+
+// MvccInfoByStartTS returns the first key written by the transaction started
+// at startTS and its MVCC data.
+func (s *MVCCStore) MvccInfoByStartTS(startTS uint64) ([]byte, *kvrpcpb.MvccInfo) {
+	s.RLock()
+	defer s.RUnlock()
+	var found *mvccEntry
+	s.ascend(nil, nil, func(e *mvccEntry) bool {
+		if e.lock != nil && e.lock.startTS == startTS {
+			found = e
+			return false
+		}
+		if _, ok := e.findWrite(startTS); ok {
+			found = e
+			return false
+		}
+		return true
+	})
+	if found == nil {
+		return nil, nil
+	}
+	return found.key, found.info()
+}
+
+func (e *mvccEntry) info() *kvrpcpb.MvccInfo {
+	info := &kvrpcpb.MvccInfo{}
+	if l := e.lock; l != nil {
+		info.Lock = &kvrpcpb.MvccLock{
+			Type:       l.op,
+			StartTs:    l.startTS,
+			Primary:    l.primary,
+			ShortValue: l.value,
+		}
+	}
+	for _, w := range e.writes {
+		info.Writes = append(info.Writes, &kvrpcpb.MvccWrite{
+			Type:       w.op,
+			StartTs:    w.startTS,
+			CommitTs:   w.commitTS,
+			ShortValue: w.value,
+		})
+		if w.op == kvrpcpb.Op_Put {
+			info.Values = append(info.Values, &kvrpcpb.MvccValue{StartTs: w.startTS, Value: w.value})
+		}
+	}
+	return info
+}
@@ -0,0 +1,250 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/google/btree"
+)
+
+type rawEntry struct {
+	key   []byte
+	value []byte
+	// expireAt is zero if the entry never expires.
+	expireAt time.Time
+}
+
+func (e *rawEntry) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+func (e *rawEntry) Less(than btree.Item) bool {
+	return bytes.Compare(e.key, than.(*rawEntry).key) < 0
+}
+
+// rawTree returns the tree of column family cf, creating it if create is set.
+func (s *MVCCStore) rawTree(cf string, create bool) *btree.BTree {
+	tree, ok := s.raw[cf]
+	if !ok && create {
+		tree = btree.New(btreeDegree)
+		s.raw[cf] = tree
+	}
+	return tree
+}
+
+// RawGet returns the value of key in cf, or nil if it does not exist or has
+// expired.
+func (s *MVCCStore) RawGet(cf string, key []byte) []byte {
+	s.RLock()
+	defer s.RUnlock()
+	return s.rawGet(cf, key)
+}
+
+func (s *MVCCStore) rawGet(cf string, key []byte) []byte {
+	if e := s.rawEntry(cf, key); e != nil {
+		return e.value
+	}
+	return nil
+}
+
+// rawEntry returns the entry of key in cf, or nil if it does not exist or
+// has expired.
+func (s *MVCCStore) rawEntry(cf string, key []byte) *rawEntry {
+	tree := s.rawTree(cf, false)
+	if tree == nil {
+		return nil
+	}
+	item := tree.Get(&rawEntry{key: key})
+	if item == nil || item.(*rawEntry).expired(s.now()) {
+		return nil
+	}
+	return item.(*rawEntry)
+}
+
+// RawGetKeyTTL returns the seconds until key in cf expires, rounded up, or 0
+// if it never expires. ok is false if key does not exist or has expired.
+func (s *MVCCStore) RawGetKeyTTL(cf string, key []byte) (ttl uint64, ok bool) {
+	s.RLock()
+	defer s.RUnlock()
+	e := s.rawEntry(cf, key)
+	if e == nil {
+		return 0, false
+	}
+	if e.expireAt.IsZero() {
+		return 0, true
+	}
+	left := e.expireAt.Sub(s.now())
+	return uint64((left + time.Second - 1) / time.Second), true
+}
+
+// RawBatchGet returns the pairs of keys that exist in cf.
+func (s *MVCCStore) RawBatchGet(cf string, keys [][]byte) []Pair {
+	s.RLock()
+	defer s.RUnlock()
+	var pairs []Pair
+	for _, key := range keys {
+		if value := s.rawGet(cf, key); value != nil {
+			pairs = append(pairs, Pair{Key: key, Value: value})
+		}
+	}
+	return pairs
+}
+
+// RawPut sets key to value in cf, expiring in ttl seconds unless ttl is 0.
+func (s *MVCCStore) RawPut(cf string, key, value []byte, ttl uint64) {
+	s.Lock()
+	defer s.Unlock()
+	s.rawPut(cf, key, value, ttl)
+}
+
+func (s *MVCCStore) rawPut(cf string, key, value []byte, ttl uint64) {
+	e := &rawEntry{
+		key:   append([]byte{}, key...),
+		value: append([]byte{}, value...),
+	}
+	if ttl > 0 {
+		e.expireAt = s.now().Add(time.Duration(ttl) * time.Second)
+	}
+	s.rawTree(cf, true).ReplaceOrInsert(e)
+}
+
+// RawBatchPut sets all pairs in cf. ttls is either empty or holds the TTL of
+// each pair, like RawPut.
+func (s *MVCCStore) RawBatchPut(cf string, keys, values [][]byte, ttls []uint64) {
+	s.Lock()
+	defer s.Unlock()
+	for i, key := range keys {
+		var ttl uint64
+		if i < len(ttls) {
+			ttl = ttls[i]
+		}
+		s.rawPut(cf, key, values[i], ttl)
+	}
+}
+
+// RawCompareAndSwap sets key to value in cf, with ttl like RawPut, if its
+// current value equals previous, where a nil previous means key does not
+// exist. It returns the current value, nil if key does not exist, and
+// whether value was set.
+func (s *MVCCStore) RawCompareAndSwap(cf string, key, previous, value []byte, ttl uint64) ([]byte, bool) {
+	s.Lock()
+	defer s.Unlock()
+	old := s.rawGet(cf, key)
+	if (old == nil) != (previous == nil) || !bytes.Equal(old, previous) {
+		return old, false
+	}
+	s.rawPut(cf, key, value, ttl)
+	return old, true
+}
+
+// RawDelete removes key from cf.
+func (s *MVCCStore) RawDelete(cf string, key []byte) {
+	s.Lock()
+	defer s.Unlock()
+	s.rawDelete(cf, key)
+}
+
+func (s *MVCCStore) rawDelete(cf string, key []byte) {
+	if tree := s.rawTree(cf, false); tree != nil {
+		tree.Delete(&rawEntry{key: key})
+	}
+}
+
+// RawBatchDelete removes keys from cf.
+func (s *MVCCStore) RawBatchDelete(cf string, keys [][]byte) {
+	s.Lock()
+	defer s.Unlock()
+	for _, key := range keys {
+		s.rawDelete(cf, key)
+	}
+}
+
+// RawScan returns at most limit pairs in [startKey, endKey) of cf, skipping
+// expired keys. An empty endKey means +inf.
+func (s *MVCCStore) RawScan(cf string, startKey, endKey []byte, limit int) []Pair {
+	s.RLock()
+	defer s.RUnlock()
+	tree := s.rawTree(cf, false)
+	if tree == nil || limit <= 0 {
+		return nil
+	}
+	var pairs []Pair
+	now := s.now()
+	ascendRange(tree, &rawEntry{key: startKey}, rawEnd(endKey), func(item btree.Item) bool {
+		if e := item.(*rawEntry); !e.expired(now) {
+			pairs = append(pairs, Pair{Key: e.key, Value: e.value})
+		}
+		return len(pairs) < limit
+	})
+	return pairs
+}
+
+// RawReverseScan returns at most limit pairs in [startKey, endKey) of cf in
+// descending order, skipping expired keys. An empty endKey means +inf.
+func (s *MVCCStore) RawReverseScan(cf string, startKey, endKey []byte, limit int) []Pair {
+	s.RLock()
+	defer s.RUnlock()
+	tree := s.rawTree(cf, false)
+	if tree == nil || limit <= 0 {
+		return nil
+	}
+	var pairs []Pair
+	now := s.now()
+	descendRange(tree, &rawEntry{key: startKey}, rawEnd(endKey), func(item btree.Item) bool {
+		if e := item.(*rawEntry); !e.expired(now) {
+			pairs = append(pairs, Pair{Key: e.key, Value: e.value})
+		}
+		return len(pairs) < limit
+	})
+	return pairs
+}
+
+// RawDeleteRange removes all keys in [startKey, endKey) of cf.
+func (s *MVCCStore) RawDeleteRange(cf string, startKey, endKey []byte) {
+	s.Lock()
+	defer s.Unlock()
+	if tree := s.rawTree(cf, false); tree != nil {
+		rawDeleteRange(tree, startKey, endKey)
+	}
+}
+
+// DestroyRange removes all transactional and raw data in [startKey, endKey).
+func (s *MVCCStore) DestroyRange(startKey, endKey []byte) {
+	s.DeleteRange(startKey, endKey)
+	s.Lock()
+	defer s.Unlock()
+	for _, tree := range s.raw {
+		rawDeleteRange(tree, startKey, endKey)
+	}
+}
+
+func rawDeleteRange(tree *btree.BTree, startKey, endKey []byte) {
+	var items []btree.Item
+	ascendRange(tree, &rawEntry{key: startKey}, rawEnd(endKey), func(item btree.Item) bool {
+		items = append(items, item)
+		return true
+	})
+	for _, item := range items {
+		tree.Delete(item)
+	}
+}
+
+func rawEnd(endKey []byte) btree.Item {
+	if len(endKey) == 0 {
+		return nil
+	}
+	return &rawEntry{key: endKey}
+}
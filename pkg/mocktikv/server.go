@@ -0,0 +1,885 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mocktikv implements an in-process TiKV server on top of an
+// in-memory MVCC store, for testing clients without a real cluster.
+package mocktikv
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/coprocessor"
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a mock TiKV server. Several Servers with different store IDs may
+// share one Cluster and one MVCCStore to simulate a multi-store cluster.
+//
+// Like TiKV, a Server rejects requests whose context carries no region ID,
+// unless SetAllowNoRegion is set.
+type Server struct {
+	storeID       uint64
+	cluster       Cluster
+	store         *MVCCStore
+	allowNoRegion bool
+
+	transportLayerLoad uint64
+}
+
+var _ tikvpb.TikvServer = (*Server)(nil)
+
+// NewServer creates a Server for storeID.
+func NewServer(storeID uint64, cluster Cluster, store *MVCCStore) *Server {
+	return &Server{
+		storeID: storeID,
+		cluster: cluster,
+		store:   store,
+	}
+}
+
+// SetTransportLayerLoad sets the transport_layer_load reported in
+// BatchCommands responses.
+func (s *Server) SetTransportLayerLoad(load uint64) {
+	atomic.StoreUint64(&s.transportLayerLoad, load)
+}
+
+// SetAllowNoRegion lets requests whose context carries no region ID skip
+// all region checks, so that the Server can be used without any routing at
+// all. It must be called before the Server serves requests.
+func (s *Server) SetAllowNoRegion(allow bool) {
+	s.allowNoRegion = allow
+}
+
+// checkContext verifies that this store leads the region addressed by ctx
+// with a matching epoch. It returns the region, or nil if ctx addresses no
+// region and that is allowed.
+func (s *Server) checkContext(ctx *kvrpcpb.Context) (*metapb.Region, *errorpb.Error) {
+	if ctx.GetRegionId() == 0 {
+		if s.allowNoRegion {
+			return nil, nil
+		}
+		return nil, &errorpb.Error{
+			Message:        "region not found",
+			RegionNotFound: &errorpb.RegionNotFound{},
+		}
+	}
+	if peer := ctx.GetPeer(); peer != nil && peer.GetStoreId() != s.storeID {
+		return nil, &errorpb.Error{
+			Message: "store not match",
+			StoreNotMatch: &errorpb.StoreNotMatch{
+				RequestStoreId: peer.GetStoreId(),
+				ActualStoreId:  s.storeID,
+			},
+		}
+	}
+	region, leader := s.cluster.RegionByID(ctx.GetRegionId())
+	if region == nil {
+		return nil, &errorpb.Error{
+			Message:        "region not found",
+			RegionNotFound: &errorpb.RegionNotFound{RegionId: ctx.GetRegionId()},
+		}
+	}
+	if leader == nil || leader.GetStoreId() != s.storeID {
+		return nil, &errorpb.Error{
+			Message:   "not leader",
+			NotLeader: &errorpb.NotLeader{RegionId: region.GetId(), Leader: leader},
+		}
+	}
+	if epoch := ctx.GetRegionEpoch(); epoch != nil {
+		current := region.GetRegionEpoch()
+		if epoch.GetVersion() != current.GetVersion() || epoch.GetConfVer() != current.GetConfVer() {
+			return nil, &errorpb.Error{
+				Message:       "epoch not match",
+				EpochNotMatch: &errorpb.EpochNotMatch{CurrentRegions: []*metapb.Region{region}},
+			}
+		}
+	}
+	return region, nil
+}
+
+// checkKeys verifies the region in ctx and that it contains every key.
+func (s *Server) checkKeys(ctx *kvrpcpb.Context, keys ...[]byte) (*metapb.Region, *errorpb.Error) {
+	region, err := s.checkContext(ctx)
+	if err != nil || region == nil {
+		return region, err
+	}
+	for _, key := range keys {
+		if !region.ContainsKey(key) {
+			return nil, keyNotInRegion(region, key)
+		}
+	}
+	return region, nil
+}
+
+func (s *Server) checkKeysErr(ctx *kvrpcpb.Context, keys ...[]byte) *errorpb.Error {
+	_, err := s.checkKeys(ctx, keys...)
+	return err
+}
+
+// checkMutations is checkKeys for the keys of mutations.
+func (s *Server) checkMutations(ctx *kvrpcpb.Context, mutations []*kvrpcpb.Mutation) *errorpb.Error {
+	keys := make([][]byte, 0, len(mutations))
+	for _, m := range mutations {
+		keys = append(keys, m.GetKey())
+	}
+	_, err := s.checkKeys(ctx, keys...)
+	return err
+}
+
+// checkRange verifies the region in ctx, then clips [startKey, endKey) to it.
+// startKey must be inside the region.
+func (s *Server) checkRange(ctx *kvrpcpb.Context, startKey, endKey []byte) ([]byte, []byte, *errorpb.Error) {
+	region, err := s.checkKeys(ctx, startKey)
+	if err != nil || region == nil {
+		return startKey, endKey, err
+	}
+	startKey, endKey = clipRange(region, startKey, endKey)
+	return startKey, endKey, nil
+}
+
+// checkReverseRange verifies the region in ctx for a reverse scan of
+// [lowerKey, upperKey), then clips the range to it. upperKey must fall inside
+// the region, or be its end.
+func (s *Server) checkReverseRange(ctx *kvrpcpb.Context, lowerKey, upperKey []byte) ([]byte, []byte, *errorpb.Error) {
+	region, err := s.checkContext(ctx)
+	if err != nil || region == nil {
+		return lowerKey, upperKey, err
+	}
+	inRegion := false
+	if len(upperKey) == 0 {
+		inRegion = len(region.GetEndKey()) == 0
+	} else {
+		inRegion = bytes.Compare(upperKey, region.GetStartKey()) > 0 &&
+			(len(region.GetEndKey()) == 0 || bytes.Compare(upperKey, region.GetEndKey()) <= 0)
+	}
+	if !inRegion {
+		return nil, nil, keyNotInRegion(region, upperKey)
+	}
+	lowerKey, upperKey = clipRange(region, lowerKey, upperKey)
+	return lowerKey, upperKey, nil
+}
+
+func keyNotInRegion(region *metapb.Region, key []byte) *errorpb.Error {
+	return &errorpb.Error{
+		Message: "key not in region",
+		KeyNotInRegion: &errorpb.KeyNotInRegion{
+			Key:      key,
+			RegionId: region.GetId(),
+			StartKey: region.GetStartKey(),
+			EndKey:   region.GetEndKey(),
+		},
+	}
+}
+
+// clipRange returns the intersection of [startKey, endKey) and the region.
+// An empty endKey means +inf.
+func clipRange(region *metapb.Region, startKey, endKey []byte) ([]byte, []byte) {
+	if bytes.Compare(startKey, region.GetStartKey()) < 0 {
+		startKey = region.GetStartKey()
+	}
+	if regionEnd := region.GetEndKey(); len(regionEnd) > 0 {
+		if len(endKey) == 0 || bytes.Compare(endKey, regionEnd) > 0 {
+			endKey = regionEnd
+		}
+	}
+	return startKey, endKey
+}
+
+// scanStreamChunkSize is the number of pairs in a chunk of a streaming scan.
+const scanStreamChunkSize = 64
+
+// scanStream serves a streaming scan of [startKey, endKey), or of [endKey,
+// startKey) in reverse, in the region of ctx. scan reads at most limit pairs
+// of [lower, upper) in the scan order, and send sends a chunk. The last chunk
+// is a KeyNotInRegion error if the range goes on beyond the region.
+func (s *Server) scanStream(ctx *kvrpcpb.Context, startKey, endKey []byte, limit uint32, reverse bool,
+	scan func(lower, upper []byte, limit int) []Pair,
+	send func(regionErr *errorpb.Error, pairs []Pair, resumeKey []byte) error) error {
+	var (
+		lower, upper []byte
+		regionErr    *errorpb.Error
+		boundary     []byte
+	)
+	if reverse {
+		lower, upper, regionErr = s.checkReverseRange(ctx, endKey, startKey)
+		if regionErr == nil && !bytes.Equal(lower, endKey) {
+			boundary = lower
+		}
+	} else {
+		lower, upper, regionErr = s.checkRange(ctx, startKey, endKey)
+		if regionErr == nil && len(upper) > 0 && !bytes.Equal(upper, endKey) {
+			boundary = upper
+		}
+	}
+	if regionErr != nil {
+		return send(regionErr, nil, nil)
+	}
+	remain := int(limit)
+	for limit == 0 || remain > 0 {
+		n := scanStreamChunkSize
+		if limit > 0 && remain < n {
+			n = remain
+		}
+		pairs := scan(lower, upper, n)
+		if len(pairs) == 0 {
+			break
+		}
+		last := pairs[len(pairs)-1].Key
+		if reverse {
+			upper = last
+		} else {
+			lower = append(append([]byte{}, last...), 0)
+		}
+		resumeKey := lower
+		if reverse {
+			resumeKey = upper
+		}
+		if err := send(nil, pairs, resumeKey); err != nil {
+			return err
+		}
+		remain -= len(pairs)
+		if len(pairs) < n {
+			break
+		}
+	}
+	if boundary == nil || limit > 0 && remain <= 0 {
+		return nil
+	}
+	region, _ := s.cluster.RegionByID(ctx.GetRegionId())
+	return send(keyNotInRegion(region, boundary), nil, boundary)
+}
+
+func regionRange(region *metapb.Region) ([]byte, []byte) {
+	if region == nil {
+		return nil, nil
+	}
+	return region.GetStartKey(), region.GetEndKey()
+}
+
+func convertToPairs(pairs []Pair, keyOnly bool) []*kvrpcpb.KvPair {
+	kvPairs := make([]*kvrpcpb.KvPair, 0, len(pairs))
+	for _, p := range pairs {
+		if p.Err != nil {
+			kvPairs = append(kvPairs, &kvrpcpb.KvPair{Key: p.Key, Error: convertToKeyError(p.Err)})
+			continue
+		}
+		kvPair := &kvrpcpb.KvPair{Key: p.Key}
+		if !keyOnly {
+			kvPair.Value = p.Value
+		}
+		kvPairs = append(kvPairs, kvPair)
+	}
+	return kvPairs
+}
+
+// KvGet implements tikvpb.TikvServer.
+func (s *Server) KvGet(ctx context.Context, req *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.GetResponse{RegionError: regionErr}, nil
+	}
+	value, err := s.store.Get(req.GetContext(), req.GetKey(), req.GetVersion())
+	if err != nil {
+		return &kvrpcpb.GetResponse{Error: convertToKeyError(err)}, nil
+	}
+	return &kvrpcpb.GetResponse{Value: value, NotFound: value == nil}, nil
+}
+
+// KvScan implements tikvpb.TikvServer.
+func (s *Server) KvScan(ctx context.Context, req *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error) {
+	var pairs []Pair
+	if req.GetReverse() {
+		lowerKey, upperKey, regionErr := s.checkReverseRange(req.GetContext(), req.GetEndKey(), req.GetStartKey())
+		if regionErr != nil {
+			return &kvrpcpb.ScanResponse{RegionError: regionErr}, nil
+		}
+		pairs = s.store.ReverseScan(req.GetContext(), lowerKey, upperKey, int(req.GetLimit()), req.GetVersion())
+	} else {
+		startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetStartKey(), req.GetEndKey())
+		if regionErr != nil {
+			return &kvrpcpb.ScanResponse{RegionError: regionErr}, nil
+		}
+		pairs = s.store.Scan(req.GetContext(), startKey, endKey, int(req.GetLimit()), req.GetVersion())
+	}
+	return &kvrpcpb.ScanResponse{Pairs: convertToPairs(pairs, req.GetKeyOnly())}, nil
+}
+
+// KvScanStream implements tikvpb.TikvServer.
+func (s *Server) KvScanStream(req *kvrpcpb.ScanRequest, stream tikvpb.Tikv_KvScanStreamServer) error {
+	scan := func(lower, upper []byte, limit int) []Pair {
+		if req.GetReverse() {
+			return s.store.ReverseScan(req.GetContext(), lower, upper, limit, req.GetVersion())
+		}
+		return s.store.Scan(req.GetContext(), lower, upper, limit, req.GetVersion())
+	}
+	return s.scanStream(req.GetContext(), req.GetStartKey(), req.GetEndKey(), req.GetLimit(), req.GetReverse(), scan,
+		func(regionErr *errorpb.Error, pairs []Pair, resumeKey []byte) error {
+			return stream.Send(&kvrpcpb.ScanStreamResponse{
+				RegionError: regionErr,
+				Pairs:       convertToPairs(pairs, req.GetKeyOnly()),
+				ResumeKey:   resumeKey,
+			})
+		})
+}
+
+// Checksum implements tikvpb.TikvServer.
+func (s *Server) Checksum(ctx context.Context, req *kvrpcpb.ChecksumRequest) (*kvrpcpb.ChecksumResponse, error) {
+	startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetRange().GetStartKey(), req.GetRange().GetEndKey())
+	if regionErr != nil {
+		return &kvrpcpb.ChecksumResponse{RegionError: regionErr}, nil
+	}
+	c, err := s.store.Checksum(req.GetContext(), startKey, endKey, req.GetVersion())
+	if err != nil {
+		return &kvrpcpb.ChecksumResponse{Error: convertToKeyError(err)}, nil
+	}
+	return &kvrpcpb.ChecksumResponse{
+		Crc64Xor:   c.Crc64Xor,
+		TotalKvs:   c.TotalKvs,
+		TotalBytes: c.TotalBytes,
+	}, nil
+}
+
+// KvPrewrite implements tikvpb.TikvServer.
+func (s *Server) KvPrewrite(ctx context.Context, req *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error) {
+	if regionErr := s.checkMutations(req.GetContext(), req.GetMutations()); regionErr != nil {
+		return &kvrpcpb.PrewriteResponse{RegionError: regionErr}, nil
+	}
+	errs := s.store.Prewrite(req)
+	return &kvrpcpb.PrewriteResponse{Errors: convertToKeyErrors(errs)}, nil
+}
+
+// KvPessimisticLock implements tikvpb.TikvServer.
+func (s *Server) KvPessimisticLock(ctx context.Context, req *kvrpcpb.PessimisticLockRequest) (*kvrpcpb.PessimisticLockResponse, error) {
+	if regionErr := s.checkMutations(req.GetContext(), req.GetMutations()); regionErr != nil {
+		return &kvrpcpb.PessimisticLockResponse{RegionError: regionErr}, nil
+	}
+	errs := s.store.PessimisticLock(req)
+	return &kvrpcpb.PessimisticLockResponse{Errors: convertToKeyErrors(errs)}, nil
+}
+
+// KVPessimisticRollback implements tikvpb.TikvServer.
+func (s *Server) KVPessimisticRollback(ctx context.Context, req *kvrpcpb.PessimisticRollbackRequest) (*kvrpcpb.PessimisticRollbackResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKeys()...); regionErr != nil {
+		return &kvrpcpb.PessimisticRollbackResponse{RegionError: regionErr}, nil
+	}
+	s.store.PessimisticRollback(req.GetKeys(), req.GetStartVersion(), req.GetForUpdateTs())
+	return &kvrpcpb.PessimisticRollbackResponse{}, nil
+}
+
+// KvTxnHeartBeat implements tikvpb.TikvServer.
+func (s *Server) KvTxnHeartBeat(ctx context.Context, req *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetPrimaryLock()); regionErr != nil {
+		return &kvrpcpb.TxnHeartBeatResponse{RegionError: regionErr}, nil
+	}
+	ttl, err := s.store.TxnHeartBeat(req.GetPrimaryLock(), req.GetStartVersion(), req.GetAdviseLockTtl())
+	return &kvrpcpb.TxnHeartBeatResponse{LockTtl: ttl, Error: convertToKeyError(err)}, nil
+}
+
+// KvCheckTxnStatus implements tikvpb.TikvServer.
+func (s *Server) KvCheckTxnStatus(ctx context.Context, req *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetPrimaryKey()); regionErr != nil {
+		return &kvrpcpb.CheckTxnStatusResponse{RegionError: regionErr}, nil
+	}
+	ttl, commitTS, action, err := s.store.CheckTxnStatus(req.GetPrimaryKey(), req.GetLockTs(), req.GetCallerStartTs(), req.GetCurrentTs())
+	return &kvrpcpb.CheckTxnStatusResponse{LockTtl: ttl, CommitVersion: commitTS, Action: action, Error: convertToKeyError(err)}, nil
+}
+
+// KvCommit implements tikvpb.TikvServer.
+func (s *Server) KvCommit(ctx context.Context, req *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKeys()...); regionErr != nil {
+		return &kvrpcpb.CommitResponse{RegionError: regionErr}, nil
+	}
+	commitTS, err := s.store.Commit(req.GetKeys(), req.GetStartVersion(), req.GetCommitVersion())
+	if err != nil {
+		return &kvrpcpb.CommitResponse{Error: convertToKeyError(err)}, nil
+	}
+	resp := &kvrpcpb.CommitResponse{}
+	if commitTS != req.GetCommitVersion() {
+		resp.CommitVersion = commitTS
+	}
+	return resp, nil
+}
+
+// KvImport implements tikvpb.TikvServer.
+func (s *Server) KvImport(ctx context.Context, req *kvrpcpb.ImportRequest) (*kvrpcpb.ImportResponse, error) {
+	s.store.Import(req.GetMutations(), req.GetCommitVersion())
+	return &kvrpcpb.ImportResponse{}, nil
+}
+
+// KvCleanup implements tikvpb.TikvServer.
+func (s *Server) KvCleanup(ctx context.Context, req *kvrpcpb.CleanupRequest) (*kvrpcpb.CleanupResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.CleanupResponse{RegionError: regionErr}, nil
+	}
+	commitTS, err := s.store.Cleanup(req.GetKey(), req.GetStartVersion(), req.GetCurrentTs())
+	return &kvrpcpb.CleanupResponse{CommitVersion: commitTS, Error: convertToKeyError(err)}, nil
+}
+
+// KvBatchGet implements tikvpb.TikvServer.
+func (s *Server) KvBatchGet(ctx context.Context, req *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKeys()...); regionErr != nil {
+		return &kvrpcpb.BatchGetResponse{RegionError: regionErr}, nil
+	}
+	pairs := s.store.BatchGet(req.GetContext(), req.GetKeys(), req.GetVersion())
+	return &kvrpcpb.BatchGetResponse{Pairs: convertToPairs(pairs, false)}, nil
+}
+
+// KvBatchRollback implements tikvpb.TikvServer.
+func (s *Server) KvBatchRollback(ctx context.Context, req *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKeys()...); regionErr != nil {
+		return &kvrpcpb.BatchRollbackResponse{RegionError: regionErr}, nil
+	}
+	err := s.store.Rollback(req.GetKeys(), req.GetStartVersion())
+	return &kvrpcpb.BatchRollbackResponse{Error: convertToKeyError(err)}, nil
+}
+
+// KvScanLock implements tikvpb.TikvServer.
+func (s *Server) KvScanLock(ctx context.Context, req *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error) {
+	startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetStartKey(), nil)
+	if regionErr != nil {
+		return &kvrpcpb.ScanLockResponse{RegionError: regionErr}, nil
+	}
+	locks := s.store.ScanLock(startKey, endKey, req.GetMaxVersion(), int(req.GetLimit()))
+	return &kvrpcpb.ScanLockResponse{Locks: locks}, nil
+}
+
+// KvResolveLock implements tikvpb.TikvServer.
+func (s *Server) KvResolveLock(ctx context.Context, req *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error) {
+	region, regionErr := s.checkKeys(req.GetContext(), req.GetKeys()...)
+	if regionErr != nil {
+		return &kvrpcpb.ResolveLockResponse{RegionError: regionErr}, nil
+	}
+	txnStatus := make(map[uint64]uint64)
+	if len(req.GetTxnInfos()) > 0 {
+		for _, info := range req.GetTxnInfos() {
+			txnStatus[info.GetTxn()] = info.GetStatus()
+		}
+	} else {
+		txnStatus[req.GetStartVersion()] = req.GetCommitVersion()
+	}
+	startKey, endKey := regionRange(region)
+	s.store.ResolveLock(startKey, endKey, req.GetKeys(), txnStatus)
+	return &kvrpcpb.ResolveLockResponse{}, nil
+}
+
+// KvGC implements tikvpb.TikvServer.
+func (s *Server) KvGC(ctx context.Context, req *kvrpcpb.GCRequest) (*kvrpcpb.GCResponse, error) {
+	region, regionErr := s.checkContext(req.GetContext())
+	if regionErr != nil {
+		return &kvrpcpb.GCResponse{RegionError: regionErr}, nil
+	}
+	startKey, endKey := regionRange(region)
+	s.store.GC(startKey, endKey, req.GetSafePoint())
+	return &kvrpcpb.GCResponse{}, nil
+}
+
+// KvDeleteRange implements tikvpb.TikvServer.
+func (s *Server) KvDeleteRange(ctx context.Context, req *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error) {
+	startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetStartKey(), req.GetEndKey())
+	if regionErr != nil {
+		return &kvrpcpb.DeleteRangeResponse{RegionError: regionErr}, nil
+	}
+	if !req.GetNotifyOnly() {
+		s.store.DeleteRange(startKey, endKey)
+	}
+	return &kvrpcpb.DeleteRangeResponse{}, nil
+}
+
+// RawGet implements tikvpb.TikvServer.
+func (s *Server) RawGet(ctx context.Context, req *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.RawGetResponse{RegionError: regionErr}, nil
+	}
+	value := s.store.RawGet(req.GetCf(), req.GetKey())
+	return &kvrpcpb.RawGetResponse{Value: value, NotFound: value == nil}, nil
+}
+
+// RawGetKeyTTL implements tikvpb.TikvServer.
+func (s *Server) RawGetKeyTTL(ctx context.Context, req *kvrpcpb.RawGetKeyTTLRequest) (*kvrpcpb.RawGetKeyTTLResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.RawGetKeyTTLResponse{RegionError: regionErr}, nil
+	}
+	ttl, ok := s.store.RawGetKeyTTL(req.GetCf(), req.GetKey())
+	return &kvrpcpb.RawGetKeyTTLResponse{Ttl: ttl, NotFound: !ok}, nil
+}
+
+// RawBatchGet implements tikvpb.TikvServer.
+func (s *Server) RawBatchGet(ctx context.Context, req *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKeys()...); regionErr != nil {
+		return &kvrpcpb.RawBatchGetResponse{RegionError: regionErr}, nil
+	}
+	pairs := s.store.RawBatchGet(req.GetCf(), req.GetKeys())
+	return &kvrpcpb.RawBatchGetResponse{Pairs: convertToPairs(pairs, false)}, nil
+}
+
+// RawPut implements tikvpb.TikvServer.
+func (s *Server) RawPut(ctx context.Context, req *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.RawPutResponse{RegionError: regionErr}, nil
+	}
+	s.store.RawPut(req.GetCf(), req.GetKey(), req.GetValue(), req.GetTtl())
+	return &kvrpcpb.RawPutResponse{}, nil
+}
+
+// RawBatchPut implements tikvpb.TikvServer.
+func (s *Server) RawBatchPut(ctx context.Context, req *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error) {
+	keys := make([][]byte, 0, len(req.GetPairs()))
+	values := make([][]byte, 0, len(req.GetPairs()))
+	for _, pair := range req.GetPairs() {
+		keys = append(keys, pair.GetKey())
+		values = append(values, pair.GetValue())
+	}
+	if regionErr := s.checkKeysErr(req.GetContext(), keys...); regionErr != nil {
+		return &kvrpcpb.RawBatchPutResponse{RegionError: regionErr}, nil
+	}
+	if ttls := req.GetTtls(); len(ttls) > 0 && len(ttls) != len(keys) {
+		return &kvrpcpb.RawBatchPutResponse{Error: "ttls do not match pairs"}, nil
+	}
+	s.store.RawBatchPut(req.GetCf(), keys, values, req.GetTtls())
+	return &kvrpcpb.RawBatchPutResponse{}, nil
+}
+
+// RawDelete implements tikvpb.TikvServer.
+func (s *Server) RawDelete(ctx context.Context, req *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.RawDeleteResponse{RegionError: regionErr}, nil
+	}
+	s.store.RawDelete(req.GetCf(), req.GetKey())
+	return &kvrpcpb.RawDeleteResponse{}, nil
+}
+
+// RawBatchDelete implements tikvpb.TikvServer.
+func (s *Server) RawBatchDelete(ctx context.Context, req *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKeys()...); regionErr != nil {
+		return &kvrpcpb.RawBatchDeleteResponse{RegionError: regionErr}, nil
+	}
+	s.store.RawBatchDelete(req.GetCf(), req.GetKeys())
+	return &kvrpcpb.RawBatchDeleteResponse{}, nil
+}
+
+// RawScan implements tikvpb.TikvServer. A reverse scan reads [end_key,
+// start_key) in descending order.
+func (s *Server) RawScan(ctx context.Context, req *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error) {
+	var pairs []Pair
+	if req.GetReverse() {
+		lowerKey, upperKey, regionErr := s.checkReverseRange(req.GetContext(), req.GetEndKey(), req.GetStartKey())
+		if regionErr != nil {
+			return &kvrpcpb.RawScanResponse{RegionError: regionErr}, nil
+		}
+		pairs = s.store.RawReverseScan(req.GetCf(), lowerKey, upperKey, int(req.GetLimit()))
+	} else {
+		startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetStartKey(), req.GetEndKey())
+		if regionErr != nil {
+			return &kvrpcpb.RawScanResponse{RegionError: regionErr}, nil
+		}
+		pairs = s.store.RawScan(req.GetCf(), startKey, endKey, int(req.GetLimit()))
+	}
+	return &kvrpcpb.RawScanResponse{Kvs: convertToPairs(pairs, req.GetKeyOnly())}, nil
+}
+
+// RawScanStream implements tikvpb.TikvServer.
+func (s *Server) RawScanStream(req *kvrpcpb.RawScanRequest, stream tikvpb.Tikv_RawScanStreamServer) error {
+	scan := func(lower, upper []byte, limit int) []Pair {
+		if req.GetReverse() {
+			return s.store.RawReverseScan(req.GetCf(), lower, upper, limit)
+		}
+		return s.store.RawScan(req.GetCf(), lower, upper, limit)
+	}
+	return s.scanStream(req.GetContext(), req.GetStartKey(), req.GetEndKey(), req.GetLimit(), req.GetReverse(), scan,
+		func(regionErr *errorpb.Error, pairs []Pair, resumeKey []byte) error {
+			return stream.Send(&kvrpcpb.RawScanStreamResponse{
+				RegionError: regionErr,
+				Kvs:         convertToPairs(pairs, req.GetKeyOnly()),
+				ResumeKey:   resumeKey,
+			})
+		})
+}
+
+// RawDeleteRange implements tikvpb.TikvServer.
+func (s *Server) RawDeleteRange(ctx context.Context, req *kvrpcpb.RawDeleteRangeRequest) (*kvrpcpb.RawDeleteRangeResponse, error) {
+	startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetStartKey(), req.GetEndKey())
+	if regionErr != nil {
+		return &kvrpcpb.RawDeleteRangeResponse{RegionError: regionErr}, nil
+	}
+	s.store.RawDeleteRange(req.GetCf(), startKey, endKey)
+	return &kvrpcpb.RawDeleteRangeResponse{}, nil
+}
+
+// RawBatchScan implements tikvpb.TikvServer. Like RawScan, the start key of
+// each range is its upper bound when scanning in reverse.
+func (s *Server) RawBatchScan(ctx context.Context, req *kvrpcpb.RawBatchScanRequest) (*kvrpcpb.RawBatchScanResponse, error) {
+	var kvs []*kvrpcpb.KvPair
+	for _, r := range req.GetRanges() {
+		resp, err := s.RawScan(ctx, &kvrpcpb.RawScanRequest{
+			Context:  req.GetContext(),
+			StartKey: r.GetStartKey(),
+			EndKey:   r.GetEndKey(),
+			Limit:    req.GetEachLimit(),
+			KeyOnly:  req.GetKeyOnly(),
+			Cf:       req.GetCf(),
+			Reverse:  req.GetReverse(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.GetRegionError() != nil {
+			return &kvrpcpb.RawBatchScanResponse{RegionError: resp.GetRegionError()}, nil
+		}
+		kvs = append(kvs, resp.GetKvs()...)
+	}
+	return &kvrpcpb.RawBatchScanResponse{Kvs: kvs}, nil
+}
+
+// RawCompareAndSwap implements tikvpb.TikvServer.
+func (s *Server) RawCompareAndSwap(ctx context.Context, req *kvrpcpb.RawCompareAndSwapRequest) (*kvrpcpb.RawCompareAndSwapResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.RawCompareAndSwapResponse{RegionError: regionErr}, nil
+	}
+	var previous []byte
+	if !req.GetPreviousNotExist() {
+		previous = append([]byte{}, req.GetPreviousValue()...)
+	}
+	old, succeed := s.store.RawCompareAndSwap(req.GetCf(), req.GetKey(), previous, req.GetValue(), req.GetTtl())
+	return &kvrpcpb.RawCompareAndSwapResponse{
+		Succeed:          succeed,
+		PreviousNotExist: old == nil,
+		PreviousValue:    old,
+	}, nil
+}
+
+// UnsafeDestroyRange implements tikvpb.TikvServer.
+func (s *Server) UnsafeDestroyRange(ctx context.Context, req *kvrpcpb.UnsafeDestroyRangeRequest) (*kvrpcpb.UnsafeDestroyRangeResponse, error) {
+	s.store.DestroyRange(req.GetStartKey(), req.GetEndKey())
+	return &kvrpcpb.UnsafeDestroyRangeResponse{}, nil
+}
+
+// Coprocessor implements tikvpb.TikvServer. It is not supported.
+func (s *Server) Coprocessor(ctx context.Context, req *coprocessor.Request) (*coprocessor.Response, error) {
+	return nil, status.Error(codes.Unimplemented, "mocktikv: coprocessor is not supported")
+}
+
+// CoprocessorStream implements tikvpb.TikvServer. It is not supported.
+func (s *Server) CoprocessorStream(req *coprocessor.Request, stream tikvpb.Tikv_CoprocessorStreamServer) error {
+	return status.Error(codes.Unimplemented, "mocktikv: coprocessor is not supported")
+}
+
+// Raft implements tikvpb.TikvServer. It is not supported.
+func (s *Server) Raft(stream tikvpb.Tikv_RaftServer) error {
+	return status.Error(codes.Unimplemented, "mocktikv: raft is not supported")
+}
+
+// BatchRaft implements tikvpb.TikvServer. It is not supported.
+func (s *Server) BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error {
+	return status.Error(codes.Unimplemented, "mocktikv: raft is not supported")
+}
+
+// Snapshot implements tikvpb.TikvServer. It is not supported.
+func (s *Server) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
+	return status.Error(codes.Unimplemented, "mocktikv: snapshot is not supported")
+}
+
+// SplitRegion implements tikvpb.TikvServer.
+func (s *Server) SplitRegion(ctx context.Context, req *kvrpcpb.SplitRegionRequest) (*kvrpcpb.SplitRegionResponse, error) {
+	splitKeys := req.GetSplitKeys()
+	if len(splitKeys) == 0 && len(req.GetSplitKey()) > 0 {
+		splitKeys = [][]byte{req.GetSplitKey()}
+	}
+	region, regionErr := s.checkKeys(req.GetContext(), splitKeys...)
+	if regionErr != nil {
+		return &kvrpcpb.SplitRegionResponse{RegionError: regionErr}, nil
+	}
+	if region == nil {
+		return &kvrpcpb.SplitRegionResponse{RegionError: &errorpb.Error{Message: "region id is required"}}, nil
+	}
+	regions, err := s.cluster.Split(region.GetId(), splitKeys)
+	if err != nil {
+		return &kvrpcpb.SplitRegionResponse{RegionError: &errorpb.Error{Message: err.Error()}}, nil
+	}
+	resp := &kvrpcpb.SplitRegionResponse{Regions: regions}
+	if len(regions) == 2 {
+		resp.Left, resp.Right = regions[0], regions[1]
+	}
+	return resp, nil
+}
+
+// ReadIndex implements tikvpb.TikvServer.
+func (s *Server) ReadIndex(ctx context.Context, req *kvrpcpb.ReadIndexRequest) (*kvrpcpb.ReadIndexResponse, error) {
+	if _, regionErr := s.checkContext(req.GetContext()); regionErr != nil {
+		return &kvrpcpb.ReadIndexResponse{RegionError: regionErr}, nil
+	}
+	return &kvrpcpb.ReadIndexResponse{}, nil
+}
+
+// GetRegionStats implements tikvpb.TikvServer.
+func (s *Server) GetRegionStats(ctx context.Context, req *kvrpcpb.GetRegionStatsRequest) (*kvrpcpb.GetRegionStatsResponse, error) {
+	startKey, endKey, regionErr := s.checkRange(req.GetContext(), req.GetRange().GetStartKey(), req.GetRange().GetEndKey())
+	if regionErr != nil {
+		return &kvrpcpb.GetRegionStatsResponse{RegionError: regionErr}, nil
+	}
+	size, keys, splitKeys := s.store.RangeStats(startKey, endKey, req.GetChunkSize())
+	return &kvrpcpb.GetRegionStatsResponse{
+		ApproximateSize: size,
+		ApproximateKeys: keys,
+		SplitKeys:       splitKeys,
+	}, nil
+}
+
+// MvccGetByKey implements tikvpb.TikvServer.
+func (s *Server) MvccGetByKey(ctx context.Context, req *kvrpcpb.MvccGetByKeyRequest) (*kvrpcpb.MvccGetByKeyResponse, error) {
+	if regionErr := s.checkKeysErr(req.GetContext(), req.GetKey()); regionErr != nil {
+		return &kvrpcpb.MvccGetByKeyResponse{RegionError: regionErr}, nil
+	}
+	return &kvrpcpb.MvccGetByKeyResponse{Info: s.store.MvccInfo(req.GetKey())}, nil
+}
+
+// MvccGetByStartTs implements tikvpb.TikvServer.
+func (s *Server) MvccGetByStartTs(ctx context.Context, req *kvrpcpb.MvccGetByStartTsRequest) (*kvrpcpb.MvccGetByStartTsResponse, error) {
+	if _, regionErr := s.checkContext(req.GetContext()); regionErr != nil {
+		return &kvrpcpb.MvccGetByStartTsResponse{RegionError: regionErr}, nil
+	}
+	key, info := s.store.MvccInfoByStartTS(req.GetStartTs())
+	return &kvrpcpb.MvccGetByStartTsResponse{Key: key, Info: info}, nil
+}
+
+// BatchCommands implements tikvpb.TikvServer. Requests are served
+// concurrently and each response is sent as soon as it is ready.
+func (s *Server) BatchCommands(stream tikvpb.Tikv_BatchCommandsServer) error {
+	ctx := stream.Context()
+	var (
+		mu      sync.Mutex
+		sendErr error
+		wg      sync.WaitGroup
+	)
+	defer wg.Wait()
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		for i, r := range req.GetRequests() {
+			if i >= len(req.GetRequestIds()) {
+				break
+			}
+			wg.Add(1)
+			go func(id uint64, r *tikvpb.BatchCommandsRequest_Request) {
+				defer wg.Done()
+				resp, err := s.handleBatchRequest(ctx, r)
+				if err != nil {
+					// Reply anyway so that the caller is not left waiting.
+					resp = &tikvpb.BatchCommandsResponse_Response{}
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				if sendErr != nil {
+					return
+				}
+				sendErr = stream.Send(&tikvpb.BatchCommandsResponse{
+					Responses:          []*tikvpb.BatchCommandsResponse_Response{resp},
+					RequestIds:         []uint64{id},
+					TransportLayerLoad: atomic.LoadUint64(&s.transportLayerLoad),
+				})
+			}(req.GetRequestIds()[i], r)
+		}
+	}
+}
+
+func (s *Server) handleBatchRequest(ctx context.Context, req *tikvpb.BatchCommandsRequest_Request) (*tikvpb.BatchCommandsResponse_Response, error) {
+	cmd, err := tikvpb.FromBatchRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var resp interface{}
+	switch r := cmd.(type) {
+	case *kvrpcpb.GetRequest:
+		resp, err = s.KvGet(ctx, r)
+	case *kvrpcpb.ScanRequest:
+		resp, err = s.KvScan(ctx, r)
+	case *kvrpcpb.PrewriteRequest:
+		resp, err = s.KvPrewrite(ctx, r)
+	case *kvrpcpb.CommitRequest:
+		resp, err = s.KvCommit(ctx, r)
+	case *kvrpcpb.ImportRequest:
+		resp, err = s.KvImport(ctx, r)
+	case *kvrpcpb.CleanupRequest:
+		resp, err = s.KvCleanup(ctx, r)
+	case *kvrpcpb.BatchGetRequest:
+		resp, err = s.KvBatchGet(ctx, r)
+	case *kvrpcpb.BatchRollbackRequest:
+		resp, err = s.KvBatchRollback(ctx, r)
+	case *kvrpcpb.ScanLockRequest:
+		resp, err = s.KvScanLock(ctx, r)
+	case *kvrpcpb.ResolveLockRequest:
+		resp, err = s.KvResolveLock(ctx, r)
+	case *kvrpcpb.GCRequest:
+		resp, err = s.KvGC(ctx, r)
+	case *kvrpcpb.DeleteRangeRequest:
+		resp, err = s.KvDeleteRange(ctx, r)
+	case *kvrpcpb.RawGetRequest:
+		resp, err = s.RawGet(ctx, r)
+	case *kvrpcpb.RawBatchGetRequest:
+		resp, err = s.RawBatchGet(ctx, r)
+	case *kvrpcpb.RawPutRequest:
+		resp, err = s.RawPut(ctx, r)
+	case *kvrpcpb.RawBatchPutRequest:
+		resp, err = s.RawBatchPut(ctx, r)
+	case *kvrpcpb.RawDeleteRequest:
+		resp, err = s.RawDelete(ctx, r)
+	case *kvrpcpb.RawBatchDeleteRequest:
+		resp, err = s.RawBatchDelete(ctx, r)
+	case *kvrpcpb.RawScanRequest:
+		resp, err = s.RawScan(ctx, r)
+	case *kvrpcpb.RawDeleteRangeRequest:
+		resp, err = s.RawDeleteRange(ctx, r)
+	case *kvrpcpb.RawBatchScanRequest:
+		resp, err = s.RawBatchScan(ctx, r)
+	case *coprocessor.Request:
+		resp, err = s.Coprocessor(ctx, r)
+	case *kvrpcpb.PessimisticLockRequest:
+		resp, err = s.KvPessimisticLock(ctx, r)
+	case *kvrpcpb.PessimisticRollbackRequest:
+		resp, err = s.KVPessimisticRollback(ctx, r)
+	case *kvrpcpb.CheckTxnStatusRequest:
+		resp, err = s.KvCheckTxnStatus(ctx, r)
+	case *kvrpcpb.TxnHeartBeatRequest:
+		resp, err = s.KvTxnHeartBeat(ctx, r)
+	case *kvrpcpb.RawCompareAndSwapRequest:
+		resp, err = s.RawCompareAndSwap(ctx, r)
+	case *kvrpcpb.RawGetKeyTTLRequest:
+		resp, err = s.RawGetKeyTTL(ctx, r)
+	case *kvrpcpb.ChecksumRequest:
+		resp, err = s.Checksum(ctx, r)
+	case *kvrpcpb.GetRegionStatsRequest:
+		resp, err = s.GetRegionStats(ctx, r)
+	case *tikvpb.BatchCommandsEmptyRequest:
+		if delay := time.Duration(r.GetDelayTime()) * time.Millisecond; delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		resp = &tikvpb.BatchCommandsEmptyResponse{TestId: r.GetTestId()}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tikvpb.ToBatchResponse(resp)
+}
@@ -0,0 +1,459 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/checksum"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T, server *Server) (tikvpb.TikvClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	tikvpb.RegisterTikvServer(s, server)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tikvpb.NewTikvClient(conn), func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+// newUnroutedServer returns a Server on a one-region cluster which serves
+// requests without a region, for the tests that skip the routing.
+func newUnroutedServer() *Server {
+	server := NewServer(1, NewMemCluster(1), NewMVCCStore())
+	server.SetAllowNoRegion(true)
+	return server
+}
+
+func TestTxnReadWrite(t *testing.T) {
+	client, cleanup := newTestClient(t, newUnroutedServer())
+	defer cleanup()
+	ctx := context.Background()
+
+	prewrite, err := client.KvPrewrite(ctx, &kvrpcpb.PrewriteRequest{
+		Mutations: []*kvrpcpb.Mutation{
+			{Op: kvrpcpb.Op_Put, Key: []byte("a"), Value: []byte("1")},
+			{Op: kvrpcpb.Op_Put, Key: []byte("b"), Value: []byte("2")},
+		},
+		PrimaryLock:  []byte("a"),
+		StartVersion: 10,
+		LockTtl:      3000,
+	})
+	if err != nil || len(prewrite.GetErrors()) > 0 {
+		t.Fatal(err, prewrite.GetErrors())
+	}
+
+	get, err := client.KvGet(ctx, &kvrpcpb.GetRequest{Key: []byte("a"), Version: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lock := get.GetError().GetLocked(); lock == nil || lock.GetLockVersion() != 10 || !bytes.Equal(lock.GetPrimaryLock(), []byte("a")) {
+		t.Fatalf("expect locked error, got %v", get)
+	}
+
+	commit, err := client.KvCommit(ctx, &kvrpcpb.CommitRequest{
+		StartVersion:  10,
+		Keys:          [][]byte{[]byte("a"), []byte("b")},
+		CommitVersion: 15,
+	})
+	if err != nil || commit.GetError() != nil {
+		t.Fatal(err, commit.GetError())
+	}
+
+	get, err = client.KvGet(ctx, &kvrpcpb.GetRequest{Key: []byte("a"), Version: 14})
+	if err != nil || !get.GetNotFound() {
+		t.Fatalf("expect not found before commit ts, got %v %v", get, err)
+	}
+	get, err = client.KvGet(ctx, &kvrpcpb.GetRequest{Key: []byte("a"), Version: 20})
+	if err != nil || string(get.GetValue()) != "1" {
+		t.Fatalf("expect value 1, got %v %v", get, err)
+	}
+
+	scan, err := client.KvScan(ctx, &kvrpcpb.ScanRequest{Limit: 10, Version: 20})
+	if err != nil || len(scan.GetPairs()) != 2 || string(scan.GetPairs()[1].GetKey()) != "b" {
+		t.Fatalf("unexpected scan result %v %v", scan, err)
+	}
+	scan, err = client.KvScan(ctx, &kvrpcpb.ScanRequest{Limit: 10, Version: 20, Reverse: true, StartKey: []byte("b")})
+	if err != nil || len(scan.GetPairs()) != 1 || string(scan.GetPairs()[0].GetKey()) != "a" {
+		t.Fatalf("unexpected reverse scan result %v %v", scan, err)
+	}
+
+	var expect checksum.Checksum
+	expect.Update([]byte("a"), []byte("1"))
+	expect.Update([]byte("b"), []byte("2"))
+	sum, err := client.Checksum(ctx, &kvrpcpb.ChecksumRequest{Version: 20, Range: &kvrpcpb.KeyRange{}})
+	if err != nil || sum.GetCrc64Xor() != expect.Crc64Xor || sum.GetTotalKvs() != 2 || sum.GetTotalBytes() != 4 {
+		t.Fatalf("unexpected checksum %v %v", sum, err)
+	}
+
+	stats, err := client.GetRegionStats(ctx, &kvrpcpb.GetRegionStatsRequest{Range: &kvrpcpb.KeyRange{}, ChunkSize: 1})
+	if err != nil || stats.GetApproximateSize() != 4 || stats.GetApproximateKeys() != 2 || len(stats.GetSplitKeys()) != 1 {
+		t.Fatalf("unexpected stats %v %v", stats, err)
+	}
+	// A key is counted once in every source holding it, but split on once.
+	for _, cf := range []string{"", "write"} {
+		if _, err = client.RawPut(ctx, &kvrpcpb.RawPutRequest{Key: []byte("a"), Value: []byte("x"), Cf: cf}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stats, err = client.GetRegionStats(ctx, &kvrpcpb.GetRegionStatsRequest{Range: &kvrpcpb.KeyRange{}, ChunkSize: 1})
+	if err != nil || stats.GetApproximateSize() != 8 || stats.GetApproximateKeys() != 4 || len(stats.GetSplitKeys()) != 1 {
+		t.Fatalf("unexpected stats %v %v", stats, err)
+	}
+
+	// A transaction started before the commit conflicts with it.
+	prewrite, err = client.KvPrewrite(ctx, &kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Put, Key: []byte("a"), Value: []byte("3")}},
+		PrimaryLock:  []byte("a"),
+		StartVersion: 12,
+	})
+	if err != nil || len(prewrite.GetErrors()) != 1 || prewrite.GetErrors()[0].GetConflict().GetConflictCommitTs() != 15 {
+		t.Fatalf("expect write conflict, got %v %v", prewrite, err)
+	}
+
+	prewrite, err = client.KvPrewrite(ctx, &kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Insert, Key: []byte("a"), Value: []byte("3")}},
+		PrimaryLock:  []byte("a"),
+		StartVersion: 30,
+	})
+	if err != nil || len(prewrite.GetErrors()) != 1 || prewrite.GetErrors()[0].GetAlreadyExist() == nil {
+		t.Fatalf("expect already exist, got %v %v", prewrite, err)
+	}
+}
+
+func TestTxnRollbackAndResolve(t *testing.T) {
+	client, cleanup := newTestClient(t, newUnroutedServer())
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, startTS := range []uint64{10, 20} {
+		key := []byte{byte('a' + startTS/10)}
+		prewrite, err := client.KvPrewrite(ctx, &kvrpcpb.PrewriteRequest{
+			Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Put, Key: key, Value: key}},
+			PrimaryLock:  key,
+			StartVersion: startTS,
+		})
+		if err != nil || len(prewrite.GetErrors()) > 0 {
+			t.Fatal(err, prewrite.GetErrors())
+		}
+	}
+
+	locks, err := client.KvScanLock(ctx, &kvrpcpb.ScanLockRequest{MaxVersion: 100})
+	if err != nil || len(locks.GetLocks()) != 2 {
+		t.Fatalf("expect 2 locks, got %v %v", locks, err)
+	}
+
+	resolve, err := client.KvResolveLock(ctx, &kvrpcpb.ResolveLockRequest{
+		TxnInfos: []*kvrpcpb.TxnInfo{{Txn: 10, Status: 0}, {Txn: 20, Status: 25}},
+	})
+	if err != nil || resolve.GetError() != nil {
+		t.Fatal(err, resolve.GetError())
+	}
+	get, err := client.KvGet(ctx, &kvrpcpb.GetRequest{Key: []byte("b"), Version: 30})
+	if err != nil || !get.GetNotFound() {
+		t.Fatalf("expect rolled back value to be gone, got %v %v", get, err)
+	}
+	get, err = client.KvGet(ctx, &kvrpcpb.GetRequest{Key: []byte("c"), Version: 30})
+	if err != nil || string(get.GetValue()) != "c" {
+		t.Fatalf("expect committed value, got %v %v", get, err)
+	}
+
+	// A rolled back transaction cannot be committed.
+	commit, err := client.KvCommit(ctx, &kvrpcpb.CommitRequest{StartVersion: 10, Keys: [][]byte{[]byte("b")}, CommitVersion: 30})
+	if err != nil || commit.GetError().GetAbort() == "" {
+		t.Fatalf("expect abort, got %v %v", commit, err)
+	}
+
+	rollback, err := client.KvBatchRollback(ctx, &kvrpcpb.BatchRollbackRequest{StartVersion: 20, Keys: [][]byte{[]byte("c")}})
+	if err != nil || rollback.GetError().GetAbort() == "" {
+		t.Fatalf("expect rollback of committed txn to fail, got %v %v", rollback, err)
+	}
+
+	cleanupResp, err := client.KvCleanup(ctx, &kvrpcpb.CleanupRequest{Key: []byte("c"), StartVersion: 20})
+	if err != nil || cleanupResp.GetCommitVersion() != 25 {
+		t.Fatalf("expect commit version 25, got %v %v", cleanupResp, err)
+	}
+
+	if _, err = client.KvGC(ctx, &kvrpcpb.GCRequest{SafePoint: 100}); err != nil {
+		t.Fatal(err)
+	}
+	get, err = client.KvGet(ctx, &kvrpcpb.GetRequest{Key: []byte("c"), Version: 100})
+	if err != nil || string(get.GetValue()) != "c" {
+		t.Fatalf("expect value to survive gc, got %v %v", get, err)
+	}
+}
+
+func TestRawKV(t *testing.T) {
+	client, cleanup := newTestClient(t, newUnroutedServer())
+	defer cleanup()
+	ctx := context.Background()
+
+	var pairs []*kvrpcpb.KvPair
+	for _, k := range []string{"a", "b", "c", "d"} {
+		pairs = append(pairs, &kvrpcpb.KvPair{Key: []byte(k), Value: []byte(k + k)})
+	}
+	if _, err := client.RawBatchPut(ctx, &kvrpcpb.RawBatchPutRequest{Pairs: pairs}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RawPut(ctx, &kvrpcpb.RawPutRequest{Key: []byte("a"), Value: []byte("x"), Cf: "write"}); err != nil {
+		t.Fatal(err)
+	}
+
+	get, err := client.RawGet(ctx, &kvrpcpb.RawGetRequest{Key: []byte("a")})
+	if err != nil || string(get.GetValue()) != "aa" {
+		t.Fatalf("unexpected get %v %v", get, err)
+	}
+	get, err = client.RawGet(ctx, &kvrpcpb.RawGetRequest{Key: []byte("b"), Cf: "write"})
+	if err != nil || !get.GetNotFound() {
+		t.Fatalf("expect not found in another cf, got %v %v", get, err)
+	}
+
+	scan, err := client.RawScan(ctx, &kvrpcpb.RawScanRequest{StartKey: []byte("b"), EndKey: []byte("d"), Limit: 10})
+	if err != nil || len(scan.GetKvs()) != 2 || string(scan.GetKvs()[0].GetKey()) != "b" {
+		t.Fatalf("unexpected scan %v %v", scan, err)
+	}
+	// Reverse scans read [end_key, start_key) backwards.
+	scan, err = client.RawScan(ctx, &kvrpcpb.RawScanRequest{StartKey: []byte("d"), EndKey: []byte("a"), Limit: 10, Reverse: true, KeyOnly: true})
+	if err != nil || len(scan.GetKvs()) != 3 || string(scan.GetKvs()[0].GetKey()) != "c" || scan.GetKvs()[0].GetValue() != nil {
+		t.Fatalf("unexpected reverse scan %v %v", scan, err)
+	}
+
+	if _, err = client.RawDeleteRange(ctx, &kvrpcpb.RawDeleteRangeRequest{StartKey: []byte("b"), EndKey: []byte("d")}); err != nil {
+		t.Fatal(err)
+	}
+	batchGet, err := client.RawBatchGet(ctx, &kvrpcpb.RawBatchGetRequest{Keys: [][]byte{[]byte("a"), []byte("b"), []byte("d")}})
+	if err != nil || len(batchGet.GetPairs()) != 2 {
+		t.Fatalf("unexpected batch get %v %v", batchGet, err)
+	}
+
+	cas, err := client.RawCompareAndSwap(ctx, &kvrpcpb.RawCompareAndSwapRequest{Key: []byte("a"), Value: []byte("y"), PreviousValue: []byte("x")})
+	if err != nil || cas.GetSucceed() || string(cas.GetPreviousValue()) != "aa" {
+		t.Fatalf("unexpected cas %v %v", cas, err)
+	}
+	cas, err = client.RawCompareAndSwap(ctx, &kvrpcpb.RawCompareAndSwapRequest{Key: []byte("a"), Value: []byte("y"), PreviousValue: []byte("aa")})
+	if err != nil || !cas.GetSucceed() {
+		t.Fatalf("unexpected cas %v %v", cas, err)
+	}
+	cas, err = client.RawCompareAndSwap(ctx, &kvrpcpb.RawCompareAndSwapRequest{Key: []byte("b"), Value: []byte("z"), PreviousNotExist: true})
+	if err != nil || !cas.GetSucceed() || !cas.GetPreviousNotExist() {
+		t.Fatalf("unexpected cas %v %v", cas, err)
+	}
+	batchGet, err = client.RawBatchGet(ctx, &kvrpcpb.RawBatchGetRequest{Keys: [][]byte{[]byte("a"), []byte("b")}})
+	if err != nil || string(batchGet.GetPairs()[0].GetValue()) != "y" || string(batchGet.GetPairs()[1].GetValue()) != "z" {
+		t.Fatalf("unexpected batch get %v %v", batchGet, err)
+	}
+}
+
+func TestRegionError(t *testing.T) {
+	cluster := NewMemCluster(1)
+	store := NewMVCCStore()
+	client, cleanup := newTestClient(t, NewServer(1, cluster, store))
+	defer cleanup()
+	ctx := context.Background()
+
+	// A request without a region is rejected.
+	resp, err := client.RawGet(ctx, &kvrpcpb.RawGetRequest{Key: []byte("a")})
+	if err != nil || resp.GetRegionError().GetRegionNotFound() == nil {
+		t.Fatalf("expect region not found, got %v %v", resp, err)
+	}
+
+	region := cluster.Regions()[0]
+	regions, err := cluster.Split(region.GetId(), [][]byte{[]byte("m")})
+	if err != nil || len(regions) != 2 {
+		t.Fatal(err, regions)
+	}
+
+	// Stale epoch.
+	resp, err = client.RawGet(ctx, &kvrpcpb.RawGetRequest{
+		Context: &kvrpcpb.Context{RegionId: region.GetId(), RegionEpoch: region.GetRegionEpoch()},
+		Key:     []byte("z"),
+	})
+	if err != nil || resp.GetRegionError().GetEpochNotMatch() == nil {
+		t.Fatalf("expect epoch not match, got %v %v", resp, err)
+	}
+
+	right := regions[1]
+	resp, err = client.RawGet(ctx, &kvrpcpb.RawGetRequest{
+		Context: &kvrpcpb.Context{RegionId: right.GetId(), RegionEpoch: right.GetRegionEpoch()},
+		Key:     []byte("a"),
+	})
+	if err != nil || resp.GetRegionError().GetKeyNotInRegion() == nil {
+		t.Fatalf("expect key not in region, got %v %v", resp, err)
+	}
+
+	resp, err = client.RawGet(ctx, &kvrpcpb.RawGetRequest{
+		Context: &kvrpcpb.Context{RegionId: 100},
+		Key:     []byte("a"),
+	})
+	if err != nil || resp.GetRegionError().GetRegionNotFound() == nil {
+		t.Fatalf("expect region not found, got %v %v", resp, err)
+	}
+
+	resp, err = client.RawGet(ctx, &kvrpcpb.RawGetRequest{
+		Context: &kvrpcpb.Context{RegionId: right.GetId(), Peer: &metapb.Peer{StoreId: 2}},
+		Key:     []byte("z"),
+	})
+	if err != nil || resp.GetRegionError().GetStoreNotMatch() == nil {
+		t.Fatalf("expect store not match, got %v %v", resp, err)
+	}
+
+	// A scan stops at the end of the region.
+	for _, k := range []string{"a", "n"} {
+		store.RawPut("", []byte(k), []byte(k), 0)
+	}
+	scan, err := client.RawScan(ctx, &kvrpcpb.RawScanRequest{
+		Context: &kvrpcpb.Context{RegionId: regions[0].GetId(), RegionEpoch: regions[0].GetRegionEpoch()},
+		Limit:   10,
+	})
+	if err != nil || len(scan.GetKvs()) != 1 {
+		t.Fatalf("expect scan to stop at region end, got %v %v", scan, err)
+	}
+}
+
+func TestScanStream(t *testing.T) {
+	cluster := NewMemCluster(1)
+	store := NewMVCCStore()
+	client, cleanup := newTestClient(t, NewServer(1, cluster, store))
+	defer cleanup()
+	ctx := context.Background()
+
+	regions, err := cluster.Split(cluster.Regions()[0].GetId(), [][]byte{[]byte("m")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	left, right := regions[0], regions[1]
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("a%03d", i))
+		store.RawPut("", key, key, 0)
+	}
+	store.RawPut("", []byte("n"), []byte("n"), 0)
+
+	// recv returns the chunk sizes and the last response of a stream.
+	recv := func(req *kvrpcpb.RawScanRequest) ([]int, *kvrpcpb.RawScanStreamResponse) {
+		stream, err := client.RawScanStream(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var (
+			sizes []int
+			last  *kvrpcpb.RawScanStreamResponse
+		)
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return sizes, last
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			sizes, last = append(sizes, len(resp.GetKvs())), resp
+		}
+	}
+	regionCtx := func(region *metapb.Region) *kvrpcpb.Context {
+		return &kvrpcpb.Context{RegionId: region.GetId(), RegionEpoch: region.GetRegionEpoch()}
+	}
+
+	sizes, last := recv(&kvrpcpb.RawScanRequest{Context: regionCtx(left)})
+	if fmt.Sprint(sizes) != "[64 36 0]" || last.GetRegionError().GetKeyNotInRegion() == nil || string(last.GetResumeKey()) != "m" {
+		t.Fatalf("unexpected stream %v %v", sizes, last)
+	}
+	sizes, last = recv(&kvrpcpb.RawScanRequest{Context: regionCtx(left), StartKey: []byte("a010"), EndKey: []byte("a020")})
+	if fmt.Sprint(sizes) != "[10]" || last.GetRegionError() != nil || string(last.GetResumeKey()) != "a019\x00" {
+		t.Fatalf("unexpected stream %v %v", sizes, last)
+	}
+	sizes, last = recv(&kvrpcpb.RawScanRequest{Context: regionCtx(left), Limit: 70})
+	if fmt.Sprint(sizes) != "[64 6]" || last.GetRegionError() != nil {
+		t.Fatalf("unexpected stream %v %v", sizes, last)
+	}
+	sizes, last = recv(&kvrpcpb.RawScanRequest{Context: regionCtx(right), Reverse: true, KeyOnly: true})
+	if fmt.Sprint(sizes) != "[1 0]" || string(last.GetResumeKey()) != "m" || last.GetRegionError().GetKeyNotInRegion() == nil {
+		t.Fatalf("unexpected reverse stream %v %v", sizes, last)
+	}
+	sizes, last = recv(&kvrpcpb.RawScanRequest{Context: regionCtx(right), StartKey: []byte("a")})
+	if len(sizes) != 1 || last.GetRegionError().GetKeyNotInRegion() == nil || last.GetResumeKey() != nil {
+		t.Fatalf("expect key not in region, got %v %v", sizes, last)
+	}
+
+	// Locks are streamed as key errors.
+	errs := store.Prewrite(&kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Put, Key: []byte("b"), Value: []byte("b")}},
+		PrimaryLock:  []byte("b"),
+		StartVersion: 10,
+	})
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	stream, err := client.KvScanStream(ctx, &kvrpcpb.ScanRequest{Context: regionCtx(left), Version: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil || len(resp.GetPairs()) != 1 || resp.GetPairs()[0].GetError().GetLocked() == nil || string(resp.GetResumeKey()) != "b\x00" {
+		t.Fatalf("expect a locked pair, got %v %v", resp, err)
+	}
+	if resp, err = stream.Recv(); err != nil || string(resp.GetResumeKey()) != "m" {
+		t.Fatalf("expect the region end, got %v %v", resp, err)
+	}
+}
+
+func TestBatchCommands(t *testing.T) {
+	client, cleanup := newTestClient(t, newUnroutedServer())
+	defer cleanup()
+
+	stream, err := client.BatchCommands(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Send(&tikvpb.BatchCommandsRequest{
+		Requests: []*tikvpb.BatchCommandsRequest_Request{
+			{Cmd: &tikvpb.BatchCommandsRequest_Request_Empty{Empty: &tikvpb.BatchCommandsEmptyRequest{TestId: 7, DelayTime: 50}}},
+			{Cmd: &tikvpb.BatchCommandsRequest_Request_RawPut{RawPut: &kvrpcpb.RawPutRequest{Key: []byte("a"), Value: []byte("b")}}},
+		},
+		RequestIds: []uint64{1, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The delayed request must not block the other one.
+	var ids []uint64
+	for i := 0; i < 2; i++ {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, resp.GetRequestIds()...)
+	}
+	if len(ids) != 2 || ids[0] != 2 || ids[1] != 1 {
+		t.Fatalf("unexpected response order %v", ids)
+	}
+}
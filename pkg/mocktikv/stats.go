@@ -0,0 +1,71 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocktikv
+
+import (
+	"sort"
+
+	"github.com/google/btree"
+)
+
+// RangeStats estimates the data in [startKey, endKey). The size counts the
+// key and value of every version of a transactional key, of its lock, and
+// of every raw key in any column family. keys counts each source apart, the
+// transactional keys and the raw keys of every column family, so a key is
+// counted once in each source holding it. If chunkSize is not 0, splitKeys
+// divides the range into chunks of about chunkSize bytes each, with the
+// size of a key summed over the sources.
+func (s *MVCCStore) RangeStats(startKey, endKey []byte, chunkSize uint64) (size, keys uint64, splitKeys [][]byte) {
+	s.RLock()
+	defer s.RUnlock()
+	sizes := make(map[string]uint64)
+	s.ascend(startKey, endKey, func(e *mvccEntry) bool {
+		n := uint64(0)
+		for _, w := range e.writes {
+			n += uint64(len(e.key) + len(w.value))
+		}
+		if e.lock != nil {
+			n += uint64(len(e.key) + len(e.lock.value))
+		}
+		sizes[string(e.key)] += n
+		keys++
+		return true
+	})
+	now := s.now()
+	for _, tree := range s.raw {
+		ascendRange(tree, &rawEntry{key: startKey}, rawEnd(endKey), func(item btree.Item) bool {
+			if e := item.(*rawEntry); !e.expired(now) {
+				sizes[string(e.key)] += uint64(len(e.key) + len(e.value))
+				keys++
+			}
+			return true
+		})
+	}
+
+	sorted := make([]string, 0, len(sizes))
+	for key := range sizes {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	var chunk uint64
+	for _, key := range sorted {
+		if chunkSize > 0 && chunk >= chunkSize {
+			splitKeys = append(splitKeys, []byte(key))
+			chunk = 0
+		}
+		chunk += sizes[key]
+		size += sizes[key]
+	}
+	return size, keys, splitKeys
+}
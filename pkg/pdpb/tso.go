@@ -0,0 +1,92 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdpb
+
+import "time"
+
+// A TSO timestamp is packed into the uint64 versions used by kvrpcpb, such
+// as start_version, commit_version, lock_ts and safe_point, as
+//
+//	physical<<PhysicalShiftBits + logical
+//
+// where physical is a Unix time in milliseconds.
+const (
+	// PhysicalShiftBits is the number of bits of the logical part.
+	PhysicalShiftBits = 18
+	// MaxLogical is the number of logical timestamps per millisecond.
+	MaxLogical = 1 << PhysicalShiftBits
+
+	logicalMask = MaxLogical - 1
+)
+
+// ComposeTS packs a physical and a logical part into a timestamp.
+func ComposeTS(physical, logical int64) uint64 {
+	return uint64(physical)<<PhysicalShiftBits + uint64(logical)
+}
+
+// ExtractPhysical returns the physical part of ts in milliseconds.
+func ExtractPhysical(ts uint64) int64 {
+	return int64(ts >> PhysicalShiftBits)
+}
+
+// ExtractLogical returns the logical part of ts.
+func ExtractLogical(ts uint64) int64 {
+	return int64(ts & logicalMask)
+}
+
+// NewTimestamp unpacks ts.
+func NewTimestamp(ts uint64) *Timestamp {
+	return &Timestamp{Physical: ExtractPhysical(ts), Logical: ExtractLogical(ts)}
+}
+
+// Uint64 returns the packed form of m.
+func (m *Timestamp) Uint64() uint64 {
+	return ComposeTS(m.GetPhysical(), m.GetLogical())
+}
+
+// Time returns the physical part of m as a time.
+func (m *Timestamp) Time() time.Time {
+	return physicalToTime(m.GetPhysical())
+}
+
+// TimeFromTS returns the physical part of ts as a time.
+func TimeFromTS(ts uint64) time.Time {
+	return physicalToTime(ExtractPhysical(ts))
+}
+
+// TSFromTime returns the first timestamp of the millisecond t falls in.
+func TSFromTime(t time.Time) uint64 {
+	return ComposeTS(t.UnixNano()/int64(time.Millisecond), 0)
+}
+
+// TSBefore returns the first timestamp of the millisecond d before ts, or 0
+// if that is before the epoch. It is typically used to derive a GC safe
+// point or a stale read timestamp from a fresh timestamp.
+func TSBefore(ts uint64, d time.Duration) uint64 {
+	physical := ExtractPhysical(ts) - int64(d/time.Millisecond)
+	if physical < 0 {
+		return 0
+	}
+	return ComposeTS(physical, 0)
+}
+
+// TSAgo returns the timestamp of d before now by the local clock. Unlike
+// TSBefore on a timestamp from PD, it is only as accurate as the local clock.
+func TSAgo(d time.Duration) uint64 {
+	return TSBefore(TSFromTime(time.Now()), d)
+}
+
+func physicalToTime(physical int64) time.Time {
+	return time.Unix(0, physical*int64(time.Millisecond))
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdpb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComposeTS(t *testing.T) {
+	cases := []struct {
+		physical, logical int64
+		ts                uint64
+	}{
+		{0, 0, 0},
+		{0, 1, 1},
+		{1, 0, 1 << 18},
+		{1, MaxLogical - 1, 1<<19 - 1},
+		{1565000000000, 42, 1565000000000<<18 + 42},
+	}
+	for _, c := range cases {
+		if ts := ComposeTS(c.physical, c.logical); ts != c.ts {
+			t.Fatalf("compose %d %d: expect %d, got %d", c.physical, c.logical, c.ts, ts)
+		}
+		if ExtractPhysical(c.ts) != c.physical || ExtractLogical(c.ts) != c.logical {
+			t.Fatalf("extract %d: got %d %d", c.ts, ExtractPhysical(c.ts), ExtractLogical(c.ts))
+		}
+		tso := NewTimestamp(c.ts)
+		if tso.GetPhysical() != c.physical || tso.GetLogical() != c.logical || tso.Uint64() != c.ts {
+			t.Fatalf("timestamp %d does not round trip: %v", c.ts, tso)
+		}
+	}
+}
+
+func TestTimeConversion(t *testing.T) {
+	now := time.Unix(1565000000, 123456789)
+	ts := TSFromTime(now)
+	if ExtractLogical(ts) != 0 || ExtractPhysical(ts) != 1565000000123 {
+		t.Fatalf("unexpected ts %d", ts)
+	}
+	if got := TimeFromTS(ts + 5); !got.Equal(now.Truncate(time.Millisecond)) {
+		t.Fatalf("expect %v, got %v", now.Truncate(time.Millisecond), got)
+	}
+	if got := NewTimestamp(ts).Time(); !got.Equal(TimeFromTS(ts)) {
+		t.Fatalf("expect %v, got %v", TimeFromTS(ts), got)
+	}
+
+	before := TSBefore(ts+5, 10*time.Minute)
+	if d := TimeFromTS(ts).Sub(TimeFromTS(before)); d != 10*time.Minute || ExtractLogical(before) != 0 {
+		t.Fatalf("unexpected ts before: %d, %v", before, d)
+	}
+	if TSBefore(ComposeTS(1000, 0), 2*time.Second) != 0 {
+		t.Fatal("expect 0 before the epoch")
+	}
+	if ago := TimeFromTS(TSAgo(time.Hour)); time.Since(ago) < time.Hour || time.Since(ago) > time.Hour+time.Minute {
+		t.Fatalf("unexpected ts an hour ago: %v", ago)
+	}
+}
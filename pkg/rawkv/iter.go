@@ -0,0 +1,115 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rawkv
+
+import (
+	"context"
+	"io"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// Iterator walks the pairs of a scan with RawScanStream. It must be closed
+// unless Next returned false.
+//
+//	it := client.Iter(ctx, startKey, endKey, opts)
+//	defer it.Close()
+//	for it.Next() {
+//		use(it.Key(), it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	scanner *regioncache.StreamScanner
+	pairs   []*kvrpcpb.KvPair
+	pair    *kvrpcpb.KvPair
+	err     error
+}
+
+// Iter returns an iterator over all pairs between startKey and endKey, with
+// the keys and options of Scan.
+func (c *Client) Iter(ctx context.Context, startKey, endKey []byte, opts ScanOptions) *Iterator {
+	open := func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, startKey []byte) (regioncache.RecvFunc, error) {
+		stream, err := client.RawScanStream(ctx, &kvrpcpb.RawScanRequest{
+			Context:  region.Context(),
+			StartKey: startKey,
+			EndKey:   endKey,
+			KeyOnly:  opts.KeyOnly,
+			Cf:       c.cf,
+			Reverse:  opts.Reverse,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return func() (*regioncache.StreamChunk, error) {
+			resp, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return &regioncache.StreamChunk{
+				RegionError: resp.GetRegionError(),
+				Pairs:       resp.GetKvs(),
+				ResumeKey:   resp.GetResumeKey(),
+			}, nil
+		}, nil
+	}
+	it := &Iterator{scanner: c.sender.NewStreamScanner(ctx, startKey, opts.Reverse, open)}
+	if newKeyRange(startKey, endKey, opts.Reverse).empty() {
+		it.scanner.Close()
+	}
+	return it
+}
+
+// Next moves to the next pair, and returns false at the end of the scan or
+// on an error.
+func (it *Iterator) Next() bool {
+	for len(it.pairs) == 0 {
+		pairs, err := it.scanner.Next()
+		if err != nil {
+			if err != io.EOF {
+				it.err = err
+			}
+			it.pair = nil
+			it.scanner.Close()
+			return false
+		}
+		it.pairs = pairs
+	}
+	it.pair, it.pairs = it.pairs[0], it.pairs[1:]
+	return true
+}
+
+// Key returns the key of the current pair.
+func (it *Iterator) Key() []byte {
+	return it.pair.GetKey()
+}
+
+// Value returns the value of the current pair.
+func (it *Iterator) Value() []byte {
+	return it.pair.GetValue()
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the scan.
+func (it *Iterator) Close() {
+	it.scanner.Close()
+	it.pairs, it.pair = nil, nil
+}
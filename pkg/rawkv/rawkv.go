@@ -0,0 +1,275 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rawkv is a small reference client for the raw key-value API of
+// TiKV. Batch requests are split by region and sent concurrently, and their
+// results are merged back in key order.
+package rawkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// Client reads and writes the raw keys of a column family.
+type Client struct {
+	sender *regioncache.Sender
+	cf     string
+}
+
+// NewClient creates a Client on the default column family.
+func NewClient(sender *regioncache.Sender) *Client {
+	return &Client{sender: sender}
+}
+
+// WithCF returns a client on the column family cf, sharing the sender of c.
+// An empty cf is the default column family.
+func (c *Client) WithCF(cf string) *Client {
+	return &Client{sender: c.sender, cf: cf}
+}
+
+// CF returns the column family of the client.
+func (c *Client) CF() string {
+	return c.cf
+}
+
+// rawError converts the error string of a raw response.
+func rawError(msg string) error {
+	if msg == "" {
+		return nil
+	}
+	return errors.New("rawkv: " + msg)
+}
+
+// sortPairs sorts pairs by key, in descending order if desc is set.
+func sortPairs(pairs []*kvrpcpb.KvPair, desc bool) {
+	sort.Slice(pairs, func(i, j int) bool {
+		return (bytes.Compare(pairs[i].GetKey(), pairs[j].GetKey()) < 0) != desc
+	})
+}
+
+// Get returns the value of key, or nil if it does not exist.
+func (c *Client) Get(ctx context.Context, key []byte) ([]byte, error) {
+	var value []byte
+	err := c.sender.SendKey(ctx, key, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.RawGet(ctx, &kvrpcpb.RawGetRequest{
+			Context: region.Context(),
+			Key:     key,
+			Cf:      c.cf,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		if !resp.GetNotFound() {
+			value = resp.GetValue()
+		}
+		return nil, rawError(resp.GetError())
+	})
+	return value, err
+}
+
+// BatchGet returns the pairs of the keys that exist, in key order.
+func (c *Client) BatchGet(ctx context.Context, keys [][]byte) ([]*kvrpcpb.KvPair, error) {
+	var (
+		mu    sync.Mutex
+		pairs []*kvrpcpb.KvPair
+	)
+	err := c.sender.SendKeys(ctx, regioncache.DedupKeys(keys), func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		resp, err := client.RawBatchGet(ctx, &kvrpcpb.RawBatchGetRequest{
+			Context: region.Context(),
+			Keys:    keys,
+			Cf:      c.cf,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		mu.Lock()
+		pairs = append(pairs, resp.GetPairs()...)
+		mu.Unlock()
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortPairs(pairs, false)
+	return pairs, nil
+}
+
+// GetKeyTTL returns the seconds until key expires, rounded up, or 0 if it
+// never expires. ok is false if key does not exist or has expired.
+func (c *Client) GetKeyTTL(ctx context.Context, key []byte) (ttl uint64, ok bool, err error) {
+	err = c.sender.SendKey(ctx, key, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.RawGetKeyTTL(ctx, &kvrpcpb.RawGetKeyTTLRequest{
+			Context: region.Context(),
+			Key:     key,
+			Cf:      c.cf,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		ttl, ok = resp.GetTtl(), !resp.GetNotFound()
+		return nil, rawError(resp.GetError())
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return ttl, ok, nil
+}
+
+// Put sets key to value.
+func (c *Client) Put(ctx context.Context, key, value []byte) error {
+	return c.PutWithTTL(ctx, key, value, 0)
+}
+
+// PutWithTTL sets key to value, which expires after ttl seconds unless ttl
+// is 0. An expired key reads as not found.
+func (c *Client) PutWithTTL(ctx context.Context, key, value []byte, ttl uint64) error {
+	return c.sender.SendKey(ctx, key, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.RawPut(ctx, &kvrpcpb.RawPutRequest{
+			Context: region.Context(),
+			Key:     key,
+			Value:   value,
+			Cf:      c.cf,
+			Ttl:     ttl,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, rawError(resp.GetError())
+	})
+}
+
+// BatchPut sets the keys of pairs to their values. If a key appears more
+// than once, the last pair wins. The put is not atomic across regions.
+func (c *Client) BatchPut(ctx context.Context, pairs []*kvrpcpb.KvPair) error {
+	return c.BatchPutWithTTL(ctx, pairs, nil)
+}
+
+// BatchPutWithTTL is BatchPut with the TTL of each pair in ttls, like
+// PutWithTTL. A nil ttls means no pair expires.
+func (c *Client) BatchPutWithTTL(ctx context.Context, pairs []*kvrpcpb.KvPair, ttls []uint64) error {
+	if ttls != nil && len(ttls) != len(pairs) {
+		return errors.New("rawkv: ttls do not match pairs")
+	}
+	values := make(map[string][]byte, len(pairs))
+	keyTTLs := make(map[string]uint64, len(ttls))
+	keys := make([][]byte, 0, len(pairs))
+	for i, pair := range pairs {
+		values[string(pair.GetKey())] = pair.GetValue()
+		if ttls != nil {
+			keyTTLs[string(pair.GetKey())] = ttls[i]
+		}
+		keys = append(keys, pair.GetKey())
+	}
+	return c.sender.SendKeys(ctx, regioncache.DedupKeys(keys), func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		pairs := make([]*kvrpcpb.KvPair, 0, len(keys))
+		var ttls []uint64
+		for _, key := range keys {
+			pairs = append(pairs, &kvrpcpb.KvPair{Key: key, Value: values[string(key)]})
+			if len(keyTTLs) > 0 {
+				ttls = append(ttls, keyTTLs[string(key)])
+			}
+		}
+		resp, err := client.RawBatchPut(ctx, &kvrpcpb.RawBatchPutRequest{
+			Context: region.Context(),
+			Pairs:   pairs,
+			Cf:      c.cf,
+			Ttls:    ttls,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, rawError(resp.GetError())
+	})
+}
+
+// Delete removes key.
+func (c *Client) Delete(ctx context.Context, key []byte) error {
+	return c.sender.SendKey(ctx, key, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.RawDelete(ctx, &kvrpcpb.RawDeleteRequest{
+			Context: region.Context(),
+			Key:     key,
+			Cf:      c.cf,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, rawError(resp.GetError())
+	})
+}
+
+// BatchDelete removes keys. The delete is not atomic across regions.
+func (c *Client) BatchDelete(ctx context.Context, keys [][]byte) error {
+	return c.sender.SendKeys(ctx, regioncache.DedupKeys(keys), func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		resp, err := client.RawBatchDelete(ctx, &kvrpcpb.RawBatchDeleteRequest{
+			Context: region.Context(),
+			Keys:    keys,
+			Cf:      c.cf,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, rawError(resp.GetError())
+	})
+}
+
+// CompareAndSwap atomically sets key to value if its current value is
+// previous, or if it does not exist when previous is nil. It returns the value
+// key had before, nil if it did not exist, and whether value was set.
+func (c *Client) CompareAndSwap(ctx context.Context, key, previous, value []byte) ([]byte, bool, error) {
+	return c.CompareAndSwapWithTTL(ctx, key, previous, value, 0)
+}
+
+// CompareAndSwapWithTTL is CompareAndSwap setting a value that expires after
+// ttl seconds, like PutWithTTL.
+func (c *Client) CompareAndSwapWithTTL(ctx context.Context, key, previous, value []byte, ttl uint64) ([]byte, bool, error) {
+	var (
+		old     []byte
+		succeed bool
+	)
+	err := c.sender.SendKey(ctx, key, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.RawCompareAndSwap(ctx, &kvrpcpb.RawCompareAndSwapRequest{
+			Context:          region.Context(),
+			Key:              key,
+			Value:            value,
+			PreviousNotExist: previous == nil,
+			PreviousValue:    previous,
+			Cf:               c.cf,
+			Ttl:              ttl,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		old, succeed = nil, resp.GetSucceed()
+		if !resp.GetPreviousNotExist() {
+			// An empty value comes back as nil, which would mean that key
+			// does not exist.
+			if old = resp.GetPreviousValue(); old == nil {
+				old = []byte{}
+			}
+		}
+		return nil, rawError(resp.GetError())
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return old, succeed, nil
+}
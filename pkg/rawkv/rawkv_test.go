@@ -0,0 +1,416 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rawkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/mocktikv/mocktikvtest"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc"
+)
+
+type testCluster struct {
+	*mocktikvtest.Cluster
+	store  *testStore
+	client *Client
+}
+
+// testStore counts the batch requests sent to TiKV.
+type testStore struct {
+	tikvpb.TikvClient
+	batchPuts int32
+}
+
+func (s *testStore) RawBatchPut(ctx context.Context, req *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error) {
+	atomic.AddInt32(&s.batchPuts, 1)
+	return s.TikvClient.RawBatchPut(ctx, req, opts...)
+}
+
+// newTestCluster starts a mock PD and a mock TiKV on store 1, with the
+// regions split at splitKeys.
+func newTestCluster(t *testing.T, splitKeys ...string) *testCluster {
+	c := &testCluster{Cluster: mocktikvtest.NewCluster(t, 1)}
+	c.Split(t, splitKeys...)
+	c.store = &testStore{TikvClient: c.TikvClient(1)}
+	c.client = NewClient(regioncache.NewSender(c.NewRegionCache(), func(context.Context, uint64) (tikvpb.TikvClient, error) {
+		return c.store, nil
+	}, nil))
+	return c
+}
+
+// putLetters puts each letter of letters as both key and value.
+func putLetters(t *testing.T, client *Client, letters string) {
+	var pairs []*kvrpcpb.KvPair
+	for _, l := range letters {
+		pairs = append(pairs, &kvrpcpb.KvPair{Key: []byte(string(l)), Value: []byte(string(l))})
+	}
+	if err := client.BatchPut(context.Background(), pairs); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// joinPairs returns the keys of pairs as one string, checking that each
+// value is its key unless keyOnly is set.
+func joinPairs(t *testing.T, pairs []*kvrpcpb.KvPair, keyOnly bool) string {
+	var b strings.Builder
+	for _, pair := range pairs {
+		if keyOnly && pair.GetValue() != nil || !keyOnly && string(pair.GetValue()) != string(pair.GetKey()) {
+			t.Fatalf("unexpected value of %q: %q", pair.GetKey(), pair.GetValue())
+		}
+		b.Write(pair.GetKey())
+	}
+	return b.String()
+}
+
+func TestBatch(t *testing.T) {
+	cluster := newTestCluster(t, "d", "k", "r")
+	defer cluster.Close()
+	client := cluster.client
+	ctx := context.Background()
+
+	putLetters(t, client, "zyxwabcmnlkj")
+	if n := atomic.LoadInt32(&cluster.store.batchPuts); n != 4 {
+		t.Fatalf("expect one put per region, got %d", n)
+	}
+	pairs, err := client.BatchGet(ctx, [][]byte{[]byte("z"), []byte("e"), []byte("a"), []byte("m"), []byte("a")})
+	if err != nil || joinPairs(t, pairs, false) != "amz" {
+		t.Fatal(err, pairs)
+	}
+
+	// The cached regions are stale after this split.
+	cluster.Split(t, "b", "x")
+	if err := client.BatchDelete(ctx, [][]byte{[]byte("a"), []byte("b"), []byte("m"), []byte("y")}); err != nil {
+		t.Fatal(err)
+	}
+	pairs, err = client.BatchGet(ctx, [][]byte{[]byte("a"), []byte("c"), []byte("m"), []byte("x"), []byte("y")})
+	if err != nil || joinPairs(t, pairs, false) != "cx" {
+		t.Fatal(err, pairs)
+	}
+
+	if err := client.Put(ctx, []byte("q"), []byte("q")); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := client.Get(ctx, []byte("q")); err != nil || string(value) != "q" {
+		t.Fatal(err, value)
+	}
+	if err := client.Delete(ctx, []byte("q")); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := client.Get(ctx, []byte("q")); err != nil || value != nil {
+		t.Fatal(err, value)
+	}
+
+	// Column families are separate key spaces.
+	lock := client.WithCF("lock")
+	putLetters(t, lock, "ak")
+	if pairs, err = lock.BatchGet(ctx, [][]byte{[]byte("a"), []byte("c"), []byte("k")}); err != nil || joinPairs(t, pairs, false) != "ak" {
+		t.Fatal(err, pairs)
+	}
+	if value, err := client.Get(ctx, []byte("a")); err != nil || value != nil {
+		t.Fatal(err, value)
+	}
+}
+
+func TestScan(t *testing.T) {
+	cluster := newTestCluster(t, "d", "k", "r")
+	defer cluster.Close()
+	client := cluster.client
+	ctx := context.Background()
+	putLetters(t, client, "abcdefghijklmnopqrstuvwxyz")
+	putLetters(t, client.WithCF("write"), "aeiou")
+
+	for _, c := range []struct {
+		start, end string
+		limit      int
+		opts       ScanOptions
+		expect     string
+	}{
+		{"", "", 5, ScanOptions{}, "abcde"},
+		{"c", "m", 100, ScanOptions{}, "cdefghijkl"},
+		{"j", "", 3, ScanOptions{KeyOnly: true}, "jkl"},
+		{"x", "", 100, ScanOptions{}, "xyz"},
+		{"m", "c", 100, ScanOptions{}, ""},
+		// Reverse scans read [end, start) from start down.
+		{"m", "c", 100, ScanOptions{Reverse: true}, "lkjihgfedc"},
+		{"", "", 4, ScanOptions{Reverse: true}, "zyxw"},
+		{"e", "", 100, ScanOptions{Reverse: true, KeyOnly: true}, "dcba"},
+		{"s", "q", 100, ScanOptions{Reverse: true}, "rq"},
+		{"k", "d", 7, ScanOptions{Reverse: true}, "jihgfed"},
+	} {
+		pairs, err := client.Scan(ctx, []byte(c.start), []byte(c.end), c.limit, c.opts)
+		if err != nil || joinPairs(t, pairs, c.opts.KeyOnly) != c.expect {
+			t.Fatalf("scan %+v: expect %q, got %q %v", c, c.expect, joinPairs(t, pairs, c.opts.KeyOnly), err)
+		}
+	}
+
+	pairs, err := client.WithCF("write").Scan(ctx, []byte("b"), nil, 100, ScanOptions{})
+	if err != nil || joinPairs(t, pairs, false) != "eiou" {
+		t.Fatal(err, pairs)
+	}
+}
+
+// iterKeys returns the keys of it joined, checking the values like joinPairs.
+func iterKeys(t *testing.T, it *Iterator, keyOnly bool) string {
+	defer it.Close()
+	var pairs []*kvrpcpb.KvPair
+	for it.Next() {
+		pairs = append(pairs, &kvrpcpb.KvPair{Key: it.Key(), Value: it.Value()})
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return joinPairs(t, pairs, keyOnly)
+}
+
+func TestIter(t *testing.T) {
+	cluster := newTestCluster(t, "d", "k", "r")
+	defer cluster.Close()
+	client := cluster.client
+	ctx := context.Background()
+	putLetters(t, client, "abcdefghijklmnopqrstuvwxyz")
+
+	for _, c := range []struct {
+		start, end string
+		opts       ScanOptions
+		expect     string
+	}{
+		{"", "", ScanOptions{}, "abcdefghijklmnopqrstuvwxyz"},
+		{"c", "m", ScanOptions{KeyOnly: true}, "cdefghijkl"},
+		{"k", "r", ScanOptions{}, "klmnopq"},
+		{"m", "c", ScanOptions{}, ""},
+		{"m", "c", ScanOptions{Reverse: true}, "lkjihgfedc"},
+		{"", "", ScanOptions{Reverse: true}, "zyxwvutsrqponmlkjihgfedcba"},
+		{"e", "", ScanOptions{Reverse: true, KeyOnly: true}, "dcba"},
+		{"c", "m", ScanOptions{Reverse: true}, ""},
+	} {
+		if got := iterKeys(t, client.Iter(ctx, []byte(c.start), []byte(c.end), c.opts), c.opts.KeyOnly); got != c.expect {
+			t.Fatalf("iter %+v: expect %q, got %q", c, c.expect, got)
+		}
+	}
+
+	// The iterator follows splits it does not know about yet.
+	it := client.Iter(ctx, []byte("b"), nil, ScanOptions{})
+	cluster.Split(t, "f", "u")
+	if got := iterKeys(t, it, false); got != "bcdefghijklmnopqrstuvwxyz" {
+		t.Fatalf("unexpected keys after split: %q", got)
+	}
+
+	// Regions hold many chunks.
+	var pairs []*kvrpcpb.KvPair
+	for i := 0; i < 500; i++ {
+		key := []byte(fmt.Sprintf("m%03d", i))
+		pairs = append(pairs, &kvrpcpb.KvPair{Key: key, Value: key})
+	}
+	if err := client.WithCF("lock").BatchPut(ctx, pairs); err != nil {
+		t.Fatal(err)
+	}
+	for _, reverse := range []bool{false, true} {
+		it = client.WithCF("lock").Iter(ctx, nil, nil, ScanOptions{Reverse: reverse})
+		n := 0
+		for ; it.Next(); n++ {
+			i := n
+			if reverse {
+				i = len(pairs) - 1 - n
+			}
+			if !bytes.Equal(it.Key(), pairs[i].GetKey()) {
+				t.Fatalf("expect %q, got %q", pairs[i].GetKey(), it.Key())
+			}
+		}
+		if it.Err() != nil || n != len(pairs) {
+			t.Fatal(it.Err(), n)
+		}
+	}
+}
+
+func TestBatchScan(t *testing.T) {
+	cluster := newTestCluster(t, "d", "k", "r")
+	defer cluster.Close()
+	client := cluster.client
+	ctx := context.Background()
+	putLetters(t, client, "abcdefghijklmnopqrstuvwxyz")
+
+	keyRange := func(start, end string) *kvrpcpb.KeyRange {
+		return &kvrpcpb.KeyRange{StartKey: []byte(start), EndKey: []byte(end)}
+	}
+	pairs, err := client.BatchScan(ctx, []*kvrpcpb.KeyRange{keyRange("x", ""), keyRange("b", "m"), keyRange("p", "t")}, 3, ScanOptions{})
+	if err != nil || joinPairs(t, pairs, false) != "bcdpqrxyz" {
+		t.Fatal(err, joinPairs(t, pairs, false))
+	}
+	pairs, err = client.BatchScan(ctx, []*kvrpcpb.KeyRange{keyRange("e", "a"), keyRange("m", "i"), keyRange("", "w")}, 3, ScanOptions{Reverse: true, KeyOnly: true})
+	if err != nil || joinPairs(t, pairs, true) != "zyxlkjdcb" {
+		t.Fatal(err, joinPairs(t, pairs, true))
+	}
+
+	// Ranges spanning regions split after they were cached.
+	cluster.Split(t, "f", "h", "u")
+	pairs, err = client.BatchScan(ctx, []*kvrpcpb.KeyRange{keyRange("c", "j"), keyRange("t", "")}, 100, ScanOptions{})
+	if err != nil || joinPairs(t, pairs, false) != "cdefghituvwxyz" {
+		t.Fatal(err, joinPairs(t, pairs, false))
+	}
+
+	if _, err = client.BatchScan(ctx, []*kvrpcpb.KeyRange{keyRange("a", "f"), keyRange("e", "g")}, 1, ScanOptions{}); err != ErrOverlappingRanges {
+		t.Fatalf("expect %v, got %v", ErrOverlappingRanges, err)
+	}
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	cluster := newTestCluster(t, "m")
+	defer cluster.Close()
+	client := cluster.client
+	ctx := context.Background()
+
+	old, ok, err := client.CompareAndSwap(ctx, []byte("leader"), nil, []byte("a"))
+	if err != nil || !ok || old != nil {
+		t.Fatal(err, ok, old)
+	}
+	old, ok, err = client.CompareAndSwap(ctx, []byte("leader"), nil, []byte("b"))
+	if err != nil || ok || string(old) != "a" {
+		t.Fatal(err, ok, old)
+	}
+	old, ok, err = client.CompareAndSwap(ctx, []byte("leader"), []byte("a"), []byte("b"))
+	if err != nil || !ok || string(old) != "a" {
+		t.Fatal(err, ok, old)
+	}
+
+	// An empty value exists, and the returned value swaps it.
+	if err = client.Put(ctx, []byte("empty"), []byte{}); err != nil {
+		t.Fatal(err)
+	}
+	old, ok, err = client.CompareAndSwap(ctx, []byte("empty"), []byte("x"), []byte("a"))
+	if err != nil || ok || old == nil || len(old) != 0 {
+		t.Fatal(err, ok, old)
+	}
+	old, ok, err = client.CompareAndSwap(ctx, []byte("empty"), old, []byte("a"))
+	if err != nil || !ok || old == nil || len(old) != 0 {
+		t.Fatal(err, ok, old)
+	}
+
+	// Concurrent increments of a counter all land.
+	const workers, increments = 4, 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; {
+				value, err := client.Get(ctx, []byte("counter"))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				n, _ := strconv.Atoi(string(value))
+				if _, ok, err := client.CompareAndSwap(ctx, []byte("counter"), value, []byte(strconv.Itoa(n+1))); err != nil {
+					t.Error(err)
+					return
+				} else if ok {
+					j++
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if value, err := client.Get(ctx, []byte("counter")); err != nil || string(value) != strconv.Itoa(workers*increments) {
+		t.Fatal(err, string(value))
+	}
+}
+
+// fakeClock is a clock for mocktikv that only moves when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestTTL(t *testing.T) {
+	cluster := newTestCluster(t, "c")
+	defer cluster.Close()
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	cluster.MVCC.SetClock(clock.Now)
+	client := cluster.client
+	ctx := context.Background()
+
+	if err := client.PutWithTTL(ctx, []byte("a"), []byte("a"), 10); err != nil {
+		t.Fatal(err)
+	}
+	putLetters(t, client, "b")
+	pairs := []*kvrpcpb.KvPair{{Key: []byte("c"), Value: []byte("c")}, {Key: []byte("d"), Value: []byte("d")}}
+	if err := client.BatchPutWithTTL(ctx, pairs, []uint64{5, 20}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.BatchPutWithTTL(ctx, pairs, []uint64{5}); err == nil {
+		t.Fatal("expect an error for missing ttls")
+	}
+	for key, expect := range map[string]uint64{"a": 10, "b": 0, "c": 5, "d": 20} {
+		if ttl, ok, err := client.GetKeyTTL(ctx, []byte(key)); err != nil || !ok || ttl != expect {
+			t.Fatalf("unexpected ttl of %s: %d %v %v", key, ttl, ok, err)
+		}
+	}
+	if _, ok, err := client.GetKeyTTL(ctx, []byte("e")); err != nil || ok {
+		t.Fatal(err, ok)
+	}
+
+	clock.Add(5500 * time.Millisecond)
+	if ttl, ok, err := client.GetKeyTTL(ctx, []byte("a")); err != nil || !ok || ttl != 5 {
+		t.Fatal(err, ok, ttl)
+	}
+	if value, err := client.Get(ctx, []byte("c")); err != nil || value != nil {
+		t.Fatal(err, value)
+	}
+	if _, ok, err := client.GetKeyTTL(ctx, []byte("c")); err != nil || ok {
+		t.Fatal(err, ok)
+	}
+	got, err := client.BatchGet(ctx, [][]byte{[]byte("a"), []byte("c"), []byte("d")})
+	if err != nil || joinPairs(t, got, false) != "ad" {
+		t.Fatal(err, got)
+	}
+
+	clock.Add(5 * time.Second)
+	got, err = client.Scan(ctx, nil, nil, 10, ScanOptions{})
+	if err != nil || joinPairs(t, got, false) != "bd" {
+		t.Fatal(err, got)
+	}
+	got, err = client.Scan(ctx, nil, nil, 10, ScanOptions{Reverse: true})
+	if err != nil || joinPairs(t, got, false) != "db" {
+		t.Fatal(err, got)
+	}
+	// An expired key is free to take.
+	if old, ok, err := client.CompareAndSwapWithTTL(ctx, []byte("a"), nil, []byte("a"), 3); err != nil || !ok || old != nil {
+		t.Fatal(err, ok, old)
+	}
+	if ttl, ok, err := client.GetKeyTTL(ctx, []byte("a")); err != nil || !ok || ttl != 3 {
+		t.Fatal(err, ok, ttl)
+	}
+}
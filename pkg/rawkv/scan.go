@@ -0,0 +1,221 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rawkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// ErrOverlappingRanges is returned by BatchScan for ranges that overlap.
+var ErrOverlappingRanges = errors.New("rawkv: scan ranges overlap")
+
+// ScanOptions configures a scan.
+type ScanOptions struct {
+	// KeyOnly leaves the values out of the returned pairs.
+	KeyOnly bool
+	// Reverse scans in descending order. Like in RawScanRequest, the start
+	// key is then the exclusive upper bound of the range and the end key its
+	// inclusive lower bound: [end_key, start_key) is scanned from start_key
+	// down. An empty start key means +inf, and an empty end key -inf.
+	Reverse bool
+}
+
+// keyRange is a range [lower, upper) whatever the direction of the scan. An
+// empty upper means +inf.
+type keyRange struct {
+	lower, upper []byte
+}
+
+func newKeyRange(startKey, endKey []byte, reverse bool) keyRange {
+	if reverse {
+		return keyRange{lower: endKey, upper: startKey}
+	}
+	return keyRange{lower: startKey, upper: endKey}
+}
+
+func (r keyRange) empty() bool {
+	return len(r.upper) > 0 && bytes.Compare(r.lower, r.upper) >= 0
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return bytes.Compare(key, r.lower) >= 0 && (len(r.upper) == 0 || bytes.Compare(key, r.upper) < 0)
+}
+
+// clip splits r at the end of region into the part inside the region and
+// the rest. ok is false if r ends in the region.
+func (r keyRange) clip(region *regioncache.Region) (in, rest keyRange, ok bool) {
+	end := region.Meta.GetEndKey()
+	if len(end) == 0 || len(r.upper) > 0 && bytes.Compare(r.upper, end) <= 0 {
+		return r, keyRange{}, false
+	}
+	return keyRange{lower: r.lower, upper: end}, keyRange{lower: end, upper: r.upper}, true
+}
+
+// toProto returns r with the start and end keys of RawScanRequest.
+func (r keyRange) toProto(reverse bool) *kvrpcpb.KeyRange {
+	if reverse {
+		return &kvrpcpb.KeyRange{StartKey: r.upper, EndKey: r.lower}
+	}
+	return &kvrpcpb.KeyRange{StartKey: r.lower, EndKey: r.upper}
+}
+
+// Scan returns at most limit pairs between startKey and endKey, reading the
+// regions one after another until limit is reached. An empty endKey means
+// +inf when scanning forward; see ScanOptions for reverse scans.
+func (c *Client) Scan(ctx context.Context, startKey, endKey []byte, limit int, opts ScanOptions) ([]*kvrpcpb.KvPair, error) {
+	var pairs []*kvrpcpb.KvPair
+	r := newKeyRange(startKey, endKey, opts.Reverse)
+	for !r.empty() && len(pairs) < limit {
+		var (
+			regionStart, regionEnd []byte
+			kvs                    []*kvrpcpb.KvPair
+		)
+		fn := func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+			regionStart, regionEnd = region.Meta.GetStartKey(), region.Meta.GetEndKey()
+			resp, err := client.RawScan(ctx, &kvrpcpb.RawScanRequest{
+				Context:  region.Context(),
+				StartKey: startKey,
+				EndKey:   endKey,
+				Limit:    uint32(limit - len(pairs)),
+				KeyOnly:  opts.KeyOnly,
+				Cf:       c.cf,
+				Reverse:  opts.Reverse,
+			})
+			if err != nil || resp.GetRegionError() != nil {
+				return resp.GetRegionError(), err
+			}
+			kvs = resp.GetKvs()
+			return nil, nil
+		}
+		if opts.Reverse {
+			if err := c.sender.SendEndKey(ctx, r.upper, fn); err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, kvs...)
+			if bytes.Compare(regionStart, r.lower) <= 0 {
+				break
+			}
+			r.upper, startKey = regionStart, regionStart
+		} else {
+			if err := c.sender.SendKey(ctx, r.lower, fn); err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, kvs...)
+			if len(regionEnd) == 0 {
+				break
+			}
+			r.lower, startKey = regionEnd, regionEnd
+		}
+	}
+	return pairs, nil
+}
+
+// BatchScan scans ranges, with the start and end keys of Scan, and returns
+// at most eachLimit pairs of each range. The ranges must not overlap; the
+// pairs of all ranges are returned together in key order, or in descending
+// order for reverse scans.
+//
+// Every range is split at region boundaries, and the parts in one region
+// are sent in one RawBatchScanRequest, concurrently with the other regions.
+func (c *Client) BatchScan(ctx context.Context, ranges []*kvrpcpb.KeyRange, eachLimit int, opts ScanOptions) ([]*kvrpcpb.KvPair, error) {
+	sorted := make([]keyRange, 0, len(ranges))
+	for _, kr := range ranges {
+		if r := newKeyRange(kr.GetStartKey(), kr.GetEndKey(), opts.Reverse); !r.empty() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].lower, sorted[j].lower) < 0 })
+	for i := 1; i < len(sorted); i++ {
+		if prev := sorted[i-1]; len(prev.upper) == 0 || bytes.Compare(prev.upper, sorted[i].lower) > 0 {
+			return nil, ErrOverlappingRanges
+		}
+	}
+	if eachLimit <= 0 || len(sorted) == 0 {
+		return nil, nil
+	}
+
+	// pending maps the lower key of each range left to scan to its upper
+	// key. A range is sent to the region of its lower key, and what lies
+	// beyond that region is left for the next round.
+	pending := make(map[string][]byte, len(sorted))
+	for _, r := range sorted {
+		pending[string(r.lower)] = r.upper
+	}
+	var (
+		mu    sync.Mutex
+		pairs []*kvrpcpb.KvPair
+	)
+	for len(pending) > 0 {
+		keys := make([][]byte, 0, len(pending))
+		for lower := range pending {
+			keys = append(keys, []byte(lower))
+		}
+		current, next := pending, make(map[string][]byte)
+		err := c.sender.SendKeys(ctx, keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+			req := &kvrpcpb.RawBatchScanRequest{
+				Context:   region.Context(),
+				EachLimit: uint32(eachLimit),
+				KeyOnly:   opts.KeyOnly,
+				Cf:        c.cf,
+				Reverse:   opts.Reverse,
+			}
+			var rests []keyRange
+			for _, key := range keys {
+				in, rest, ok := keyRange{lower: key, upper: current[string(key)]}.clip(region)
+				req.Ranges = append(req.Ranges, in.toProto(opts.Reverse))
+				if ok {
+					rests = append(rests, rest)
+				}
+			}
+			resp, err := client.RawBatchScan(ctx, req)
+			if err != nil || resp.GetRegionError() != nil {
+				return resp.GetRegionError(), err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			pairs = append(pairs, resp.GetKvs()...)
+			for _, rest := range rests {
+				next[string(rest.lower)] = rest.upper
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		pending = next
+	}
+
+	// Every part of a range got up to eachLimit pairs; keep the first
+	// eachLimit of the range in scan order.
+	sortPairs(pairs, opts.Reverse)
+	counts := make([]int, len(sorted))
+	result := pairs[:0]
+	for _, pair := range pairs {
+		i := sort.Search(len(sorted), func(i int) bool { return bytes.Compare(sorted[i].lower, pair.GetKey()) > 0 }) - 1
+		if i >= 0 && sorted[i].contains(pair.GetKey()) && counts[i] < eachLimit {
+			counts[i]++
+			result = append(result, pair)
+		}
+	}
+	return result, nil
+}
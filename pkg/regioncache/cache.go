@@ -0,0 +1,373 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package regioncache caches the region layout of a cluster on the client
+// side, and keeps it up to date from the region errors returned by TiKV.
+package regioncache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/btree"
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+)
+
+const btreeDegree = 32
+
+// staleLoads is how many times LocateKey loads a region which a newer
+// cached region makes stale before it gives up, and staleLoadBackoff is
+// the wait before the first reload; it doubles with every reload.
+const (
+	staleLoads       = 4
+	staleLoadBackoff = 10 * time.Millisecond
+)
+
+// ErrStaleRegion is returned by LocateKey if the loader keeps returning a
+// region older than the cached ones, for example while PD has not yet
+// heard of a split that TiKV already reported to the client.
+var ErrStaleRegion = errors.New("regioncache: loaded region is stale")
+
+// Region is a cached region and its leader. A Region is never modified once
+// cached; updates replace it.
+type Region struct {
+	Meta *metapb.Region
+	// Leader is nil if the leader is unknown.
+	Leader *metapb.Peer
+}
+
+// ID returns the region ID.
+func (r *Region) ID() uint64 {
+	return r.Meta.GetId()
+}
+
+// Context returns a kvrpcpb.Context addressing the region leader.
+func (r *Region) Context() *kvrpcpb.Context {
+	return &kvrpcpb.Context{
+		RegionId:    r.Meta.GetId(),
+		RegionEpoch: r.Meta.GetRegionEpoch(),
+		Peer:        r.Leader,
+	}
+}
+
+func (r *Region) peerOnStore(storeID uint64) *metapb.Peer {
+	for _, peer := range r.Meta.GetPeers() {
+		if peer.GetStoreId() == storeID {
+			return peer
+		}
+	}
+	return nil
+}
+
+// Less orders regions by start key.
+func (r *Region) Less(other btree.Item) bool {
+	return bytes.Compare(r.Meta.GetStartKey(), other.(*Region).Meta.GetStartKey()) < 0
+}
+
+// RegionCache maps keys to regions, loading misses through a Loader.
+type RegionCache struct {
+	loader Loader
+
+	mu      sync.RWMutex
+	tree    *btree.BTree
+	regions map[uint64]*Region
+}
+
+// NewRegionCache creates an empty RegionCache.
+func NewRegionCache(loader Loader) *RegionCache {
+	return &RegionCache{
+		loader:  loader,
+		tree:    btree.New(btreeDegree),
+		regions: make(map[uint64]*Region),
+	}
+}
+
+// LocateKey returns the region containing key. A loaded region that is
+// stale, so that the newer cached region does not contain key, is loaded
+// again after a backoff; ErrStaleRegion is returned if it stays stale.
+func (c *RegionCache) LocateKey(ctx context.Context, key []byte) (*Region, error) {
+	if region := c.SearchKey(key); region != nil {
+		return region, nil
+	}
+	backoff := staleLoadBackoff
+	for attempt := 1; ; attempt++ {
+		region, err := c.loader.LoadRegion(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if region = c.insert(region); region.Meta.ContainsKey(key) {
+			return region, nil
+		}
+		if attempt == staleLoads {
+			return nil, ErrStaleRegion
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// LocateEndKey returns the region containing the keys right before key, that
+// is the region ending at key if key is a region boundary. An empty key means
+// +inf, so the last region is returned. Reverse scans, which read up to an
+// exclusive upper bound, start from it.
+func (c *RegionCache) LocateEndKey(ctx context.Context, key []byte) (*Region, error) {
+	c.mu.RLock()
+	region := c.searchEndKey(key)
+	c.mu.RUnlock()
+	if region != nil {
+		return region, nil
+	}
+	var err error
+	if len(key) == 0 {
+		region, err = c.loader.LoadPrevRegion(ctx, key)
+	} else if region, err = c.loader.LoadRegion(ctx, key); err == nil && bytes.Equal(region.Meta.GetStartKey(), key) {
+		region, err = c.loader.LoadPrevRegion(ctx, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.insert(region), nil
+}
+
+// LocateRegionByID returns the region with id.
+func (c *RegionCache) LocateRegionByID(ctx context.Context, id uint64) (*Region, error) {
+	c.mu.RLock()
+	region := c.regions[id]
+	c.mu.RUnlock()
+	if region != nil {
+		return region, nil
+	}
+	region, err := c.loader.LoadRegionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.insert(region), nil
+}
+
+// LoadRange loads and caches at most limit regions from the one containing
+// startKey until endKey, so that later lookups in the range hit the cache.
+func (c *RegionCache) LoadRange(ctx context.Context, startKey, endKey []byte, limit int) ([]*Region, error) {
+	regions, err := c.loader.ScanRegions(ctx, startKey, endKey, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i, region := range regions {
+		regions[i] = c.insert(region)
+	}
+	return regions, nil
+}
+
+// SearchKey returns the cached region containing key, or nil.
+func (c *RegionCache) SearchKey(key []byte) *Region {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.search(key)
+}
+
+func (c *RegionCache) search(key []byte) *Region {
+	var region *Region
+	c.tree.DescendLessOrEqual(&Region{Meta: &metapb.Region{StartKey: key}}, func(i btree.Item) bool {
+		region = i.(*Region)
+		return false
+	})
+	if region == nil || !region.Meta.ContainsKey(key) {
+		return nil
+	}
+	return region
+}
+
+// searchEndKey returns the cached region with a start key before key and an
+// end key at or after it, or nil.
+func (c *RegionCache) searchEndKey(key []byte) *Region {
+	var region *Region
+	if len(key) == 0 {
+		if max := c.tree.Max(); max != nil {
+			region = max.(*Region)
+		}
+	} else {
+		c.tree.DescendLessOrEqual(&Region{Meta: &metapb.Region{StartKey: key}}, func(i btree.Item) bool {
+			region = i.(*Region)
+			// A region starting at key holds no key before it.
+			return bytes.Equal(region.Meta.GetStartKey(), key)
+		})
+	}
+	if region == nil {
+		return nil
+	}
+	end := region.Meta.GetEndKey()
+	if len(key) == 0 {
+		if len(end) == 0 {
+			return region
+		}
+		return nil
+	}
+	if bytes.Compare(region.Meta.GetStartKey(), key) < 0 && (len(end) == 0 || bytes.Compare(key, end) <= 0) {
+		return region
+	}
+	return nil
+}
+
+// Insert caches region unless a cached region overlapping it is newer. It
+// returns the region now cached for the range of region.
+func (c *RegionCache) Insert(region *Region) *Region {
+	return c.insert(region)
+}
+
+func (c *RegionCache) insert(region *Region) *Region {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached := c.insertLocked(region); cached != nil {
+		return cached
+	}
+	return region
+}
+
+// insertLocked caches region and returns nil, or returns the newer cached
+// region that makes it stale.
+func (c *RegionCache) insertLocked(region *Region) *Region {
+	var stale []*Region
+	if cached := c.regions[region.ID()]; cached != nil {
+		if region.Meta.GetRegionEpoch().IsStale(cached.Meta.GetRegionEpoch()) {
+			return cached
+		}
+		stale = append(stale, cached)
+	}
+	for _, cached := range c.overlapping(region.Meta) {
+		if cached.ID() == region.ID() {
+			continue
+		}
+		// Only splits and merges move range boundaries, and both bump the
+		// version of the regions they touch.
+		if cached.Meta.GetRegionEpoch().GetVersion() > region.Meta.GetRegionEpoch().GetVersion() {
+			return cached
+		}
+		stale = append(stale, cached)
+	}
+	for _, r := range stale {
+		c.remove(r)
+	}
+	c.tree.ReplaceOrInsert(region)
+	c.regions[region.ID()] = region
+	return nil
+}
+
+// overlapping returns the cached regions intersecting meta.
+func (c *RegionCache) overlapping(meta *metapb.Region) []*Region {
+	var regions []*Region
+	if first := c.search(meta.GetStartKey()); first != nil {
+		regions = append(regions, first)
+	}
+	c.tree.AscendGreaterOrEqual(&Region{Meta: &metapb.Region{StartKey: meta.GetStartKey()}}, func(i btree.Item) bool {
+		region := i.(*Region)
+		if !region.Meta.Overlaps(meta) {
+			return false
+		}
+		if len(regions) == 0 || regions[0] != region {
+			regions = append(regions, region)
+		}
+		return true
+	})
+	return regions
+}
+
+func (c *RegionCache) remove(region *Region) {
+	if c.regions[region.ID()] == region {
+		delete(c.regions, region.ID())
+	}
+	if item := c.tree.Get(region); item == btree.Item(region) {
+		c.tree.Delete(region)
+	}
+}
+
+// Invalidate drops the region with id, so that the next lookup reloads it.
+func (c *RegionCache) Invalidate(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if region := c.regions[id]; region != nil {
+		c.remove(region)
+	}
+}
+
+// UpdateLeader records leader as the leader of the region with id. A nil
+// leader, or one that is not a peer of the cached region, invalidates the
+// region instead.
+func (c *RegionCache) UpdateLeader(id uint64, leader *metapb.Peer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	region := c.regions[id]
+	if region == nil {
+		return
+	}
+	peer := region.peerOnStore(leader.GetStoreId())
+	if leader == nil || peer == nil || peer.GetId() != leader.GetId() {
+		c.remove(region)
+		return
+	}
+	updated := &Region{Meta: region.Meta, Leader: peer}
+	c.tree.ReplaceOrInsert(updated)
+	c.regions[id] = updated
+}
+
+// OnEpochNotMatch replaces the region with id by the current regions TiKV
+// reported, all at once. The leaders of the new regions are assumed to be on
+// the store of the old leader, as right after a split. Without current
+// regions the region is only invalidated.
+func (c *RegionCache) OnEpochNotMatch(id uint64, current []*metapb.Region) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := c.regions[id]
+	if old != nil {
+		c.remove(old)
+	}
+	for _, meta := range current {
+		region := &Region{Meta: meta}
+		if old != nil && old.Leader != nil {
+			region.Leader = region.peerOnStore(old.Leader.GetStoreId())
+		}
+		c.insertLocked(region)
+	}
+}
+
+// OnRegionError updates the cache from a region error returned for the
+// region with id. NotLeader and EpochNotMatch are applied; any other error
+// invalidates the region, except ServerIsBusy and StaleCommand which say
+// nothing about the layout.
+func (c *RegionCache) OnRegionError(id uint64, err *errorpb.Error) {
+	switch {
+	case err == nil, err.GetServerIsBusy() != nil, err.GetStaleCommand() != nil:
+	case err.GetNotLeader() != nil:
+		c.UpdateLeader(id, err.GetNotLeader().GetLeader())
+	case err.GetEpochNotMatch() != nil:
+		c.OnEpochNotMatch(id, err.GetEpochNotMatch().GetCurrentRegions())
+	default:
+		c.Invalidate(id)
+	}
+}
+
+// Len returns the number of cached regions.
+func (c *RegionCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.regions)
+}
@@ -0,0 +1,300 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regioncache
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/mockpd"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testClusterID = 42
+
+// newTestCluster starts a mock PD with region 2 over the whole key space,
+// with peers 3 and 4 on stores 1 and 2 and the leader on store 1.
+func newTestCluster(t *testing.T) (*mockpd.Server, pdpb.PDClient, func()) {
+	server := mockpd.NewServer(testClusterID)
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pdpb.RegisterPDServer(s, server)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := pdpb.NewPDClient(conn)
+	resp, err := client.Bootstrap(context.Background(), &pdpb.BootstrapRequest{
+		Header: &pdpb.RequestHeader{ClusterId: testClusterID},
+		Store:  &metapb.Store{Id: 1, Address: "store1"},
+		Region: &metapb.Region{
+			Id:          2,
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 2, Version: 1},
+			Peers:       []*metapb.Peer{{Id: 3, StoreId: 1}, {Id: 4, StoreId: 2}},
+		},
+	})
+	if err != nil || resp.GetHeader().GetError() != nil {
+		t.Fatal(err, resp.GetHeader().GetError())
+	}
+	return server, client, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestLocate(t *testing.T) {
+	server, client, cleanup := newTestCluster(t)
+	defer cleanup()
+	cache := NewRegionCache(NewPDLoader(client, testClusterID))
+	ctx := context.Background()
+
+	if _, err := server.Split(2, [][]byte{[]byte("m")}); err != nil {
+		t.Fatal(err)
+	}
+	region, err := cache.LocateKey(ctx, []byte("a"))
+	if err != nil || region.ID() == 2 || !region.Meta.ContainsKey([]byte("a")) || region.Meta.ContainsKey([]byte("m")) {
+		t.Fatal(err, region)
+	}
+	if region.Leader.GetStoreId() != 1 || region.Context().GetPeer() != region.Leader {
+		t.Fatalf("unexpected leader %v", region.Leader)
+	}
+	if cache.SearchKey([]byte("z")) != nil {
+		t.Fatal("expect cache miss")
+	}
+	byID, err := cache.LocateRegionByID(ctx, 2)
+	if err != nil || string(byID.Meta.GetStartKey()) != "m" || cache.SearchKey([]byte("z")) != byID {
+		t.Fatal(err, byID)
+	}
+
+	cache.Invalidate(2)
+	regions, err := cache.LoadRange(ctx, []byte("a"), nil, 0)
+	if err != nil || len(regions) != 2 || cache.Len() != 2 {
+		t.Fatal(err, regions)
+	}
+}
+
+// staleLoader returns stale instead of the region of a key, until its
+// count of stale loads runs out.
+type staleLoader struct {
+	*PDLoader
+	stale *Region
+	loads int
+}
+
+func (l *staleLoader) LoadRegion(ctx context.Context, key []byte) (*Region, error) {
+	if l.loads > 0 {
+		l.loads--
+		return l.stale, nil
+	}
+	return l.PDLoader.LoadRegion(ctx, key)
+}
+
+func TestLocateStaleLoad(t *testing.T) {
+	server, client, cleanup := newTestCluster(t)
+	defer cleanup()
+	ctx := context.Background()
+	stale, err := NewPDLoader(client, testClusterID).LoadRegion(ctx, []byte("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = server.Split(2, [][]byte{[]byte("m")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The cache knows the region 2 after the split, while the loader is
+	// behind and returns it from before the split for "a".
+	loader := &staleLoader{PDLoader: NewPDLoader(client, testClusterID), stale: stale, loads: 2}
+	cache := NewRegionCache(loader)
+	if _, err = cache.LocateRegionByID(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	region, err := cache.LocateKey(ctx, []byte("a"))
+	if err != nil || region.ID() == 2 || !region.Meta.ContainsKey([]byte("a")) || loader.loads != 0 {
+		t.Fatal(err, region)
+	}
+
+	cache.Invalidate(region.ID())
+	loader.loads = staleLoads
+	if region, err = cache.LocateKey(ctx, []byte("a")); !errors.Is(err, ErrStaleRegion) {
+		t.Fatalf("expect a stale region error, got %v %v", region, err)
+	}
+}
+
+func TestLocateEndKey(t *testing.T) {
+	server, client, cleanup := newTestCluster(t)
+	defer cleanup()
+	ctx := context.Background()
+	if _, err := server.Split(2, [][]byte{[]byte("m")}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cached := range []bool{false, true} {
+		cache := NewRegionCache(NewPDLoader(client, testClusterID))
+		if cached {
+			if _, err := cache.LoadRange(ctx, nil, nil, 0); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for key, start := range map[string]string{"": "m", "z": "m", "n": "m", "m": "", "b": ""} {
+			region, err := cache.LocateEndKey(ctx, []byte(key))
+			if err != nil || string(region.Meta.GetStartKey()) != start {
+				t.Fatalf("cached %v: unexpected region for end key %q: %v %v", cached, key, region, err)
+			}
+		}
+	}
+}
+
+func TestEpochNotMatch(t *testing.T) {
+	server, client, cleanup := newTestCluster(t)
+	defer cleanup()
+	cache := NewRegionCache(NewPDLoader(client, testClusterID))
+	ctx := context.Background()
+
+	old, err := cache.LocateKey(ctx, []byte("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := server.Split(2, [][]byte{[]byte("f"), []byte("p")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.OnRegionError(old.ID(), &errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{CurrentRegions: current}})
+	if cache.Len() != 3 {
+		t.Fatalf("expect 3 regions, got %d", cache.Len())
+	}
+	for _, key := range []string{"a", "g", "x"} {
+		region := cache.SearchKey([]byte(key))
+		if region == nil || region.Leader.GetStoreId() != old.Leader.GetStoreId() {
+			t.Fatalf("unexpected region for %s: %v", key, region)
+		}
+	}
+
+	// A stale copy of the old region must not replace the new ones.
+	if cached := cache.Insert(old); cached == old || cache.Len() != 3 {
+		t.Fatalf("stale region is cached: %v", cached)
+	}
+	// An empty EpochNotMatch only invalidates the region.
+	cache.OnRegionError(current[0].GetId(), &errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{}})
+	if cache.SearchKey([]byte("a")) != nil || cache.SearchKey([]byte("g")) == nil {
+		t.Fatal("expect only the first region to be invalidated")
+	}
+}
+
+func TestNotLeader(t *testing.T) {
+	_, client, cleanup := newTestCluster(t)
+	defer cleanup()
+	cache := NewRegionCache(NewPDLoader(client, testClusterID))
+
+	region, err := cache.LocateKey(context.Background(), []byte("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.OnRegionError(2, &errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 2, Leader: &metapb.Peer{Id: 4, StoreId: 2}}})
+	updated := cache.SearchKey([]byte("a"))
+	if updated == nil || updated == region || updated.Leader.GetId() != 4 || region.Leader.GetId() != 3 {
+		t.Fatalf("unexpected leader update %v", updated)
+	}
+	cache.OnRegionError(2, &errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{}})
+	if cache.SearchKey([]byte("a")) != updated {
+		t.Fatal("server is busy must not change the cache")
+	}
+	// A leader that is not a peer means the cached peers are stale.
+	cache.OnRegionError(2, &errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 2, Leader: &metapb.Peer{Id: 5, StoreId: 3}}})
+	if cache.SearchKey([]byte("a")) != nil {
+		t.Fatal("expect region to be invalidated")
+	}
+}
+
+func TestSender(t *testing.T) {
+	server, client, cleanup := newTestCluster(t)
+	defer cleanup()
+	cache := NewRegionCache(NewPDLoader(client, testClusterID))
+	ctx := context.Background()
+	var (
+		mu       sync.Mutex
+		storeIDs []uint64
+	)
+	sender := NewSender(cache, func(ctx context.Context, storeID uint64) (tikvpb.TikvClient, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		storeIDs = append(storeIDs, storeID)
+		return nil, nil
+	}, nil)
+
+	if _, err := cache.LocateKey(ctx, []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := server.Split(2, [][]byte{[]byte("f"), []byte("p")}); err != nil {
+		t.Fatal(err)
+	}
+	// check plays TiKV: it rejects requests with a stale epoch.
+	check := func(region *Region) *errorpb.Error {
+		current, _ := server.RegionByID(region.ID())
+		if region.Meta.GetRegionEpoch().IsStale(current.GetRegionEpoch()) {
+			return &errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{}}
+		}
+		return nil
+	}
+	sent := make(map[uint64]int)
+	keys := [][]byte{[]byte("a"), []byte("b"), []byte("g"), []byte("x"), []byte("z")}
+	err := sender.SendKeys(ctx, keys, func(ctx context.Context, client tikvpb.TikvClient, region *Region, keys [][]byte) (*errorpb.Error, error) {
+		if regionErr := check(region); regionErr != nil {
+			return regionErr, nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, key := range keys {
+			if !region.Meta.ContainsKey(key) {
+				t.Errorf("key %q sent to region %v", key, region.Meta)
+			}
+		}
+		sent[region.ID()] += len(keys)
+		return nil, nil
+	})
+	if err != nil || len(sent) != 3 || cache.Len() != 3 {
+		t.Fatal(err, sent)
+	}
+
+	storeIDs = nil
+	calls := 0
+	err = sender.SendKey(ctx, []byte("a"), func(ctx context.Context, client tikvpb.TikvClient, region *Region) (*errorpb.Error, error) {
+		calls++
+		if calls == 1 {
+			return &errorpb.Error{NotLeader: &errorpb.NotLeader{Leader: region.peerOnStore(2)}}, nil
+		}
+		return nil, nil
+	})
+	if err != nil || len(storeIDs) != 2 || storeIDs[0] != 1 || storeIDs[1] != 2 {
+		t.Fatal(err, storeIDs)
+	}
+	err = sender.SendKey(ctx, []byte("a"), func(ctx context.Context, client tikvpb.TikvClient, region *Region) (*errorpb.Error, error) {
+		return &errorpb.Error{RaftEntryTooLarge: &errorpb.RaftEntryTooLarge{}}, nil
+	})
+	if !errors.Is(err, kverror.ErrRaftEntryTooLarge) {
+		t.Fatalf("expect raft entry too large, got %v", err)
+	}
+}
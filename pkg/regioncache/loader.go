@@ -0,0 +1,109 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regioncache
+
+import (
+	"context"
+
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+)
+
+// Loader loads regions on cache misses.
+type Loader interface {
+	// LoadRegion returns the region containing key and its leader, which may
+	// be nil if unknown.
+	LoadRegion(ctx context.Context, key []byte) (*Region, error)
+	// LoadPrevRegion returns the region right before the one containing key,
+	// that is the region ending at key if key is a region boundary. An empty
+	// key means +inf, whose previous region is the last one.
+	LoadPrevRegion(ctx context.Context, key []byte) (*Region, error)
+	// LoadRegionByID returns the region with id.
+	LoadRegionByID(ctx context.Context, id uint64) (*Region, error)
+	// ScanRegions returns at most limit regions in order, from the one
+	// containing startKey until endKey. An empty endKey means +inf and a
+	// limit <= 0 means no limit.
+	ScanRegions(ctx context.Context, startKey, endKey []byte, limit int) ([]*Region, error)
+}
+
+// PDLoader is a Loader backed by a pdpb.PDClient.
+type PDLoader struct {
+	client    pdpb.PDClient
+	clusterID uint64
+}
+
+// NewPDLoader creates a PDLoader for the cluster clusterID.
+func NewPDLoader(client pdpb.PDClient, clusterID uint64) *PDLoader {
+	return &PDLoader{client: client, clusterID: clusterID}
+}
+
+func (l *PDLoader) header() *pdpb.RequestHeader {
+	return &pdpb.RequestHeader{ClusterId: l.clusterID}
+}
+
+func regionFromResponse(resp *pdpb.GetRegionResponse, err error) (*Region, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err = kverror.FromPDError(resp.GetHeader().GetError()); err != nil {
+		return nil, err
+	}
+	if resp.GetRegion() == nil {
+		return nil, kverror.ErrPDRegionNotFound
+	}
+	return &Region{Meta: resp.GetRegion(), Leader: resp.GetLeader()}, nil
+}
+
+// LoadRegion implements Loader.
+func (l *PDLoader) LoadRegion(ctx context.Context, key []byte) (*Region, error) {
+	return regionFromResponse(l.client.GetRegion(ctx, &pdpb.GetRegionRequest{Header: l.header(), RegionKey: key}))
+}
+
+// LoadPrevRegion implements Loader.
+func (l *PDLoader) LoadPrevRegion(ctx context.Context, key []byte) (*Region, error) {
+	return regionFromResponse(l.client.GetPrevRegion(ctx, &pdpb.GetRegionRequest{Header: l.header(), RegionKey: key}))
+}
+
+// LoadRegionByID implements Loader.
+func (l *PDLoader) LoadRegionByID(ctx context.Context, id uint64) (*Region, error) {
+	return regionFromResponse(l.client.GetRegionByID(ctx, &pdpb.GetRegionByIDRequest{Header: l.header(), RegionId: id}))
+}
+
+// ScanRegions implements Loader.
+func (l *PDLoader) ScanRegions(ctx context.Context, startKey, endKey []byte, limit int) ([]*Region, error) {
+	if limit < 0 {
+		limit = 0
+	}
+	resp, err := l.client.ScanRegions(ctx, &pdpb.ScanRegionsRequest{
+		Header:   l.header(),
+		StartKey: startKey,
+		EndKey:   endKey,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = kverror.FromPDError(resp.GetHeader().GetError()); err != nil {
+		return nil, err
+	}
+	regions := make([]*Region, 0, len(resp.GetRegions()))
+	for i, meta := range resp.GetRegions() {
+		region := &Region{Meta: meta}
+		if i < len(resp.GetLeaders()) && resp.GetLeaders()[i].GetId() != 0 {
+			region.Leader = resp.GetLeaders()[i]
+		}
+		regions = append(regions, region)
+	}
+	return regions, nil
+}
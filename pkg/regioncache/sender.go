@@ -0,0 +1,204 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regioncache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/errorpb/retry"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// ClientFunc returns a client of the TiKV store with storeID.
+type ClientFunc func(ctx context.Context, storeID uint64) (tikvpb.TikvClient, error)
+
+// RequestFunc sends a request to region through client, and returns the
+// region error of the response if there is one.
+type RequestFunc func(ctx context.Context, client tikvpb.TikvClient, region *Region) (*errorpb.Error, error)
+
+// BatchFunc is a RequestFunc for the keys of a batch that are in region.
+type BatchFunc func(ctx context.Context, client tikvpb.TikvClient, region *Region, keys [][]byte) (*errorpb.Error, error)
+
+// KeyGroup is the keys of a batch that are in one region.
+type KeyGroup struct {
+	Region *Region
+	Keys   [][]byte
+}
+
+// Sender sends requests to the leaders of the regions in a RegionCache, and
+// retries region errors as retry.Decide says, updating the cache on the way.
+type Sender struct {
+	cache   *RegionCache
+	clients ClientFunc
+	backoff retry.BackoffPolicy
+}
+
+// NewSender creates a Sender. A nil backoff means retry.DefaultBackoff.
+func NewSender(cache *RegionCache, clients ClientFunc, backoff retry.BackoffPolicy) *Sender {
+	return &Sender{cache: cache, clients: clients, backoff: backoff}
+}
+
+// Cache returns the region cache of the sender.
+func (s *Sender) Cache() *RegionCache {
+	return s.cache
+}
+
+// SendKey sends fn to the region containing key until it gets no region
+// error.
+func (s *Sender) SendKey(ctx context.Context, key []byte, fn RequestFunc) error {
+	return s.sendLocated(ctx, func() (*Region, error) { return s.cache.LocateKey(ctx, key) }, fn)
+}
+
+// SendEndKey is SendKey for the region containing the keys right before key,
+// as located by RegionCache.LocateEndKey.
+func (s *Sender) SendEndKey(ctx context.Context, key []byte, fn RequestFunc) error {
+	return s.sendLocated(ctx, func() (*Region, error) { return s.cache.LocateEndKey(ctx, key) }, fn)
+}
+
+func (s *Sender) sendLocated(ctx context.Context, locate func() (*Region, error), fn RequestFunc) error {
+	for attempt := 1; ; attempt++ {
+		region, err := locate()
+		if err != nil {
+			return err
+		}
+		regionErr, err := s.send(ctx, region, fn)
+		if err != nil || regionErr == nil {
+			return err
+		}
+		if err = s.onRegionError(ctx, region, regionErr, attempt); err != nil {
+			return err
+		}
+	}
+}
+
+// DedupKeys returns keys without duplicates, keeping the first occurrence,
+// for the batch requests that must not hold a key twice.
+func DedupKeys(keys [][]byte) [][]byte {
+	seen := make(map[string]bool, len(keys))
+	result := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// SendKeys groups keys by region and calls fn for all groups concurrently.
+// A group that gets a region error is grouped again and resent, so fn sees
+// a key twice only after a region error. The first error fails the batch.
+func (s *Sender) SendKeys(ctx context.Context, keys [][]byte, fn BatchFunc) error {
+	return s.sendKeys(ctx, keys, fn, 1)
+}
+
+func (s *Sender) sendKeys(ctx context.Context, keys [][]byte, fn BatchFunc, attempt int) error {
+	groups, err := s.GroupKeys(ctx, keys)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for _, group := range groups {
+		wg.Add(1)
+		go func(group KeyGroup) {
+			defer wg.Done()
+			if err := s.sendGroup(ctx, group, fn, attempt); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(group)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+func (s *Sender) sendGroup(ctx context.Context, group KeyGroup, fn BatchFunc, attempt int) error {
+	regionErr, err := s.send(ctx, group.Region, func(ctx context.Context, client tikvpb.TikvClient, region *Region) (*errorpb.Error, error) {
+		return fn(ctx, client, region, group.Keys)
+	})
+	if err != nil || regionErr == nil {
+		return err
+	}
+	if err = s.onRegionError(ctx, group.Region, regionErr, attempt); err != nil {
+		return err
+	}
+	return s.sendKeys(ctx, group.Keys, fn, attempt+1)
+}
+
+// GroupKeys groups keys by the regions containing them. Groups are ordered
+// by their first key, and keys keep their order within a group.
+func (s *Sender) GroupKeys(ctx context.Context, keys [][]byte) ([]KeyGroup, error) {
+	var groups []KeyGroup
+	index := make(map[uint64]int)
+	for _, key := range keys {
+		region, err := s.cache.LocateKey(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		i, ok := index[region.ID()]
+		if !ok {
+			i = len(groups)
+			index[region.ID()] = i
+			groups = append(groups, KeyGroup{Region: region})
+		}
+		groups[i].Keys = append(groups[i].Keys, key)
+	}
+	return groups, nil
+}
+
+func (s *Sender) send(ctx context.Context, region *Region, fn RequestFunc) (*errorpb.Error, error) {
+	storeID := region.Leader.GetStoreId()
+	if region.Leader == nil && len(region.Meta.GetPeers()) > 0 {
+		// Try any peer; a NotLeader error tells the real leader.
+		storeID = region.Meta.GetPeers()[0].GetStoreId()
+	}
+	client, err := s.clients(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	return fn(ctx, client, region)
+}
+
+// onRegionError applies regionErr to the cache and waits as decided. It
+// returns an error if the request should not be retried.
+func (s *Sender) onRegionError(ctx context.Context, region *Region, regionErr *errorpb.Error, attempt int) error {
+	d := retry.Decide(regionErr, attempt, s.backoff)
+	if d.Action == retry.ActionFail {
+		return kverror.FromRegionError(regionErr)
+	}
+	s.cache.OnRegionError(region.ID(), regionErr)
+	if d.Backoff <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d.Backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regioncache
+
+import (
+	"context"
+	"io"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// StreamChunk is a response of a streaming scan such as KvScanStream.
+type StreamChunk struct {
+	RegionError *errorpb.Error
+	Pairs       []*kvrpcpb.KvPair
+	ResumeKey   []byte
+}
+
+// endsRegion reports whether the chunk says the scan goes on in the next
+// region, from its resume key.
+func (c *StreamChunk) endsRegion() bool {
+	return c.RegionError.GetKeyNotInRegion() != nil && len(c.ResumeKey) > 0
+}
+
+// RecvFunc receives the next chunk of a stream, or io.EOF at its end.
+type RecvFunc func() (*StreamChunk, error)
+
+// OpenStreamFunc starts a streaming scan on region from startKey through
+// client. The stream must end when ctx is done.
+type OpenStreamFunc func(ctx context.Context, client tikvpb.TikvClient, region *Region, startKey []byte) (RecvFunc, error)
+
+// StreamScanner reads a streaming scan region after region, reopening the
+// stream from the last resume key at region boundaries and on region errors.
+type StreamScanner struct {
+	sender  *Sender
+	ctx     context.Context
+	reverse bool
+	open    OpenStreamFunc
+
+	// key is where the next stream starts.
+	key     []byte
+	recv    RecvFunc
+	cancel  context.CancelFunc
+	pending *StreamChunk
+	done    bool
+}
+
+// NewStreamScanner creates a StreamScanner starting from startKey. For a
+// reverse scan startKey is the exclusive upper bound, and empty means +inf.
+func (s *Sender) NewStreamScanner(ctx context.Context, startKey []byte, reverse bool, open OpenStreamFunc) *StreamScanner {
+	return &StreamScanner{sender: s, ctx: ctx, reverse: reverse, open: open, key: startKey}
+}
+
+// Next returns the pairs of the next chunk, or io.EOF after the last one.
+func (sc *StreamScanner) Next() ([]*kvrpcpb.KvPair, error) {
+	for {
+		if chunk := sc.pending; chunk != nil {
+			sc.pending, sc.key = nil, chunk.ResumeKey
+			return chunk.Pairs, nil
+		}
+		if sc.done {
+			return nil, io.EOF
+		}
+		if sc.recv == nil {
+			if err := sc.openStream(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		chunk, err := sc.recv()
+		switch {
+		case err == io.EOF:
+			sc.closeStream()
+			sc.done = true
+		case err != nil:
+			sc.closeStream()
+			return nil, err
+		case chunk.endsRegion():
+			sc.closeStream()
+			sc.key = chunk.ResumeKey
+		case chunk.RegionError != nil:
+			// Reopen from the resume key, where the sender handles the error.
+			sc.closeStream()
+		default:
+			sc.key = chunk.ResumeKey
+			return chunk.Pairs, nil
+		}
+	}
+}
+
+// Restart makes the scanner go on from key, dropping what it has received
+// past key.
+func (sc *StreamScanner) Restart(key []byte) {
+	sc.closeStream()
+	sc.key, sc.pending, sc.done = key, nil, false
+}
+
+// Close stops the scan.
+func (sc *StreamScanner) Close() {
+	sc.closeStream()
+	sc.pending, sc.done = nil, true
+}
+
+// openStream opens a stream from sc.key and receives its first chunk, so
+// that region errors at the start are retried by the sender.
+func (sc *StreamScanner) openStream() error {
+	ctx, cancel := context.WithCancel(sc.ctx)
+	fn := func(ctx context.Context, client tikvpb.TikvClient, region *Region) (*errorpb.Error, error) {
+		recv, err := sc.open(ctx, client, region, sc.key)
+		if err != nil {
+			return nil, err
+		}
+		chunk, err := recv()
+		switch {
+		case err == io.EOF:
+			sc.done = true
+		case err != nil:
+			return nil, err
+		case chunk.endsRegion():
+			sc.key = chunk.ResumeKey
+		case chunk.RegionError != nil:
+			return chunk.RegionError, nil
+		default:
+			sc.recv, sc.pending = recv, chunk
+		}
+		return nil, nil
+	}
+	var err error
+	if sc.reverse {
+		err = sc.sender.SendEndKey(ctx, sc.key, fn)
+	} else {
+		err = sc.sender.SendKey(ctx, sc.key, fn)
+	}
+	if err != nil || sc.recv == nil {
+		cancel()
+		return err
+	}
+	sc.cancel = cancel
+	return nil
+}
+
+func (sc *StreamScanner) closeStream() {
+	if sc.cancel != nil {
+		sc.cancel()
+	}
+	sc.recv, sc.cancel = nil, nil
+}
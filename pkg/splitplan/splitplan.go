@@ -0,0 +1,106 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package splitplan estimates the data in a key range with GetRegionStats,
+// and splits the regions at the suggested keys, for example to spread a
+// bulk load over the stores before it starts.
+package splitplan
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// Planner sends the stats and split requests to the regions of a range.
+type Planner struct {
+	sender *regioncache.Sender
+}
+
+// NewPlanner creates a Planner.
+func NewPlanner(sender *regioncache.Sender) *Planner {
+	return &Planner{sender: sender}
+}
+
+// RangeStats is the estimated data in a key range.
+type RangeStats struct {
+	ApproximateSize uint64
+	ApproximateKeys uint64
+	// SplitKeys divide the range into chunks of about the requested size,
+	// in key order. A chunk does not span regions, and the boundaries of
+	// the regions are not among the keys.
+	SplitKeys [][]byte
+}
+
+// RangeStats estimates the data in [startKey, endKey) of all column
+// families, asking the regions one after another. An empty endKey means
+// +inf. If chunkSize is not 0, the stats suggest keys splitting the range
+// into chunks of about chunkSize bytes.
+func (p *Planner) RangeStats(ctx context.Context, startKey, endKey []byte, chunkSize uint64) (*RangeStats, error) {
+	stats := &RangeStats{}
+	for len(endKey) == 0 || bytes.Compare(startKey, endKey) < 0 {
+		var regionEnd []byte
+		err := p.sender.SendKey(ctx, startKey, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+			regionEnd = region.Meta.GetEndKey()
+			resp, err := client.GetRegionStats(ctx, &kvrpcpb.GetRegionStatsRequest{
+				Context:   region.Context(),
+				Range:     &kvrpcpb.KeyRange{StartKey: startKey, EndKey: endKey},
+				ChunkSize: chunkSize,
+			})
+			if err != nil || resp.GetRegionError() != nil {
+				return resp.GetRegionError(), err
+			}
+			stats.ApproximateSize += resp.GetApproximateSize()
+			stats.ApproximateKeys += resp.GetApproximateKeys()
+			stats.SplitKeys = append(stats.SplitKeys, resp.GetSplitKeys()...)
+			return nil, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(regionEnd) == 0 {
+			break
+		}
+		startKey = regionEnd
+	}
+	return stats, nil
+}
+
+// SplitRegions splits the regions at splitKeys, for example the split keys
+// of RangeStats. Keys that already start a region are skipped.
+func (p *Planner) SplitRegions(ctx context.Context, splitKeys [][]byte) error {
+	return p.sender.SendKeys(ctx, regioncache.DedupKeys(splitKeys), func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		var split [][]byte
+		for _, key := range keys {
+			if !bytes.Equal(key, region.Meta.GetStartKey()) {
+				split = append(split, key)
+			}
+		}
+		if len(split) == 0 {
+			return nil, nil
+		}
+		resp, err := client.SplitRegion(ctx, &kvrpcpb.SplitRegionRequest{
+			Context:   region.Context(),
+			SplitKeys: split,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		p.sender.Cache().OnEpochNotMatch(region.ID(), resp.GetRegions())
+		return nil, nil
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splitplan
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/kvproto/pkg/mocktikv/mocktikvtest"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+func TestRangeStats(t *testing.T) {
+	cluster := mocktikvtest.NewCluster(t, 1)
+	defer cluster.Close()
+	cluster.Split(t, "m")
+	sender := regioncache.NewSender(cluster.NewRegionCache(), func(context.Context, uint64) (tikvpb.TikvClient, error) {
+		return cluster.TikvClient(1), nil
+	}, nil)
+	planner := NewPlanner(sender)
+	ctx := context.Background()
+
+	// 100 pairs of 10 bytes before m, and 20 after.
+	for i := 0; i < 100; i++ {
+		cluster.MVCC.RawPut("", []byte(fmt.Sprintf("a%03d", i)), []byte("value!"), 0)
+	}
+	for i := 0; i < 20; i++ {
+		cluster.MVCC.RawPut("", []byte(fmt.Sprintf("n%03d", i)), []byte("value!"), 0)
+	}
+
+	stats, err := planner.RangeStats(ctx, nil, nil, 250)
+	if err != nil || stats.ApproximateSize != 1200 || stats.ApproximateKeys != 120 {
+		t.Fatal(err, stats)
+	}
+	if got := fmt.Sprintf("%s", stats.SplitKeys); got != "[a025 a050 a075]" {
+		t.Fatalf("unexpected split keys %s", got)
+	}
+	stats, err = planner.RangeStats(ctx, []byte("a050"), []byte("n010"), 0)
+	if err != nil || stats.ApproximateSize != 600 || stats.ApproximateKeys != 60 || len(stats.SplitKeys) != 0 {
+		t.Fatal(err, stats)
+	}
+
+	if err = planner.SplitRegions(ctx, [][]byte{[]byte("a025"), []byte("a050"), []byte("m"), []byte("a025")}); err != nil {
+		t.Fatal(err)
+	}
+	for key, start := range map[string]string{"a010": "", "a030": "a025", "a070": "a050", "n": "m"} {
+		region, err := sender.Cache().LocateKey(ctx, []byte(key))
+		if err != nil || string(region.Meta.GetStartKey()) != start {
+			t.Fatalf("expect %s in the region from %q, got %v %v", key, start, region, err)
+		}
+		resp, err := cluster.PDClient.GetRegion(ctx, &pdpb.GetRegionRequest{
+			Header:    cluster.Header(),
+			RegionKey: []byte(key),
+		})
+		if err != nil || resp.GetRegion().GetId() != region.ID() {
+			t.Fatalf("cached region %v differs from %v", region.Meta, resp.GetRegion())
+		}
+	}
+	// The stats add up across the new regions.
+	stats, err = planner.RangeStats(ctx, []byte("a020"), []byte("a060"), 0)
+	if err != nil || stats.ApproximateSize != 400 || stats.ApproximateKeys != 40 {
+		t.Fatal(err, stats)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikvpb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pingcap/kvproto/pkg/coprocessor"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+)
+
+// ErrUnsupportedCommand is returned when a request or response has no case
+// in the BatchCommands oneofs.
+var ErrUnsupportedCommand = errors.New("tikvpb: unsupported batch command")
+
+// ToBatchRequest wraps a single RPC request, such as a *kvrpcpb.GetRequest or
+// a *coprocessor.Request, into a BatchCommandsRequest_Request.
+func ToBatchRequest(req interface{}) (*BatchCommandsRequest_Request, error) {
+	switch r := req.(type) {
+	case *kvrpcpb.GetRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Get{Get: r}}, nil
+	case *kvrpcpb.ScanRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Scan{Scan: r}}, nil
+	case *kvrpcpb.PrewriteRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Prewrite{Prewrite: r}}, nil
+	case *kvrpcpb.CommitRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Commit{Commit: r}}, nil
+	case *kvrpcpb.ImportRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Import{Import: r}}, nil
+	case *kvrpcpb.CleanupRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Cleanup{Cleanup: r}}, nil
+	case *kvrpcpb.BatchGetRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_BatchGet{BatchGet: r}}, nil
+	case *kvrpcpb.BatchRollbackRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_BatchRollback{BatchRollback: r}}, nil
+	case *kvrpcpb.ScanLockRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_ScanLock{ScanLock: r}}, nil
+	case *kvrpcpb.ResolveLockRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_ResolveLock{ResolveLock: r}}, nil
+	case *kvrpcpb.GCRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_GC{GC: r}}, nil
+	case *kvrpcpb.DeleteRangeRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_DeleteRange{DeleteRange: r}}, nil
+	case *kvrpcpb.RawGetRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawGet{RawGet: r}}, nil
+	case *kvrpcpb.RawBatchGetRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawBatchGet{RawBatchGet: r}}, nil
+	case *kvrpcpb.RawPutRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawPut{RawPut: r}}, nil
+	case *kvrpcpb.RawBatchPutRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawBatchPut{RawBatchPut: r}}, nil
+	case *kvrpcpb.RawDeleteRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawDelete{RawDelete: r}}, nil
+	case *kvrpcpb.RawBatchDeleteRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawBatchDelete{RawBatchDelete: r}}, nil
+	case *kvrpcpb.RawScanRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawScan{RawScan: r}}, nil
+	case *kvrpcpb.RawDeleteRangeRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawDeleteRange{RawDeleteRange: r}}, nil
+	case *kvrpcpb.RawBatchScanRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawBatchScan{RawBatchScan: r}}, nil
+	case *coprocessor.Request:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Coprocessor{Coprocessor: r}}, nil
+	case *kvrpcpb.PessimisticLockRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_PessimisticLock{PessimisticLock: r}}, nil
+	case *kvrpcpb.PessimisticRollbackRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_PessimisticRollback{PessimisticRollback: r}}, nil
+	case *kvrpcpb.CheckTxnStatusRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_CheckTxnStatus{CheckTxnStatus: r}}, nil
+	case *kvrpcpb.TxnHeartBeatRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_TxnHeartBeat{TxnHeartBeat: r}}, nil
+	case *kvrpcpb.RawCompareAndSwapRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawCompareAndSwap{RawCompareAndSwap: r}}, nil
+	case *kvrpcpb.RawGetKeyTTLRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_RawGetKeyTTL{RawGetKeyTTL: r}}, nil
+	case *kvrpcpb.ChecksumRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Checksum{Checksum: r}}, nil
+	case *kvrpcpb.GetRegionStatsRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_GetRegionStats{GetRegionStats: r}}, nil
+	case *BatchCommandsEmptyRequest:
+		return &BatchCommandsRequest_Request{Cmd: &BatchCommandsRequest_Request_Empty{Empty: r}}, nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedCommand, req)
+}
+
+// FromBatchRequest unwraps the single RPC request carried by req.
+func FromBatchRequest(req *BatchCommandsRequest_Request) (interface{}, error) {
+	switch r := req.GetCmd().(type) {
+	case *BatchCommandsRequest_Request_Get:
+		return r.Get, nil
+	case *BatchCommandsRequest_Request_Scan:
+		return r.Scan, nil
+	case *BatchCommandsRequest_Request_Prewrite:
+		return r.Prewrite, nil
+	case *BatchCommandsRequest_Request_Commit:
+		return r.Commit, nil
+	case *BatchCommandsRequest_Request_Import:
+		return r.Import, nil
+	case *BatchCommandsRequest_Request_Cleanup:
+		return r.Cleanup, nil
+	case *BatchCommandsRequest_Request_BatchGet:
+		return r.BatchGet, nil
+	case *BatchCommandsRequest_Request_BatchRollback:
+		return r.BatchRollback, nil
+	case *BatchCommandsRequest_Request_ScanLock:
+		return r.ScanLock, nil
+	case *BatchCommandsRequest_Request_ResolveLock:
+		return r.ResolveLock, nil
+	case *BatchCommandsRequest_Request_GC:
+		return r.GC, nil
+	case *BatchCommandsRequest_Request_DeleteRange:
+		return r.DeleteRange, nil
+	case *BatchCommandsRequest_Request_RawGet:
+		return r.RawGet, nil
+	case *BatchCommandsRequest_Request_RawBatchGet:
+		return r.RawBatchGet, nil
+	case *BatchCommandsRequest_Request_RawPut:
+		return r.RawPut, nil
+	case *BatchCommandsRequest_Request_RawBatchPut:
+		return r.RawBatchPut, nil
+	case *BatchCommandsRequest_Request_RawDelete:
+		return r.RawDelete, nil
+	case *BatchCommandsRequest_Request_RawBatchDelete:
+		return r.RawBatchDelete, nil
+	case *BatchCommandsRequest_Request_RawScan:
+		return r.RawScan, nil
+	case *BatchCommandsRequest_Request_RawDeleteRange:
+		return r.RawDeleteRange, nil
+	case *BatchCommandsRequest_Request_RawBatchScan:
+		return r.RawBatchScan, nil
+	case *BatchCommandsRequest_Request_Coprocessor:
+		return r.Coprocessor, nil
+	case *BatchCommandsRequest_Request_PessimisticLock:
+		return r.PessimisticLock, nil
+	case *BatchCommandsRequest_Request_PessimisticRollback:
+		return r.PessimisticRollback, nil
+	case *BatchCommandsRequest_Request_CheckTxnStatus:
+		return r.CheckTxnStatus, nil
+	case *BatchCommandsRequest_Request_TxnHeartBeat:
+		return r.TxnHeartBeat, nil
+	case *BatchCommandsRequest_Request_RawCompareAndSwap:
+		return r.RawCompareAndSwap, nil
+	case *BatchCommandsRequest_Request_RawGetKeyTTL:
+		return r.RawGetKeyTTL, nil
+	case *BatchCommandsRequest_Request_Checksum:
+		return r.Checksum, nil
+	case *BatchCommandsRequest_Request_GetRegionStats:
+		return r.GetRegionStats, nil
+	case *BatchCommandsRequest_Request_Empty:
+		return r.Empty, nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedCommand, req.GetCmd())
+}
+
+// ToBatchResponse wraps a single RPC response into a
+// BatchCommandsResponse_Response.
+func ToBatchResponse(resp interface{}) (*BatchCommandsResponse_Response, error) {
+	switch r := resp.(type) {
+	case *kvrpcpb.GetResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Get{Get: r}}, nil
+	case *kvrpcpb.ScanResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Scan{Scan: r}}, nil
+	case *kvrpcpb.PrewriteResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Prewrite{Prewrite: r}}, nil
+	case *kvrpcpb.CommitResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Commit{Commit: r}}, nil
+	case *kvrpcpb.ImportResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Import{Import: r}}, nil
+	case *kvrpcpb.CleanupResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Cleanup{Cleanup: r}}, nil
+	case *kvrpcpb.BatchGetResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_BatchGet{BatchGet: r}}, nil
+	case *kvrpcpb.BatchRollbackResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_BatchRollback{BatchRollback: r}}, nil
+	case *kvrpcpb.ScanLockResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_ScanLock{ScanLock: r}}, nil
+	case *kvrpcpb.ResolveLockResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_ResolveLock{ResolveLock: r}}, nil
+	case *kvrpcpb.GCResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_GC{GC: r}}, nil
+	case *kvrpcpb.DeleteRangeResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_DeleteRange{DeleteRange: r}}, nil
+	case *kvrpcpb.RawGetResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawGet{RawGet: r}}, nil
+	case *kvrpcpb.RawBatchGetResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawBatchGet{RawBatchGet: r}}, nil
+	case *kvrpcpb.RawPutResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawPut{RawPut: r}}, nil
+	case *kvrpcpb.RawBatchPutResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawBatchPut{RawBatchPut: r}}, nil
+	case *kvrpcpb.RawDeleteResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawDelete{RawDelete: r}}, nil
+	case *kvrpcpb.RawBatchDeleteResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawBatchDelete{RawBatchDelete: r}}, nil
+	case *kvrpcpb.RawScanResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawScan{RawScan: r}}, nil
+	case *kvrpcpb.RawDeleteRangeResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawDeleteRange{RawDeleteRange: r}}, nil
+	case *kvrpcpb.RawBatchScanResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawBatchScan{RawBatchScan: r}}, nil
+	case *coprocessor.Response:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Coprocessor{Coprocessor: r}}, nil
+	case *kvrpcpb.PessimisticLockResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_PessimisticLock{PessimisticLock: r}}, nil
+	case *kvrpcpb.PessimisticRollbackResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_PessimisticRollback{PessimisticRollback: r}}, nil
+	case *kvrpcpb.CheckTxnStatusResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_CheckTxnStatus{CheckTxnStatus: r}}, nil
+	case *kvrpcpb.TxnHeartBeatResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_TxnHeartBeat{TxnHeartBeat: r}}, nil
+	case *kvrpcpb.RawCompareAndSwapResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawCompareAndSwap{RawCompareAndSwap: r}}, nil
+	case *kvrpcpb.RawGetKeyTTLResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_RawGetKeyTTL{RawGetKeyTTL: r}}, nil
+	case *kvrpcpb.ChecksumResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Checksum{Checksum: r}}, nil
+	case *kvrpcpb.GetRegionStatsResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_GetRegionStats{GetRegionStats: r}}, nil
+	case *BatchCommandsEmptyResponse:
+		return &BatchCommandsResponse_Response{Cmd: &BatchCommandsResponse_Response_Empty{Empty: r}}, nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedCommand, resp)
+}
+
+// FromBatchResponse unwraps the single RPC response carried by resp, such as
+// a *kvrpcpb.GetResponse for a Get command.
+func FromBatchResponse(resp *BatchCommandsResponse_Response) (interface{}, error) {
+	switch r := resp.GetCmd().(type) {
+	case *BatchCommandsResponse_Response_Get:
+		return r.Get, nil
+	case *BatchCommandsResponse_Response_Scan:
+		return r.Scan, nil
+	case *BatchCommandsResponse_Response_Prewrite:
+		return r.Prewrite, nil
+	case *BatchCommandsResponse_Response_Commit:
+		return r.Commit, nil
+	case *BatchCommandsResponse_Response_Import:
+		return r.Import, nil
+	case *BatchCommandsResponse_Response_Cleanup:
+		return r.Cleanup, nil
+	case *BatchCommandsResponse_Response_BatchGet:
+		return r.BatchGet, nil
+	case *BatchCommandsResponse_Response_BatchRollback:
+		return r.BatchRollback, nil
+	case *BatchCommandsResponse_Response_ScanLock:
+		return r.ScanLock, nil
+	case *BatchCommandsResponse_Response_ResolveLock:
+		return r.ResolveLock, nil
+	case *BatchCommandsResponse_Response_GC:
+		return r.GC, nil
+	case *BatchCommandsResponse_Response_DeleteRange:
+		return r.DeleteRange, nil
+	case *BatchCommandsResponse_Response_RawGet:
+		return r.RawGet, nil
+	case *BatchCommandsResponse_Response_RawBatchGet:
+		return r.RawBatchGet, nil
+	case *BatchCommandsResponse_Response_RawPut:
+		return r.RawPut, nil
+	case *BatchCommandsResponse_Response_RawBatchPut:
+		return r.RawBatchPut, nil
+	case *BatchCommandsResponse_Response_RawDelete:
+		return r.RawDelete, nil
+	case *BatchCommandsResponse_Response_RawBatchDelete:
+		return r.RawBatchDelete, nil
+	case *BatchCommandsResponse_Response_RawScan:
+		return r.RawScan, nil
+	case *BatchCommandsResponse_Response_RawDeleteRange:
+		return r.RawDeleteRange, nil
+	case *BatchCommandsResponse_Response_RawBatchScan:
+		return r.RawBatchScan, nil
+	case *BatchCommandsResponse_Response_Coprocessor:
+		return r.Coprocessor, nil
+	case *BatchCommandsResponse_Response_PessimisticLock:
+		return r.PessimisticLock, nil
+	case *BatchCommandsResponse_Response_PessimisticRollback:
+		return r.PessimisticRollback, nil
+	case *BatchCommandsResponse_Response_CheckTxnStatus:
+		return r.CheckTxnStatus, nil
+	case *BatchCommandsResponse_Response_TxnHeartBeat:
+		return r.TxnHeartBeat, nil
+	case *BatchCommandsResponse_Response_RawCompareAndSwap:
+		return r.RawCompareAndSwap, nil
+	case *BatchCommandsResponse_Response_RawGetKeyTTL:
+		return r.RawGetKeyTTL, nil
+	case *BatchCommandsResponse_Response_Checksum:
+		return r.Checksum, nil
+	case *BatchCommandsResponse_Response_GetRegionStats:
+		return r.GetRegionStats, nil
+	case *BatchCommandsResponse_Response_Empty:
+		return r.Empty, nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedCommand, resp.GetCmd())
+}
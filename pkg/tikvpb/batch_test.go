@@ -0,0 +1,87 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikvpb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+)
+
+// newOneofValues returns, for every wrapper type of a oneof, a fresh wrapper
+// and the message it carries. The wrappers come from the generated code, so
+// a case added to the proto shows up here without touching the test.
+func newOneofValues(wrappers []interface{}) (cmds, msgs []interface{}) {
+	for _, w := range wrappers {
+		cmd := reflect.New(reflect.TypeOf(w).Elem())
+		field := cmd.Elem().Field(0)
+		field.Set(reflect.New(field.Type().Elem()))
+		cmds = append(cmds, cmd.Interface())
+		msgs = append(msgs, field.Interface())
+	}
+	return cmds, msgs
+}
+
+func TestBatchRequestMapping(t *testing.T) {
+	_, _, _, wrappers := (*BatchCommandsRequest_Request)(nil).XXX_OneofFuncs()
+	cmds, msgs := newOneofValues(wrappers)
+	for i, msg := range msgs {
+		req, err := ToBatchRequest(msg)
+		if err != nil {
+			t.Fatalf("%T has no mapping: %v", msg, err)
+		}
+		if reflect.TypeOf(req.GetCmd()) != reflect.TypeOf(cmds[i]) {
+			t.Fatalf("%T is wrapped in %T, expect %T", msg, req.GetCmd(), cmds[i])
+		}
+		back, err := FromBatchRequest(req)
+		if err != nil || back != msg {
+			t.Fatalf("%T does not round trip: %v", msg, err)
+		}
+	}
+}
+
+func TestBatchResponseMapping(t *testing.T) {
+	_, _, _, wrappers := (*BatchCommandsResponse_Response)(nil).XXX_OneofFuncs()
+	cmds, msgs := newOneofValues(wrappers)
+	for i, msg := range msgs {
+		resp, err := ToBatchResponse(msg)
+		if err != nil {
+			t.Fatalf("%T has no mapping: %v", msg, err)
+		}
+		if reflect.TypeOf(resp.GetCmd()) != reflect.TypeOf(cmds[i]) {
+			t.Fatalf("%T is wrapped in %T, expect %T", msg, resp.GetCmd(), cmds[i])
+		}
+		back, err := FromBatchResponse(resp)
+		if err != nil || back != msg {
+			t.Fatalf("%T does not round trip: %v", msg, err)
+		}
+	}
+}
+
+func TestUnsupportedCommand(t *testing.T) {
+	if _, err := ToBatchRequest(&kvrpcpb.MvccGetByKeyRequest{}); !errors.Is(err, ErrUnsupportedCommand) {
+		t.Fatalf("expect unsupported command, got %v", err)
+	}
+	if _, err := ToBatchResponse(&kvrpcpb.MvccGetByKeyResponse{}); !errors.Is(err, ErrUnsupportedCommand) {
+		t.Fatalf("expect unsupported command, got %v", err)
+	}
+	if _, err := FromBatchRequest(&BatchCommandsRequest_Request{}); !errors.Is(err, ErrUnsupportedCommand) {
+		t.Fatalf("expect unsupported command, got %v", err)
+	}
+	if _, err := FromBatchResponse(&BatchCommandsResponse_Response{}); !errors.Is(err, ErrUnsupportedCommand) {
+		t.Fatalf("expect unsupported command, got %v", err)
+	}
+}
@@ -46,7 +46,7 @@ func (m *BatchCommandsRequest) Reset()         { *m = BatchCommandsRequest{} }
 func (m *BatchCommandsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchCommandsRequest) ProtoMessage()    {}
 func (*BatchCommandsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{0}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{0}
 }
 func (m *BatchCommandsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,6 +117,10 @@ type BatchCommandsRequest_Request struct {
 	//	*BatchCommandsRequest_Request_PessimisticRollback
 	//	*BatchCommandsRequest_Request_CheckTxnStatus
 	//	*BatchCommandsRequest_Request_TxnHeartBeat
+	//	*BatchCommandsRequest_Request_RawCompareAndSwap
+	//	*BatchCommandsRequest_Request_RawGetKeyTTL
+	//	*BatchCommandsRequest_Request_Checksum
+	//	*BatchCommandsRequest_Request_GetRegionStats
 	//	*BatchCommandsRequest_Request_Empty
 	Cmd                  isBatchCommandsRequest_Request_Cmd `protobuf_oneof:"cmd"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
@@ -128,7 +132,7 @@ func (m *BatchCommandsRequest_Request) Reset()         { *m = BatchCommandsReque
 func (m *BatchCommandsRequest_Request) String() string { return proto.CompactTextString(m) }
 func (*BatchCommandsRequest_Request) ProtoMessage()    {}
 func (*BatchCommandsRequest_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{0, 0}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{0, 0}
 }
 func (m *BatchCommandsRequest_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,6 +245,18 @@ type BatchCommandsRequest_Request_CheckTxnStatus struct {
 type BatchCommandsRequest_Request_TxnHeartBeat struct {
 	TxnHeartBeat *kvrpcpb.TxnHeartBeatRequest `protobuf:"bytes,26,opt,name=TxnHeartBeat,oneof"`
 }
+type BatchCommandsRequest_Request_RawCompareAndSwap struct {
+	RawCompareAndSwap *kvrpcpb.RawCompareAndSwapRequest `protobuf:"bytes,27,opt,name=RawCompareAndSwap,oneof"`
+}
+type BatchCommandsRequest_Request_RawGetKeyTTL struct {
+	RawGetKeyTTL *kvrpcpb.RawGetKeyTTLRequest `protobuf:"bytes,28,opt,name=RawGetKeyTTL,oneof"`
+}
+type BatchCommandsRequest_Request_Checksum struct {
+	Checksum *kvrpcpb.ChecksumRequest `protobuf:"bytes,29,opt,name=Checksum,oneof"`
+}
+type BatchCommandsRequest_Request_GetRegionStats struct {
+	GetRegionStats *kvrpcpb.GetRegionStatsRequest `protobuf:"bytes,30,opt,name=GetRegionStats,oneof"`
+}
 type BatchCommandsRequest_Request_Empty struct {
 	Empty *BatchCommandsEmptyRequest `protobuf:"bytes,255,opt,name=Empty,oneof"`
 }
@@ -271,6 +287,10 @@ func (*BatchCommandsRequest_Request_PessimisticLock) isBatchCommandsRequest_Requ
 func (*BatchCommandsRequest_Request_PessimisticRollback) isBatchCommandsRequest_Request_Cmd() {}
 func (*BatchCommandsRequest_Request_CheckTxnStatus) isBatchCommandsRequest_Request_Cmd()      {}
 func (*BatchCommandsRequest_Request_TxnHeartBeat) isBatchCommandsRequest_Request_Cmd()        {}
+func (*BatchCommandsRequest_Request_RawCompareAndSwap) isBatchCommandsRequest_Request_Cmd()   {}
+func (*BatchCommandsRequest_Request_RawGetKeyTTL) isBatchCommandsRequest_Request_Cmd()        {}
+func (*BatchCommandsRequest_Request_Checksum) isBatchCommandsRequest_Request_Cmd()            {}
+func (*BatchCommandsRequest_Request_GetRegionStats) isBatchCommandsRequest_Request_Cmd()      {}
 func (*BatchCommandsRequest_Request_Empty) isBatchCommandsRequest_Request_Cmd()               {}
 
 func (m *BatchCommandsRequest_Request) GetCmd() isBatchCommandsRequest_Request_Cmd {
@@ -462,6 +482,34 @@ func (m *BatchCommandsRequest_Request) GetTxnHeartBeat() *kvrpcpb.TxnHeartBeatRe
 	return nil
 }
 
+func (m *BatchCommandsRequest_Request) GetRawCompareAndSwap() *kvrpcpb.RawCompareAndSwapRequest {
+	if x, ok := m.GetCmd().(*BatchCommandsRequest_Request_RawCompareAndSwap); ok {
+		return x.RawCompareAndSwap
+	}
+	return nil
+}
+
+func (m *BatchCommandsRequest_Request) GetRawGetKeyTTL() *kvrpcpb.RawGetKeyTTLRequest {
+	if x, ok := m.GetCmd().(*BatchCommandsRequest_Request_RawGetKeyTTL); ok {
+		return x.RawGetKeyTTL
+	}
+	return nil
+}
+
+func (m *BatchCommandsRequest_Request) GetChecksum() *kvrpcpb.ChecksumRequest {
+	if x, ok := m.GetCmd().(*BatchCommandsRequest_Request_Checksum); ok {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (m *BatchCommandsRequest_Request) GetGetRegionStats() *kvrpcpb.GetRegionStatsRequest {
+	if x, ok := m.GetCmd().(*BatchCommandsRequest_Request_GetRegionStats); ok {
+		return x.GetRegionStats
+	}
+	return nil
+}
+
 func (m *BatchCommandsRequest_Request) GetEmpty() *BatchCommandsEmptyRequest {
 	if x, ok := m.GetCmd().(*BatchCommandsRequest_Request_Empty); ok {
 		return x.Empty
@@ -498,6 +546,10 @@ func (*BatchCommandsRequest_Request) XXX_OneofFuncs() (func(msg proto.Message, b
 		(*BatchCommandsRequest_Request_PessimisticRollback)(nil),
 		(*BatchCommandsRequest_Request_CheckTxnStatus)(nil),
 		(*BatchCommandsRequest_Request_TxnHeartBeat)(nil),
+		(*BatchCommandsRequest_Request_RawCompareAndSwap)(nil),
+		(*BatchCommandsRequest_Request_RawGetKeyTTL)(nil),
+		(*BatchCommandsRequest_Request_Checksum)(nil),
+		(*BatchCommandsRequest_Request_GetRegionStats)(nil),
 		(*BatchCommandsRequest_Request_Empty)(nil),
 	}
 }
@@ -636,6 +688,26 @@ func _BatchCommandsRequest_Request_OneofMarshaler(msg proto.Message, b *proto.Bu
 		if err := b.EncodeMessage(x.TxnHeartBeat); err != nil {
 			return err
 		}
+	case *BatchCommandsRequest_Request_RawCompareAndSwap:
+		_ = b.EncodeVarint(27<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RawCompareAndSwap); err != nil {
+			return err
+		}
+	case *BatchCommandsRequest_Request_RawGetKeyTTL:
+		_ = b.EncodeVarint(28<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RawGetKeyTTL); err != nil {
+			return err
+		}
+	case *BatchCommandsRequest_Request_Checksum:
+		_ = b.EncodeVarint(29<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Checksum); err != nil {
+			return err
+		}
+	case *BatchCommandsRequest_Request_GetRegionStats:
+		_ = b.EncodeVarint(30<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetRegionStats); err != nil {
+			return err
+		}
 	case *BatchCommandsRequest_Request_Empty:
 		_ = b.EncodeVarint(255<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Empty); err != nil {
@@ -859,6 +931,38 @@ func _BatchCommandsRequest_Request_OneofUnmarshaler(msg proto.Message, tag, wire
 		err := b.DecodeMessage(msg)
 		m.Cmd = &BatchCommandsRequest_Request_TxnHeartBeat{msg}
 		return true, err
+	case 27: // cmd.RawCompareAndSwap
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.RawCompareAndSwapRequest)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsRequest_Request_RawCompareAndSwap{msg}
+		return true, err
+	case 28: // cmd.RawGetKeyTTL
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.RawGetKeyTTLRequest)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsRequest_Request_RawGetKeyTTL{msg}
+		return true, err
+	case 29: // cmd.Checksum
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.ChecksumRequest)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsRequest_Request_Checksum{msg}
+		return true, err
+	case 30: // cmd.GetRegionStats
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.GetRegionStatsRequest)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsRequest_Request_GetRegionStats{msg}
+		return true, err
 	case 255: // cmd.Empty
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -1006,6 +1110,26 @@ func _BatchCommandsRequest_Request_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *BatchCommandsRequest_Request_RawCompareAndSwap:
+		s := proto.Size(x.RawCompareAndSwap)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCommandsRequest_Request_RawGetKeyTTL:
+		s := proto.Size(x.RawGetKeyTTL)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCommandsRequest_Request_Checksum:
+		s := proto.Size(x.Checksum)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCommandsRequest_Request_GetRegionStats:
+		s := proto.Size(x.GetRegionStats)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *BatchCommandsRequest_Request_Empty:
 		s := proto.Size(x.Empty)
 		n += 2 // tag and wire
@@ -1032,7 +1156,7 @@ func (m *BatchCommandsResponse) Reset()         { *m = BatchCommandsResponse{} }
 func (m *BatchCommandsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchCommandsResponse) ProtoMessage()    {}
 func (*BatchCommandsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{1}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{1}
 }
 func (m *BatchCommandsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,6 +1234,10 @@ type BatchCommandsResponse_Response struct {
 	//	*BatchCommandsResponse_Response_PessimisticRollback
 	//	*BatchCommandsResponse_Response_CheckTxnStatus
 	//	*BatchCommandsResponse_Response_TxnHeartBeat
+	//	*BatchCommandsResponse_Response_RawCompareAndSwap
+	//	*BatchCommandsResponse_Response_RawGetKeyTTL
+	//	*BatchCommandsResponse_Response_Checksum
+	//	*BatchCommandsResponse_Response_GetRegionStats
 	//	*BatchCommandsResponse_Response_Empty
 	Cmd                  isBatchCommandsResponse_Response_Cmd `protobuf_oneof:"cmd"`
 	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
@@ -1121,7 +1249,7 @@ func (m *BatchCommandsResponse_Response) Reset()         { *m = BatchCommandsRes
 func (m *BatchCommandsResponse_Response) String() string { return proto.CompactTextString(m) }
 func (*BatchCommandsResponse_Response) ProtoMessage()    {}
 func (*BatchCommandsResponse_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{1, 0}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{1, 0}
 }
 func (m *BatchCommandsResponse_Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,6 +1362,18 @@ type BatchCommandsResponse_Response_CheckTxnStatus struct {
 type BatchCommandsResponse_Response_TxnHeartBeat struct {
 	TxnHeartBeat *kvrpcpb.TxnHeartBeatResponse `protobuf:"bytes,26,opt,name=TxnHeartBeat,oneof"`
 }
+type BatchCommandsResponse_Response_RawCompareAndSwap struct {
+	RawCompareAndSwap *kvrpcpb.RawCompareAndSwapResponse `protobuf:"bytes,27,opt,name=RawCompareAndSwap,oneof"`
+}
+type BatchCommandsResponse_Response_RawGetKeyTTL struct {
+	RawGetKeyTTL *kvrpcpb.RawGetKeyTTLResponse `protobuf:"bytes,28,opt,name=RawGetKeyTTL,oneof"`
+}
+type BatchCommandsResponse_Response_Checksum struct {
+	Checksum *kvrpcpb.ChecksumResponse `protobuf:"bytes,29,opt,name=Checksum,oneof"`
+}
+type BatchCommandsResponse_Response_GetRegionStats struct {
+	GetRegionStats *kvrpcpb.GetRegionStatsResponse `protobuf:"bytes,30,opt,name=GetRegionStats,oneof"`
+}
 type BatchCommandsResponse_Response_Empty struct {
 	Empty *BatchCommandsEmptyResponse `protobuf:"bytes,255,opt,name=Empty,oneof"`
 }
@@ -1264,6 +1404,10 @@ func (*BatchCommandsResponse_Response_PessimisticLock) isBatchCommandsResponse_R
 func (*BatchCommandsResponse_Response_PessimisticRollback) isBatchCommandsResponse_Response_Cmd() {}
 func (*BatchCommandsResponse_Response_CheckTxnStatus) isBatchCommandsResponse_Response_Cmd()      {}
 func (*BatchCommandsResponse_Response_TxnHeartBeat) isBatchCommandsResponse_Response_Cmd()        {}
+func (*BatchCommandsResponse_Response_RawCompareAndSwap) isBatchCommandsResponse_Response_Cmd()   {}
+func (*BatchCommandsResponse_Response_RawGetKeyTTL) isBatchCommandsResponse_Response_Cmd()        {}
+func (*BatchCommandsResponse_Response_Checksum) isBatchCommandsResponse_Response_Cmd()            {}
+func (*BatchCommandsResponse_Response_GetRegionStats) isBatchCommandsResponse_Response_Cmd()      {}
 func (*BatchCommandsResponse_Response_Empty) isBatchCommandsResponse_Response_Cmd()               {}
 
 func (m *BatchCommandsResponse_Response) GetCmd() isBatchCommandsResponse_Response_Cmd {
@@ -1455,6 +1599,34 @@ func (m *BatchCommandsResponse_Response) GetTxnHeartBeat() *kvrpcpb.TxnHeartBeat
 	return nil
 }
 
+func (m *BatchCommandsResponse_Response) GetRawCompareAndSwap() *kvrpcpb.RawCompareAndSwapResponse {
+	if x, ok := m.GetCmd().(*BatchCommandsResponse_Response_RawCompareAndSwap); ok {
+		return x.RawCompareAndSwap
+	}
+	return nil
+}
+
+func (m *BatchCommandsResponse_Response) GetRawGetKeyTTL() *kvrpcpb.RawGetKeyTTLResponse {
+	if x, ok := m.GetCmd().(*BatchCommandsResponse_Response_RawGetKeyTTL); ok {
+		return x.RawGetKeyTTL
+	}
+	return nil
+}
+
+func (m *BatchCommandsResponse_Response) GetChecksum() *kvrpcpb.ChecksumResponse {
+	if x, ok := m.GetCmd().(*BatchCommandsResponse_Response_Checksum); ok {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (m *BatchCommandsResponse_Response) GetGetRegionStats() *kvrpcpb.GetRegionStatsResponse {
+	if x, ok := m.GetCmd().(*BatchCommandsResponse_Response_GetRegionStats); ok {
+		return x.GetRegionStats
+	}
+	return nil
+}
+
 func (m *BatchCommandsResponse_Response) GetEmpty() *BatchCommandsEmptyResponse {
 	if x, ok := m.GetCmd().(*BatchCommandsResponse_Response_Empty); ok {
 		return x.Empty
@@ -1491,6 +1663,10 @@ func (*BatchCommandsResponse_Response) XXX_OneofFuncs() (func(msg proto.Message,
 		(*BatchCommandsResponse_Response_PessimisticRollback)(nil),
 		(*BatchCommandsResponse_Response_CheckTxnStatus)(nil),
 		(*BatchCommandsResponse_Response_TxnHeartBeat)(nil),
+		(*BatchCommandsResponse_Response_RawCompareAndSwap)(nil),
+		(*BatchCommandsResponse_Response_RawGetKeyTTL)(nil),
+		(*BatchCommandsResponse_Response_Checksum)(nil),
+		(*BatchCommandsResponse_Response_GetRegionStats)(nil),
 		(*BatchCommandsResponse_Response_Empty)(nil),
 	}
 }
@@ -1629,6 +1805,26 @@ func _BatchCommandsResponse_Response_OneofMarshaler(msg proto.Message, b *proto.
 		if err := b.EncodeMessage(x.TxnHeartBeat); err != nil {
 			return err
 		}
+	case *BatchCommandsResponse_Response_RawCompareAndSwap:
+		_ = b.EncodeVarint(27<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RawCompareAndSwap); err != nil {
+			return err
+		}
+	case *BatchCommandsResponse_Response_RawGetKeyTTL:
+		_ = b.EncodeVarint(28<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RawGetKeyTTL); err != nil {
+			return err
+		}
+	case *BatchCommandsResponse_Response_Checksum:
+		_ = b.EncodeVarint(29<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Checksum); err != nil {
+			return err
+		}
+	case *BatchCommandsResponse_Response_GetRegionStats:
+		_ = b.EncodeVarint(30<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetRegionStats); err != nil {
+			return err
+		}
 	case *BatchCommandsResponse_Response_Empty:
 		_ = b.EncodeVarint(255<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Empty); err != nil {
@@ -1852,6 +2048,38 @@ func _BatchCommandsResponse_Response_OneofUnmarshaler(msg proto.Message, tag, wi
 		err := b.DecodeMessage(msg)
 		m.Cmd = &BatchCommandsResponse_Response_TxnHeartBeat{msg}
 		return true, err
+	case 27: // cmd.RawCompareAndSwap
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.RawCompareAndSwapResponse)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsResponse_Response_RawCompareAndSwap{msg}
+		return true, err
+	case 28: // cmd.RawGetKeyTTL
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.RawGetKeyTTLResponse)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsResponse_Response_RawGetKeyTTL{msg}
+		return true, err
+	case 29: // cmd.Checksum
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.ChecksumResponse)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsResponse_Response_Checksum{msg}
+		return true, err
+	case 30: // cmd.GetRegionStats
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(kvrpcpb.GetRegionStatsResponse)
+		err := b.DecodeMessage(msg)
+		m.Cmd = &BatchCommandsResponse_Response_GetRegionStats{msg}
+		return true, err
 	case 255: // cmd.Empty
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -1999,6 +2227,26 @@ func _BatchCommandsResponse_Response_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *BatchCommandsResponse_Response_RawCompareAndSwap:
+		s := proto.Size(x.RawCompareAndSwap)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCommandsResponse_Response_RawGetKeyTTL:
+		s := proto.Size(x.RawGetKeyTTL)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCommandsResponse_Response_Checksum:
+		s := proto.Size(x.Checksum)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCommandsResponse_Response_GetRegionStats:
+		s := proto.Size(x.GetRegionStats)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *BatchCommandsResponse_Response_Empty:
 		s := proto.Size(x.Empty)
 		n += 2 // tag and wire
@@ -2022,7 +2270,7 @@ func (m *BatchRaftMessage) Reset()         { *m = BatchRaftMessage{} }
 func (m *BatchRaftMessage) String() string { return proto.CompactTextString(m) }
 func (*BatchRaftMessage) ProtoMessage()    {}
 func (*BatchRaftMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{2}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{2}
 }
 func (m *BatchRaftMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2072,7 +2320,7 @@ func (m *BatchCommandsEmptyRequest) Reset()         { *m = BatchCommandsEmptyReq
 func (m *BatchCommandsEmptyRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchCommandsEmptyRequest) ProtoMessage()    {}
 func (*BatchCommandsEmptyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{3}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{3}
 }
 func (m *BatchCommandsEmptyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2127,7 +2375,7 @@ func (m *BatchCommandsEmptyResponse) Reset()         { *m = BatchCommandsEmptyRe
 func (m *BatchCommandsEmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchCommandsEmptyResponse) ProtoMessage()    {}
 func (*BatchCommandsEmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_352285c788f1f4e2, []int{4}
+	return fileDescriptor_tikvpb_315f5852766b0b93, []int{4}
 }
 func (m *BatchCommandsEmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2201,6 +2449,9 @@ type TikvClient interface {
 	KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error)
 	KvGC(ctx context.Context, in *kvrpcpb.GCRequest, opts ...grpc.CallOption) (*kvrpcpb.GCResponse, error)
 	KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error)
+	// KvScanStream is KvScan streaming the pairs in chunks, see ScanStreamResponse.
+	KvScanStream(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (Tikv_KvScanStreamClient, error)
+	Checksum(ctx context.Context, in *kvrpcpb.ChecksumRequest, opts ...grpc.CallOption) (*kvrpcpb.ChecksumResponse, error)
 	// RawKV commands.
 	RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error)
 	RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error)
@@ -2211,6 +2462,10 @@ type TikvClient interface {
 	RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error)
 	RawDeleteRange(ctx context.Context, in *kvrpcpb.RawDeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteRangeResponse, error)
 	RawBatchScan(ctx context.Context, in *kvrpcpb.RawBatchScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchScanResponse, error)
+	RawCompareAndSwap(ctx context.Context, in *kvrpcpb.RawCompareAndSwapRequest, opts ...grpc.CallOption) (*kvrpcpb.RawCompareAndSwapResponse, error)
+	RawGetKeyTTL(ctx context.Context, in *kvrpcpb.RawGetKeyTTLRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetKeyTTLResponse, error)
+	// RawScanStream is RawScan streaming the pairs in chunks, see RawScanStreamResponse.
+	RawScanStream(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (Tikv_RawScanStreamClient, error)
 	// Store commands (to the whole tikv but not a certain region)
 	UnsafeDestroyRange(ctx context.Context, in *kvrpcpb.UnsafeDestroyRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.UnsafeDestroyRangeResponse, error)
 	// SQL push down commands.
@@ -2223,6 +2478,7 @@ type TikvClient interface {
 	// Region commands.
 	SplitRegion(ctx context.Context, in *kvrpcpb.SplitRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.SplitRegionResponse, error)
 	ReadIndex(ctx context.Context, in *kvrpcpb.ReadIndexRequest, opts ...grpc.CallOption) (*kvrpcpb.ReadIndexResponse, error)
+	GetRegionStats(ctx context.Context, in *kvrpcpb.GetRegionStatsRequest, opts ...grpc.CallOption) (*kvrpcpb.GetRegionStatsResponse, error)
 	// transaction debugger commands.
 	MvccGetByKey(ctx context.Context, in *kvrpcpb.MvccGetByKeyRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByKeyResponse, error)
 	MvccGetByStartTs(ctx context.Context, in *kvrpcpb.MvccGetByStartTsRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByStartTsResponse, error)
@@ -2381,6 +2637,47 @@ func (c *tikvClient) KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeR
 	return out, nil
 }
 
+func (c *tikvClient) KvScanStream(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (Tikv_KvScanStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[0], "/tikvpb.Tikv/KvScanStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvKvScanStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tikv_KvScanStreamClient interface {
+	Recv() (*kvrpcpb.ScanStreamResponse, error)
+	grpc.ClientStream
+}
+
+type tikvKvScanStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvKvScanStreamClient) Recv() (*kvrpcpb.ScanStreamResponse, error) {
+	m := new(kvrpcpb.ScanStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) Checksum(ctx context.Context, in *kvrpcpb.ChecksumRequest, opts ...grpc.CallOption) (*kvrpcpb.ChecksumResponse, error) {
+	out := new(kvrpcpb.ChecksumResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/Checksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tikvClient) RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error) {
 	out := new(kvrpcpb.RawGetResponse)
 	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawGet", in, out, opts...)
@@ -2462,6 +2759,56 @@ func (c *tikvClient) RawBatchScan(ctx context.Context, in *kvrpcpb.RawBatchScanR
 	return out, nil
 }
 
+func (c *tikvClient) RawCompareAndSwap(ctx context.Context, in *kvrpcpb.RawCompareAndSwapRequest, opts ...grpc.CallOption) (*kvrpcpb.RawCompareAndSwapResponse, error) {
+	out := new(kvrpcpb.RawCompareAndSwapResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawCompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) RawGetKeyTTL(ctx context.Context, in *kvrpcpb.RawGetKeyTTLRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetKeyTTLResponse, error) {
+	out := new(kvrpcpb.RawGetKeyTTLResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawGetKeyTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) RawScanStream(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (Tikv_RawScanStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[1], "/tikvpb.Tikv/RawScanStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvRawScanStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tikv_RawScanStreamClient interface {
+	Recv() (*kvrpcpb.RawScanStreamResponse, error)
+	grpc.ClientStream
+}
+
+type tikvRawScanStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvRawScanStreamClient) Recv() (*kvrpcpb.RawScanStreamResponse, error) {
+	m := new(kvrpcpb.RawScanStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *tikvClient) UnsafeDestroyRange(ctx context.Context, in *kvrpcpb.UnsafeDestroyRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.UnsafeDestroyRangeResponse, error) {
 	out := new(kvrpcpb.UnsafeDestroyRangeResponse)
 	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/UnsafeDestroyRange", in, out, opts...)
@@ -2481,7 +2828,7 @@ func (c *tikvClient) Coprocessor(ctx context.Context, in *coprocessor.Request, o
 }
 
 func (c *tikvClient) CoprocessorStream(ctx context.Context, in *coprocessor.Request, opts ...grpc.CallOption) (Tikv_CoprocessorStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[0], "/tikvpb.Tikv/CoprocessorStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[2], "/tikvpb.Tikv/CoprocessorStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2513,7 +2860,7 @@ func (x *tikvCoprocessorStreamClient) Recv() (*coprocessor.Response, error) {
 }
 
 func (c *tikvClient) Raft(ctx context.Context, opts ...grpc.CallOption) (Tikv_RaftClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[1], "/tikvpb.Tikv/Raft", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[3], "/tikvpb.Tikv/Raft", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2547,7 +2894,7 @@ func (x *tikvRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
 }
 
 func (c *tikvClient) BatchRaft(ctx context.Context, opts ...grpc.CallOption) (Tikv_BatchRaftClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[2], "/tikvpb.Tikv/BatchRaft", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[4], "/tikvpb.Tikv/BatchRaft", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2581,7 +2928,7 @@ func (x *tikvBatchRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
 }
 
 func (c *tikvClient) Snapshot(ctx context.Context, opts ...grpc.CallOption) (Tikv_SnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[3], "/tikvpb.Tikv/Snapshot", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[5], "/tikvpb.Tikv/Snapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2632,6 +2979,15 @@ func (c *tikvClient) ReadIndex(ctx context.Context, in *kvrpcpb.ReadIndexRequest
 	return out, nil
 }
 
+func (c *tikvClient) GetRegionStats(ctx context.Context, in *kvrpcpb.GetRegionStatsRequest, opts ...grpc.CallOption) (*kvrpcpb.GetRegionStatsResponse, error) {
+	out := new(kvrpcpb.GetRegionStatsResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/GetRegionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tikvClient) MvccGetByKey(ctx context.Context, in *kvrpcpb.MvccGetByKeyRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByKeyResponse, error) {
 	out := new(kvrpcpb.MvccGetByKeyResponse)
 	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/MvccGetByKey", in, out, opts...)
@@ -2651,7 +3007,7 @@ func (c *tikvClient) MvccGetByStartTs(ctx context.Context, in *kvrpcpb.MvccGetBy
 }
 
 func (c *tikvClient) BatchCommands(ctx context.Context, opts ...grpc.CallOption) (Tikv_BatchCommandsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[4], "/tikvpb.Tikv/BatchCommands", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[6], "/tikvpb.Tikv/BatchCommands", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2701,6 +3057,9 @@ type TikvServer interface {
 	KvResolveLock(context.Context, *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error)
 	KvGC(context.Context, *kvrpcpb.GCRequest) (*kvrpcpb.GCResponse, error)
 	KvDeleteRange(context.Context, *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error)
+	// KvScanStream is KvScan streaming the pairs in chunks, see ScanStreamResponse.
+	KvScanStream(*kvrpcpb.ScanRequest, Tikv_KvScanStreamServer) error
+	Checksum(context.Context, *kvrpcpb.ChecksumRequest) (*kvrpcpb.ChecksumResponse, error)
 	// RawKV commands.
 	RawGet(context.Context, *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error)
 	RawBatchGet(context.Context, *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error)
@@ -2711,6 +3070,10 @@ type TikvServer interface {
 	RawScan(context.Context, *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error)
 	RawDeleteRange(context.Context, *kvrpcpb.RawDeleteRangeRequest) (*kvrpcpb.RawDeleteRangeResponse, error)
 	RawBatchScan(context.Context, *kvrpcpb.RawBatchScanRequest) (*kvrpcpb.RawBatchScanResponse, error)
+	RawCompareAndSwap(context.Context, *kvrpcpb.RawCompareAndSwapRequest) (*kvrpcpb.RawCompareAndSwapResponse, error)
+	RawGetKeyTTL(context.Context, *kvrpcpb.RawGetKeyTTLRequest) (*kvrpcpb.RawGetKeyTTLResponse, error)
+	// RawScanStream is RawScan streaming the pairs in chunks, see RawScanStreamResponse.
+	RawScanStream(*kvrpcpb.RawScanRequest, Tikv_RawScanStreamServer) error
 	// Store commands (to the whole tikv but not a certain region)
 	UnsafeDestroyRange(context.Context, *kvrpcpb.UnsafeDestroyRangeRequest) (*kvrpcpb.UnsafeDestroyRangeResponse, error)
 	// SQL push down commands.
@@ -2723,6 +3086,7 @@ type TikvServer interface {
 	// Region commands.
 	SplitRegion(context.Context, *kvrpcpb.SplitRegionRequest) (*kvrpcpb.SplitRegionResponse, error)
 	ReadIndex(context.Context, *kvrpcpb.ReadIndexRequest) (*kvrpcpb.ReadIndexResponse, error)
+	GetRegionStats(context.Context, *kvrpcpb.GetRegionStatsRequest) (*kvrpcpb.GetRegionStatsResponse, error)
 	// transaction debugger commands.
 	MvccGetByKey(context.Context, *kvrpcpb.MvccGetByKeyRequest) (*kvrpcpb.MvccGetByKeyResponse, error)
 	MvccGetByStartTs(context.Context, *kvrpcpb.MvccGetByStartTsRequest) (*kvrpcpb.MvccGetByStartTsResponse, error)
@@ -3021,6 +3385,45 @@ func _Tikv_KvDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tikv_KvScanStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.ScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TikvServer).KvScanStream(m, &tikvKvScanStreamServer{stream})
+}
+
+type Tikv_KvScanStreamServer interface {
+	Send(*kvrpcpb.ScanStreamResponse) error
+	grpc.ServerStream
+}
+
+type tikvKvScanStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvKvScanStreamServer) Send(m *kvrpcpb.ScanStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tikv_Checksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).Checksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/Checksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).Checksum(ctx, req.(*kvrpcpb.ChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tikv_RawGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.RawGetRequest)
 	if err := dec(in); err != nil {
@@ -3183,69 +3586,126 @@ func _Tikv_RawBatchScan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tikv_UnsafeDestroyRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.UnsafeDestroyRangeRequest)
+func _Tikv_RawCompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawCompareAndSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TikvServer).UnsafeDestroyRange(ctx, in)
+		return srv.(TikvServer).RawCompareAndSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/UnsafeDestroyRange",
+		FullMethod: "/tikvpb.Tikv/RawCompareAndSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).UnsafeDestroyRange(ctx, req.(*kvrpcpb.UnsafeDestroyRangeRequest))
+		return srv.(TikvServer).RawCompareAndSwap(ctx, req.(*kvrpcpb.RawCompareAndSwapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tikv_Coprocessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(coprocessor.Request)
+func _Tikv_RawGetKeyTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawGetKeyTTLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TikvServer).Coprocessor(ctx, in)
+		return srv.(TikvServer).RawGetKeyTTL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/Coprocessor",
+		FullMethod: "/tikvpb.Tikv/RawGetKeyTTL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).Coprocessor(ctx, req.(*coprocessor.Request))
+		return srv.(TikvServer).RawGetKeyTTL(ctx, req.(*kvrpcpb.RawGetKeyTTLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tikv_CoprocessorStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(coprocessor.Request)
+func _Tikv_RawScanStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.RawScanRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(TikvServer).CoprocessorStream(m, &tikvCoprocessorStreamServer{stream})
+	return srv.(TikvServer).RawScanStream(m, &tikvRawScanStreamServer{stream})
 }
 
-type Tikv_CoprocessorStreamServer interface {
-	Send(*coprocessor.Response) error
+type Tikv_RawScanStreamServer interface {
+	Send(*kvrpcpb.RawScanStreamResponse) error
 	grpc.ServerStream
 }
 
-type tikvCoprocessorStreamServer struct {
+type tikvRawScanStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *tikvCoprocessorStreamServer) Send(m *coprocessor.Response) error {
+func (x *tikvRawScanStreamServer) Send(m *kvrpcpb.RawScanStreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Tikv_Raft_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(TikvServer).Raft(&tikvRaftServer{stream})
+func _Tikv_UnsafeDestroyRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.UnsafeDestroyRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).UnsafeDestroyRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/UnsafeDestroyRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).UnsafeDestroyRange(ctx, req.(*kvrpcpb.UnsafeDestroyRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Tikv_RaftServer interface {
-	SendAndClose(*raft_serverpb.Done) error
+func _Tikv_Coprocessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(coprocessor.Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).Coprocessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/Coprocessor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).Coprocessor(ctx, req.(*coprocessor.Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_CoprocessorStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(coprocessor.Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TikvServer).CoprocessorStream(m, &tikvCoprocessorStreamServer{stream})
+}
+
+type Tikv_CoprocessorStreamServer interface {
+	Send(*coprocessor.Response) error
+	grpc.ServerStream
+}
+
+type tikvCoprocessorStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvCoprocessorStreamServer) Send(m *coprocessor.Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tikv_Raft_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TikvServer).Raft(&tikvRaftServer{stream})
+}
+
+type Tikv_RaftServer interface {
+	SendAndClose(*raft_serverpb.Done) error
 	Recv() (*raft_serverpb.RaftMessage, error)
 	grpc.ServerStream
 }
@@ -3354,6 +3814,24 @@ func _Tikv_ReadIndex_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tikv_GetRegionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GetRegionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).GetRegionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/GetRegionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).GetRegionStats(ctx, req.(*kvrpcpb.GetRegionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tikv_MvccGetByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.MvccGetByKeyRequest)
 	if err := dec(in); err != nil {
@@ -3484,6 +3962,10 @@ var _Tikv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KvDeleteRange",
 			Handler:    _Tikv_KvDeleteRange_Handler,
 		},
+		{
+			MethodName: "Checksum",
+			Handler:    _Tikv_Checksum_Handler,
+		},
 		{
 			MethodName: "RawGet",
 			Handler:    _Tikv_RawGet_Handler,
@@ -3520,6 +4002,14 @@ var _Tikv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RawBatchScan",
 			Handler:    _Tikv_RawBatchScan_Handler,
 		},
+		{
+			MethodName: "RawCompareAndSwap",
+			Handler:    _Tikv_RawCompareAndSwap_Handler,
+		},
+		{
+			MethodName: "RawGetKeyTTL",
+			Handler:    _Tikv_RawGetKeyTTL_Handler,
+		},
 		{
 			MethodName: "UnsafeDestroyRange",
 			Handler:    _Tikv_UnsafeDestroyRange_Handler,
@@ -3536,6 +4026,10 @@ var _Tikv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadIndex",
 			Handler:    _Tikv_ReadIndex_Handler,
 		},
+		{
+			MethodName: "GetRegionStats",
+			Handler:    _Tikv_GetRegionStats_Handler,
+		},
 		{
 			MethodName: "MvccGetByKey",
 			Handler:    _Tikv_MvccGetByKey_Handler,
@@ -3546,6 +4040,16 @@ var _Tikv_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "KvScanStream",
+			Handler:       _Tikv_KvScanStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RawScanStream",
+			Handler:       _Tikv_RawScanStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "CoprocessorStream",
 			Handler:       _Tikv_CoprocessorStream_Handler,
@@ -4040,6 +4544,70 @@ func (m *BatchCommandsRequest_Request_TxnHeartBeat) MarshalTo(dAtA []byte) (int,
 	}
 	return i, nil
 }
+func (m *BatchCommandsRequest_Request_RawCompareAndSwap) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RawCompareAndSwap != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawCompareAndSwap.Size()))
+		n30, err := m.RawCompareAndSwap.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+func (m *BatchCommandsRequest_Request_RawGetKeyTTL) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RawGetKeyTTL != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawGetKeyTTL.Size()))
+		n31, err := m.RawGetKeyTTL.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+func (m *BatchCommandsRequest_Request_Checksum) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Checksum != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Checksum.Size()))
+		n32, err := m.Checksum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+func (m *BatchCommandsRequest_Request_GetRegionStats) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetRegionStats != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.GetRegionStats.Size()))
+		n33, err := m.GetRegionStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
 func (m *BatchCommandsRequest_Request_Empty) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.Empty != nil {
@@ -4048,11 +4616,11 @@ func (m *BatchCommandsRequest_Request_Empty) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0xf
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Empty.Size()))
-		n30, err := m.Empty.MarshalTo(dAtA[i:])
+		n34, err := m.Empty.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n34
 	}
 	return i, nil
 }
@@ -4084,21 +4652,21 @@ func (m *BatchCommandsResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RequestIds) > 0 {
-		dAtA32 := make([]byte, len(m.RequestIds)*10)
-		var j31 int
+		dAtA36 := make([]byte, len(m.RequestIds)*10)
+		var j35 int
 		for _, num := range m.RequestIds {
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j35++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA36[j35] = uint8(num)
+			j35++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTikvpb(dAtA, i, uint64(j31))
-		i += copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintTikvpb(dAtA, i, uint64(j35))
+		i += copy(dAtA[i:], dAtA36[:j35])
 	}
 	if m.TransportLayerLoad != 0 {
 		dAtA[i] = 0x18
@@ -4127,11 +4695,11 @@ func (m *BatchCommandsResponse_Response) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Cmd != nil {
-		nn33, err := m.Cmd.MarshalTo(dAtA[i:])
+		nn37, err := m.Cmd.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn33
+		i += nn37
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -4145,11 +4713,11 @@ func (m *BatchCommandsResponse_Response_Get) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Get.Size()))
-		n34, err := m.Get.MarshalTo(dAtA[i:])
+		n38, err := m.Get.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n38
 	}
 	return i, nil
 }
@@ -4159,11 +4727,11 @@ func (m *BatchCommandsResponse_Response_Scan) MarshalTo(dAtA []byte) (int, error
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Scan.Size()))
-		n35, err := m.Scan.MarshalTo(dAtA[i:])
+		n39, err := m.Scan.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n39
 	}
 	return i, nil
 }
@@ -4173,11 +4741,11 @@ func (m *BatchCommandsResponse_Response_Prewrite) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Prewrite.Size()))
-		n36, err := m.Prewrite.MarshalTo(dAtA[i:])
+		n40, err := m.Prewrite.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n40
 	}
 	return i, nil
 }
@@ -4187,11 +4755,11 @@ func (m *BatchCommandsResponse_Response_Commit) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Commit.Size()))
-		n37, err := m.Commit.MarshalTo(dAtA[i:])
+		n41, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n41
 	}
 	return i, nil
 }
@@ -4201,11 +4769,11 @@ func (m *BatchCommandsResponse_Response_Import) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Import.Size()))
-		n38, err := m.Import.MarshalTo(dAtA[i:])
+		n42, err := m.Import.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n42
 	}
 	return i, nil
 }
@@ -4215,11 +4783,11 @@ func (m *BatchCommandsResponse_Response_Cleanup) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Cleanup.Size()))
-		n39, err := m.Cleanup.MarshalTo(dAtA[i:])
+		n43, err := m.Cleanup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n43
 	}
 	return i, nil
 }
@@ -4229,11 +4797,11 @@ func (m *BatchCommandsResponse_Response_BatchGet) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.BatchGet.Size()))
-		n40, err := m.BatchGet.MarshalTo(dAtA[i:])
+		n44, err := m.BatchGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n44
 	}
 	return i, nil
 }
@@ -4243,11 +4811,11 @@ func (m *BatchCommandsResponse_Response_BatchRollback) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.BatchRollback.Size()))
-		n41, err := m.BatchRollback.MarshalTo(dAtA[i:])
+		n45, err := m.BatchRollback.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n45
 	}
 	return i, nil
 }
@@ -4257,11 +4825,11 @@ func (m *BatchCommandsResponse_Response_ScanLock) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.ScanLock.Size()))
-		n42, err := m.ScanLock.MarshalTo(dAtA[i:])
+		n46, err := m.ScanLock.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n46
 	}
 	return i, nil
 }
@@ -4271,11 +4839,11 @@ func (m *BatchCommandsResponse_Response_ResolveLock) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.ResolveLock.Size()))
-		n43, err := m.ResolveLock.MarshalTo(dAtA[i:])
+		n47, err := m.ResolveLock.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n47
 	}
 	return i, nil
 }
@@ -4285,11 +4853,11 @@ func (m *BatchCommandsResponse_Response_GC) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.GC.Size()))
-		n44, err := m.GC.MarshalTo(dAtA[i:])
+		n48, err := m.GC.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n48
 	}
 	return i, nil
 }
@@ -4299,11 +4867,11 @@ func (m *BatchCommandsResponse_Response_DeleteRange) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.DeleteRange.Size()))
-		n45, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		n49, err := m.DeleteRange.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n49
 	}
 	return i, nil
 }
@@ -4313,11 +4881,11 @@ func (m *BatchCommandsResponse_Response_RawGet) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawGet.Size()))
-		n46, err := m.RawGet.MarshalTo(dAtA[i:])
+		n50, err := m.RawGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n50
 	}
 	return i, nil
 }
@@ -4327,11 +4895,11 @@ func (m *BatchCommandsResponse_Response_RawBatchGet) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchGet.Size()))
-		n47, err := m.RawBatchGet.MarshalTo(dAtA[i:])
+		n51, err := m.RawBatchGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n51
 	}
 	return i, nil
 }
@@ -4341,11 +4909,11 @@ func (m *BatchCommandsResponse_Response_RawPut) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawPut.Size()))
-		n48, err := m.RawPut.MarshalTo(dAtA[i:])
+		n52, err := m.RawPut.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n52
 	}
 	return i, nil
 }
@@ -4357,11 +4925,11 @@ func (m *BatchCommandsResponse_Response_RawBatchPut) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchPut.Size()))
-		n49, err := m.RawBatchPut.MarshalTo(dAtA[i:])
+		n53, err := m.RawBatchPut.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n53
 	}
 	return i, nil
 }
@@ -4373,11 +4941,11 @@ func (m *BatchCommandsResponse_Response_RawDelete) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawDelete.Size()))
-		n50, err := m.RawDelete.MarshalTo(dAtA[i:])
+		n54, err := m.RawDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n54
 	}
 	return i, nil
 }
@@ -4389,11 +4957,11 @@ func (m *BatchCommandsResponse_Response_RawBatchDelete) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchDelete.Size()))
-		n51, err := m.RawBatchDelete.MarshalTo(dAtA[i:])
+		n55, err := m.RawBatchDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n55
 	}
 	return i, nil
 }
@@ -4405,11 +4973,11 @@ func (m *BatchCommandsResponse_Response_RawScan) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawScan.Size()))
-		n52, err := m.RawScan.MarshalTo(dAtA[i:])
+		n56, err := m.RawScan.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n56
 	}
 	return i, nil
 }
@@ -4421,11 +4989,11 @@ func (m *BatchCommandsResponse_Response_RawDeleteRange) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawDeleteRange.Size()))
-		n53, err := m.RawDeleteRange.MarshalTo(dAtA[i:])
+		n57, err := m.RawDeleteRange.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n57
 	}
 	return i, nil
 }
@@ -4437,11 +5005,11 @@ func (m *BatchCommandsResponse_Response_RawBatchScan) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchScan.Size()))
-		n54, err := m.RawBatchScan.MarshalTo(dAtA[i:])
+		n58, err := m.RawBatchScan.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n58
 	}
 	return i, nil
 }
@@ -4453,11 +5021,11 @@ func (m *BatchCommandsResponse_Response_Coprocessor) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Coprocessor.Size()))
-		n55, err := m.Coprocessor.MarshalTo(dAtA[i:])
+		n59, err := m.Coprocessor.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n59
 	}
 	return i, nil
 }
@@ -4469,11 +5037,11 @@ func (m *BatchCommandsResponse_Response_PessimisticLock) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.PessimisticLock.Size()))
-		n56, err := m.PessimisticLock.MarshalTo(dAtA[i:])
+		n60, err := m.PessimisticLock.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n60
 	}
 	return i, nil
 }
@@ -4485,11 +5053,11 @@ func (m *BatchCommandsResponse_Response_PessimisticRollback) MarshalTo(dAtA []by
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.PessimisticRollback.Size()))
-		n57, err := m.PessimisticRollback.MarshalTo(dAtA[i:])
+		n61, err := m.PessimisticRollback.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n61
 	}
 	return i, nil
 }
@@ -4501,11 +5069,11 @@ func (m *BatchCommandsResponse_Response_CheckTxnStatus) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.CheckTxnStatus.Size()))
-		n58, err := m.CheckTxnStatus.MarshalTo(dAtA[i:])
+		n62, err := m.CheckTxnStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n62
 	}
 	return i, nil
 }
@@ -4517,11 +5085,75 @@ func (m *BatchCommandsResponse_Response_TxnHeartBeat) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.TxnHeartBeat.Size()))
-		n59, err := m.TxnHeartBeat.MarshalTo(dAtA[i:])
+		n63, err := m.TxnHeartBeat.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n63
+	}
+	return i, nil
+}
+func (m *BatchCommandsResponse_Response_RawCompareAndSwap) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RawCompareAndSwap != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawCompareAndSwap.Size()))
+		n64, err := m.RawCompareAndSwap.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	return i, nil
+}
+func (m *BatchCommandsResponse_Response_RawGetKeyTTL) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RawGetKeyTTL != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawGetKeyTTL.Size()))
+		n65, err := m.RawGetKeyTTL.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	return i, nil
+}
+func (m *BatchCommandsResponse_Response_Checksum) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Checksum != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Checksum.Size()))
+		n66, err := m.Checksum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	return i, nil
+}
+func (m *BatchCommandsResponse_Response_GetRegionStats) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetRegionStats != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.GetRegionStats.Size()))
+		n67, err := m.GetRegionStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
 	}
 	return i, nil
 }
@@ -4533,11 +5165,11 @@ func (m *BatchCommandsResponse_Response_Empty) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0xf
 		i++
 		i = encodeVarintTikvpb(dAtA, i, uint64(m.Empty.Size()))
-		n60, err := m.Empty.MarshalTo(dAtA[i:])
+		n68, err := m.Empty.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n68
 	}
 	return i, nil
 }
@@ -4908,6 +5540,42 @@ func (m *BatchCommandsRequest_Request_TxnHeartBeat) Size() (n int) {
 	}
 	return n
 }
+func (m *BatchCommandsRequest_Request_RawCompareAndSwap) Size() (n int) {
+	var l int
+	_ = l
+	if m.RawCompareAndSwap != nil {
+		l = m.RawCompareAndSwap.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
+func (m *BatchCommandsRequest_Request_RawGetKeyTTL) Size() (n int) {
+	var l int
+	_ = l
+	if m.RawGetKeyTTL != nil {
+		l = m.RawGetKeyTTL.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
+func (m *BatchCommandsRequest_Request_Checksum) Size() (n int) {
+	var l int
+	_ = l
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
+func (m *BatchCommandsRequest_Request_GetRegionStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.GetRegionStats != nil {
+		l = m.GetRegionStats.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
 func (m *BatchCommandsRequest_Request_Empty) Size() (n int) {
 	var l int
 	_ = l
@@ -5188,6 +5856,42 @@ func (m *BatchCommandsResponse_Response_TxnHeartBeat) Size() (n int) {
 	}
 	return n
 }
+func (m *BatchCommandsResponse_Response_RawCompareAndSwap) Size() (n int) {
+	var l int
+	_ = l
+	if m.RawCompareAndSwap != nil {
+		l = m.RawCompareAndSwap.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
+func (m *BatchCommandsResponse_Response_RawGetKeyTTL) Size() (n int) {
+	var l int
+	_ = l
+	if m.RawGetKeyTTL != nil {
+		l = m.RawGetKeyTTL.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
+func (m *BatchCommandsResponse_Response_Checksum) Size() (n int) {
+	var l int
+	_ = l
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
+func (m *BatchCommandsResponse_Response_GetRegionStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.GetRegionStats != nil {
+		l = m.GetRegionStats.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	return n
+}
 func (m *BatchCommandsResponse_Response_Empty) Size() (n int) {
 	var l int
 	_ = l
@@ -6257,6 +6961,134 @@ func (m *BatchCommandsRequest_Request) Unmarshal(dAtA []byte) error {
 			}
 			m.Cmd = &BatchCommandsRequest_Request_TxnHeartBeat{v}
 			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCompareAndSwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.RawCompareAndSwapRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsRequest_Request_RawCompareAndSwap{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawGetKeyTTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.RawGetKeyTTLRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsRequest_Request_RawGetKeyTTL{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.ChecksumRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsRequest_Request_Checksum{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRegionStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.GetRegionStatsRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsRequest_Request_GetRegionStats{v}
+			iNdEx = postIndex
 		case 255:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
@@ -7335,6 +8167,134 @@ func (m *BatchCommandsResponse_Response) Unmarshal(dAtA []byte) error {
 			}
 			m.Cmd = &BatchCommandsResponse_Response_TxnHeartBeat{v}
 			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCompareAndSwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.RawCompareAndSwapResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsResponse_Response_RawCompareAndSwap{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawGetKeyTTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.RawGetKeyTTLResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsResponse_Response_RawGetKeyTTL{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.ChecksumResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsResponse_Response_Checksum{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRegionStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kvrpcpb.GetRegionStatsResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Cmd = &BatchCommandsResponse_Response_GetRegionStats{v}
+			iNdEx = postIndex
 		case 255:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
@@ -7735,118 +8695,131 @@ var (
 	ErrIntOverflowTikvpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("tikvpb.proto", fileDescriptor_tikvpb_352285c788f1f4e2) }
-
-var fileDescriptor_tikvpb_352285c788f1f4e2 = []byte{
-	// 1745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x99, 0xdb, 0x52, 0xdc, 0x46,
-	0x1a, 0xc7, 0x35, 0x30, 0x9c, 0x9a, 0x73, 0x03, 0x46, 0xf4, 0x72, 0xb2, 0xec, 0xf5, 0x52, 0xbb,
-	0x55, 0xb3, 0x60, 0x7b, 0x97, 0xd8, 0x4e, 0x1c, 0xc2, 0xe0, 0x60, 0x3c, 0xb8, 0x42, 0x69, 0x70,
-	0xe2, 0x5c, 0x51, 0xf2, 0x4c, 0x1b, 0xa6, 0xe6, 0xa0, 0x89, 0xa4, 0x11, 0xe6, 0x11, 0xf2, 0x06,
-	0x79, 0x84, 0x3c, 0x44, 0x1e, 0x20, 0x97, 0xb9, 0x49, 0x55, 0x2e, 0x53, 0xce, 0x55, 0x2e, 0xf2,
-	0x0c, 0x49, 0x75, 0x4b, 0xea, 0x93, 0xba, 0x35, 0xce, 0x15, 0xe2, 0xfb, 0xbe, 0xff, 0xd7, 0x67,
-	0xfd, 0x3e, 0xf5, 0x80, 0x99, 0xa8, 0xd5, 0x8e, 0xfb, 0x6f, 0x2a, 0xfd, 0xc0, 0x8f, 0x7c, 0x38,
-	0x9e, 0xfc, 0x87, 0x16, 0x1b, 0x7e, 0x3f, 0xf0, 0x1b, 0x38, 0x0c, 0xfd, 0x20, 0x71, 0xa1, 0xd9,
-	0x76, 0x1c, 0xf4, 0x1b, 0x59, 0x24, 0x5a, 0x0a, 0xbc, 0xb7, 0xd1, 0x45, 0x88, 0x83, 0x18, 0x07,
-	0xcc, 0xb8, 0x7c, 0xe9, 0x5f, 0xfa, 0xf4, 0xf1, 0xbf, 0xe4, 0x29, 0xb5, 0xce, 0x07, 0x83, 0x30,
-	0xa2, 0x8f, 0x89, 0xc1, 0xf9, 0x7d, 0x16, 0x2c, 0x1f, 0x7a, 0x51, 0xe3, 0xaa, 0xea, 0x77, 0xbb,
-	0x5e, 0xaf, 0x19, 0xba, 0xf8, 0x9b, 0x01, 0x0e, 0x23, 0x78, 0x00, 0x26, 0x83, 0xe4, 0x31, 0xb4,
-	0x4b, 0xdb, 0xa3, 0x3b, 0xd3, 0xf7, 0xef, 0x56, 0xd2, 0xfe, 0xe9, 0xe2, 0x2b, 0xe9, 0x5f, 0x97,
-	0xa9, 0xe0, 0x16, 0x98, 0x4e, 0x9f, 0x2f, 0x5a, 0xcd, 0xd0, 0x1e, 0xd9, 0x1e, 0xdd, 0x29, 0xbb,
-	0x20, 0x35, 0x9d, 0x34, 0x43, 0xf4, 0xc7, 0x0c, 0x98, 0xc8, 0x9a, 0xfb, 0x17, 0x18, 0x3d, 0xc6,
-	0x91, 0x5d, 0xda, 0x2e, 0xed, 0x4c, 0xdf, 0x5f, 0xaa, 0x64, 0x03, 0x3c, 0xc6, 0x51, 0x1a, 0xf1,
-	0xdc, 0x72, 0x49, 0x04, 0xfc, 0x37, 0x28, 0xd7, 0x1b, 0x5e, 0xcf, 0x1e, 0xa1, 0x91, 0xcb, 0x2c,
-	0x92, 0x18, 0x79, 0x28, 0x8d, 0x81, 0xff, 0x07, 0x93, 0x67, 0x01, 0xbe, 0x0e, 0x5a, 0x11, 0xb6,
-	0x47, 0x69, 0xbc, 0xcd, 0xe2, 0x33, 0x07, 0xd7, 0xb0, 0x58, 0xb8, 0x0b, 0xc6, 0xc9, 0xf0, 0x5a,
-	0x91, 0x5d, 0xa6, 0xaa, 0x5b, 0x4c, 0x95, 0x98, 0xb9, 0x26, 0x8d, 0x23, 0x8a, 0x93, 0x6e, 0xdf,
-	0x0f, 0x22, 0x7b, 0x4c, 0x51, 0x24, 0x66, 0x41, 0x91, 0x18, 0xe0, 0x03, 0x30, 0x51, 0xed, 0x60,
-	0xaf, 0x37, 0xe8, 0xdb, 0xe3, 0x54, 0xb2, 0xca, 0x1b, 0x49, 0xec, 0x5c, 0x93, 0x45, 0x92, 0x01,
-	0xd1, 0xc9, 0x27, 0x53, 0x35, 0xa1, 0x0c, 0x28, 0x73, 0x08, 0x03, 0xca, 0x4c, 0xf0, 0x19, 0x98,
-	0xa5, 0xcf, 0xae, 0xdf, 0xe9, 0xbc, 0xf1, 0x1a, 0x6d, 0x7b, 0x92, 0x8a, 0x37, 0x64, 0x71, 0xe6,
-	0xe5, 0x19, 0x64, 0x15, 0x69, 0x9e, 0xcc, 0xeb, 0xa9, 0xdf, 0x68, 0xdb, 0x53, 0x4a, 0xf3, 0x99,
-	0x43, 0x68, 0x3e, 0x33, 0xc1, 0x4f, 0xc1, 0xb4, 0x8b, 0x43, 0xbf, 0x13, 0x63, 0x2a, 0x05, 0x54,
-	0xfa, 0x0f, 0x26, 0x15, 0x7c, 0x5c, 0x2d, 0x2a, 0xe0, 0x5d, 0x30, 0x72, 0x5c, 0xb5, 0xa7, 0xa9,
-	0x0e, 0xf2, 0xcd, 0x51, 0xe5, 0xe1, 0x23, 0xc7, 0x55, 0xd2, 0xcc, 0x11, 0xee, 0xe0, 0x08, 0xbb,
-	0x5e, 0xef, 0x12, 0xdb, 0x33, 0x4a, 0x33, 0x82, 0x4f, 0x68, 0x46, 0xb0, 0x92, 0x55, 0x74, 0xbd,
-	0x6b, 0x32, 0xb9, 0xb3, 0xca, 0x2a, 0x26, 0x66, 0x61, 0x15, 0x13, 0x03, 0x1d, 0x99, 0x77, 0xcd,
-	0xd6, 0x64, 0x4e, 0x1d, 0x19, 0xf7, 0x89, 0x23, 0xe3, 0xd6, 0xb4, 0xc9, 0xb3, 0x41, 0x64, 0xcf,
-	0xe7, 0x9b, 0x3c, 0x1b, 0x28, 0x4d, 0x9e, 0x0d, 0xa4, 0x26, 0x89, 0x6c, 0xc1, 0xd0, 0xa4, 0xa4,
-	0x15, 0x15, 0xf0, 0x11, 0x98, 0x72, 0xbd, 0xeb, 0x64, 0xdc, 0xf6, 0x22, 0x95, 0xaf, 0x89, 0xf2,
-	0x74, 0x46, 0x98, 0x98, 0x47, 0xc3, 0xe7, 0x60, 0x2e, 0xcb, 0x94, 0xea, 0x21, 0xd5, 0x6f, 0xe6,
-	0x9a, 0x57, 0x93, 0x28, 0x3a, 0xb2, 0xfd, 0x5d, 0xef, 0x9a, 0x9e, 0xe4, 0x25, 0x65, 0xfb, 0xa7,
-	0x76, 0x61, 0xfb, 0xa7, 0x96, 0xb4, 0x79, 0x71, 0x8d, 0x97, 0xf3, 0xcd, 0x6b, 0x97, 0x59, 0xd1,
-	0xc1, 0x43, 0x30, 0x93, 0x75, 0x88, 0xf6, 0x61, 0x85, 0xe6, 0x59, 0xcf, 0x0d, 0x43, 0xee, 0x88,
-	0xa4, 0x81, 0x1f, 0x81, 0xe9, 0x2a, 0x7f, 0x35, 0xdb, 0xb7, 0xd2, 0x17, 0x92, 0xf8, 0xba, 0x16,
-	0x56, 0x40, 0x08, 0x85, 0x35, 0x30, 0x7f, 0x86, 0xc3, 0xb0, 0xd5, 0x6d, 0x85, 0x51, 0xab, 0x41,
-	0xcf, 0xc4, 0x2a, 0x55, 0x6f, 0xf1, 0xd7, 0x93, 0xec, 0xe7, 0x89, 0x54, 0x25, 0xfc, 0x0a, 0x2c,
-	0x09, 0x26, 0x76, 0xc2, 0x6d, 0x9a, 0xf0, 0x8e, 0x2e, 0x61, 0xfe, 0x9c, 0xeb, 0x32, 0x90, 0xd9,
-	0xae, 0x5e, 0xe1, 0x46, 0xfb, 0xfc, 0x5d, 0xaf, 0x1e, 0x79, 0xd1, 0x20, 0xb4, 0xd7, 0x94, 0xd9,
-	0x96, 0xdd, 0xc2, 0x6c, 0xcb, 0x0e, 0x32, 0xdb, 0xe7, 0xef, 0x7a, 0xcf, 0xb1, 0x17, 0x44, 0x87,
-	0xd8, 0x8b, 0x6c, 0xa4, 0xcc, 0xb6, 0xe8, 0x14, 0x66, 0x5b, 0x34, 0xc3, 0xc7, 0x60, 0xec, 0x59,
-	0xb7, 0x1f, 0xdd, 0xd8, 0x7f, 0x26, 0x8c, 0xb8, 0xad, 0xa5, 0x11, 0x0d, 0xe1, 0x29, 0x12, 0xc9,
-	0xe1, 0x18, 0x18, 0x6d, 0x74, 0x9b, 0xce, 0x0f, 0x73, 0x60, 0x45, 0x61, 0x57, 0xd8, 0xf7, 0x7b,
-	0x21, 0x86, 0x47, 0x60, 0x2a, 0x48, 0x9f, 0x33, 0xda, 0xdd, 0x33, 0xd0, 0x2e, 0x89, 0xaa, 0x64,
-	0x0f, 0x2e, 0x17, 0x0e, 0x05, 0x1e, 0xdc, 0x05, 0xcb, 0x51, 0xe0, 0xf5, 0x42, 0x02, 0x80, 0x8b,
-	0x8e, 0x77, 0x83, 0x83, 0x8b, 0x8e, 0xef, 0x35, 0x29, 0x9b, 0xca, 0x2e, 0x64, 0xbe, 0x53, 0xe2,
-	0x3a, 0xf5, 0xbd, 0x26, 0xfa, 0x76, 0x16, 0x4c, 0xb2, 0x5e, 0xee, 0x88, 0x8c, 0x5c, 0x96, 0x19,
-	0x99, 0x84, 0x64, 0x90, 0xfc, 0x8f, 0x04, 0xc9, 0x15, 0x05, 0x92, 0x2c, 0x36, 0xa1, 0xe4, 0x7e,
-	0x8e, 0x92, 0x6b, 0x1a, 0x4a, 0x32, 0x11, 0xc7, 0xe4, 0x9e, 0x82, 0xc9, 0xd5, 0x1c, 0x26, 0x99,
-	0x28, 0xe3, 0xe4, 0x9e, 0xc2, 0xc9, 0xd5, 0x1c, 0x27, 0xb9, 0x24, 0x05, 0xe5, 0x43, 0x15, 0x94,
-	0x76, 0x1e, 0x94, 0x4c, 0xc4, 0x48, 0xb9, 0x9f, 0x23, 0xe5, 0x9a, 0x86, 0x94, 0x7c, 0x50, 0xec,
-	0x85, 0xfc, 0xb9, 0x1e, 0x95, 0x9b, 0x26, 0x54, 0xb2, 0x14, 0x0a, 0x2b, 0xf7, 0x73, 0xac, 0x5c,
-	0xd3, 0xb0, 0x92, 0x77, 0x80, 0xc1, 0xf2, 0x40, 0x07, 0xcb, 0x75, 0x3d, 0x2c, 0x99, 0x5c, 0xa2,
-	0xe5, 0x3f, 0x05, 0x5a, 0x2e, 0x49, 0xb4, 0x64, 0xf1, 0x04, 0x97, 0x07, 0x3a, 0x5c, 0xae, 0xeb,
-	0x71, 0xc9, 0x1b, 0x12, 0xdf, 0xa2, 0x7b, 0x0a, 0x2f, 0x57, 0x73, 0xbc, 0xe4, 0xab, 0x99, 0x02,
-	0xf3, 0x40, 0x07, 0xcc, 0x75, 0x3d, 0x30, 0x85, 0xd1, 0x09, 0xc4, 0xdc, 0x53, 0x88, 0xb9, 0x9a,
-	0x23, 0xa6, 0xd4, 0x28, 0x21, 0xde, 0x81, 0x0e, 0x99, 0xeb, 0x7a, 0x64, 0xe6, 0x1b, 0x25, 0x19,
-	0x1e, 0xe7, 0x99, 0x89, 0x74, 0xcc, 0x64, 0x6a, 0x01, 0x9a, 0x27, 0x06, 0x68, 0x6e, 0x19, 0xa1,
-	0xc9, 0xb2, 0xa8, 0xd4, 0x7c, 0xa8, 0x52, 0xd3, 0xce, 0x53, 0x93, 0x9f, 0x85, 0x0c, 0x9b, 0x27,
-	0x06, 0x6c, 0x6e, 0x19, 0xb1, 0x29, 0x75, 0x40, 0x5c, 0xf1, 0xaa, 0x96, 0x9b, 0x1b, 0x06, 0x6e,
-	0xb2, 0x34, 0x32, 0x38, 0x1f, 0xe9, 0xc0, 0xb9, 0xa2, 0x80, 0x93, 0xaf, 0x83, 0x48, 0xce, 0x53,
-	0x13, 0x39, 0xb7, 0xcd, 0xe4, 0x64, 0x99, 0x72, 0xe8, 0x7c, 0x5d, 0x84, 0xce, 0xbb, 0xc5, 0xe8,
-	0x64, 0x59, 0xb5, 0xec, 0x3c, 0x31, 0xb0, 0x73, 0xcb, 0xc8, 0x4e, 0x3e, 0xe5, 0x0a, 0x3c, 0xab,
-	0x5a, 0x78, 0x6e, 0x18, 0xe0, 0xc9, 0xa7, 0x5c, 0xa2, 0xe7, 0x13, 0x85, 0x9e, 0x4e, 0x11, 0x3d,
-	0x59, 0x0e, 0x19, 0x9f, 0x87, 0x60, 0x21, 0x79, 0xc5, 0x79, 0x6f, 0xa3, 0x97, 0x38, 0x0c, 0xbd,
-	0x4b, 0x0c, 0x2b, 0xa0, 0xdc, 0x0d, 0x2f, 0x33, 0x66, 0xa2, 0x8a, 0xfc, 0x25, 0x2a, 0x44, 0xba,
-	0x34, 0xce, 0xa9, 0x83, 0x35, 0x23, 0xaf, 0xe1, 0x2a, 0x98, 0x88, 0x12, 0x78, 0x52, 0xc6, 0x95,
-	0xdd, 0xf1, 0x88, 0x82, 0x13, 0x6e, 0x00, 0xd0, 0xc4, 0x1d, 0xef, 0xe6, 0x22, 0x6a, 0x75, 0x31,
-	0x85, 0x5a, 0xd9, 0x9d, 0xa2, 0x96, 0xf3, 0x56, 0x17, 0x3b, 0xff, 0x03, 0xc8, 0x3c, 0x0c, 0x63,
-	0xd6, 0xfb, 0x3f, 0x2f, 0x83, 0xf2, 0x79, 0xab, 0x1d, 0xc3, 0x87, 0x60, 0xac, 0x16, 0x93, 0x57,
-	0x8b, 0xee, 0xbb, 0x13, 0x69, 0x41, 0xeb, 0x58, 0x70, 0x1f, 0x8c, 0xd7, 0x62, 0xba, 0x9f, 0xb5,
-	0x1f, 0xa1, 0x48, 0x4f, 0x5d, 0xc7, 0x82, 0x55, 0x00, 0x6a, 0x31, 0x83, 0xa8, 0xf1, 0x8b, 0x14,
-	0x99, 0x29, 0xec, 0x58, 0xf0, 0x35, 0x58, 0xac, 0xc5, 0xea, 0x7e, 0x1e, 0x56, 0x3e, 0xa2, 0xa1,
-	0xa7, 0xc4, 0xb1, 0x60, 0x13, 0xac, 0xd4, 0xbe, 0xd4, 0xed, 0xe9, 0x0f, 0xa9, 0x25, 0xd1, 0x07,
-	0x9d, 0x1a, 0xc7, 0x82, 0x5f, 0x80, 0xb9, 0x5a, 0x2c, 0x6d, 0xd1, 0xc2, 0x72, 0x10, 0x15, 0xef,
-	0x77, 0xc7, 0x82, 0xaf, 0xc0, 0x42, 0x2d, 0x56, 0x8e, 0xce, 0x90, 0x4a, 0x15, 0x0d, 0x3b, 0x8d,
-	0x8e, 0x05, 0x3f, 0x01, 0x93, 0xb5, 0x38, 0x2d, 0x5e, 0x0c, 0xd7, 0x00, 0xc8, 0x54, 0xf7, 0x64,
-	0xf2, 0xb4, 0x90, 0x31, 0xdc, 0x09, 0x20, 0x53, 0x0d, 0xe4, 0x58, 0xf0, 0x00, 0x4c, 0xd5, 0xe2,
-	0xac, 0xa4, 0x31, 0x5d, 0x10, 0x20, 0x63, 0x41, 0x94, 0x6d, 0x36, 0xc6, 0x4e, 0xe3, 0x6d, 0x01,
-	0x32, 0x57, 0x47, 0x8e, 0x05, 0x5d, 0x30, 0x9f, 0x26, 0x61, 0x9b, 0xa1, 0xf8, 0xea, 0x00, 0x0d,
-	0x29, 0x97, 0xb2, 0x8e, 0xb1, 0xa2, 0xc7, 0x78, 0x8f, 0x80, 0xcc, 0x55, 0x93, 0x63, 0xc1, 0x53,
-	0x30, 0x5b, 0x8b, 0xc5, 0xd2, 0xa7, 0xe8, 0x52, 0x01, 0x15, 0x16, 0x51, 0x8e, 0x05, 0xf7, 0x40,
-	0xb9, 0x16, 0x1f, 0x57, 0xa1, 0xe6, 0x86, 0x01, 0xe9, 0xea, 0xa8, 0xac, 0x03, 0x22, 0x20, 0x8b,
-	0xae, 0x1b, 0x50, 0x61, 0x71, 0xe5, 0x58, 0xf0, 0x49, 0x56, 0x4f, 0x41, 0xc3, 0xcd, 0x03, 0x32,
-	0x55, 0x58, 0x8e, 0x05, 0x5f, 0x48, 0x95, 0x15, 0x2c, 0xba, 0x84, 0x40, 0x85, 0x05, 0x17, 0xeb,
-	0x08, 0x29, 0x7c, 0x0c, 0xf7, 0x11, 0xc8, 0x54, 0x75, 0xc9, 0x1d, 0x21, 0x19, 0x8a, 0xae, 0x26,
-	0x50, 0x61, 0x11, 0xe6, 0x58, 0xe4, 0xc3, 0x8c, 0x17, 0x52, 0xe6, 0x5b, 0x0a, 0x54, 0x50, 0x8c,
-	0x39, 0x16, 0xac, 0xab, 0x15, 0x18, 0x1c, 0x72, 0x61, 0x81, 0x86, 0xd5, 0x66, 0x8e, 0x05, 0x9f,
-	0xb2, 0x5a, 0x0c, 0x9a, 0xee, 0x2e, 0x90, 0xb1, 0x3c, 0x63, 0x9d, 0x12, 0xf7, 0xce, 0x90, 0x6b,
-	0x0c, 0x34, 0xac, 0x5e, 0x73, 0x2c, 0xf8, 0x52, 0xae, 0xcf, 0x60, 0xe1, 0x8d, 0x06, 0x2a, 0xae,
-	0xdb, 0x1c, 0x0b, 0x5e, 0x00, 0xf8, 0xaa, 0x17, 0x7a, 0x6f, 0xf1, 0x11, 0x0e, 0xa3, 0xc0, 0xbf,
-	0x49, 0xfa, 0xe9, 0x30, 0x59, 0xde, 0x99, 0xa5, 0xbe, 0x53, 0x18, 0xc3, 0x1a, 0xf8, 0x58, 0x2a,
-	0x05, 0xa1, 0xf6, 0xf6, 0x04, 0xe9, 0x4b, 0x43, 0xba, 0x3b, 0x16, 0x05, 0x75, 0x3d, 0x0a, 0xb0,
-	0xd7, 0xfd, 0x9b, 0x39, 0x76, 0x4b, 0xf0, 0x09, 0x28, 0x93, 0x42, 0x05, 0x16, 0x54, 0x2f, 0x68,
-	0x49, 0xf1, 0x1d, 0xf9, 0x3d, 0xec, 0x58, 0x3b, 0x25, 0xf8, 0x14, 0x4c, 0xb1, 0xa2, 0x08, 0xda,
-	0x52, 0x55, 0xf5, 0x41, 0xfa, 0xcf, 0xc0, 0x64, 0xbd, 0xe7, 0xf5, 0xc3, 0x2b, 0x9f, 0x10, 0x50,
-	0x0e, 0xca, 0x1c, 0xd5, 0xab, 0x41, 0xaf, 0x6d, 0x4e, 0xf1, 0x02, 0x4c, 0xd7, 0xfb, 0x1d, 0x82,
-	0x9d, 0xcb, 0x96, 0xdf, 0x13, 0xce, 0x9b, 0x60, 0xcd, 0x9f, 0x37, 0xc9, 0x29, 0x9d, 0x37, 0xec,
-	0x35, 0x4f, 0x7a, 0x4d, 0xfc, 0x4e, 0x3c, 0x6f, 0x99, 0x4d, 0x73, 0xde, 0xb8, 0x4b, 0xdc, 0x85,
-	0x2f, 0xe3, 0x46, 0xe3, 0x18, 0x47, 0x87, 0x37, 0x35, 0x7c, 0x23, 0xec, 0x42, 0xd1, 0x9c, 0xdf,
-	0x85, 0xb2, 0x97, 0xa5, 0xfb, 0x1a, 0x2c, 0x30, 0x4f, 0x3d, 0xf2, 0x82, 0xe8, 0x3c, 0x84, 0xdb,
-	0x79, 0x51, 0xea, 0xca, 0xd2, 0xde, 0x2e, 0x88, 0x10, 0xc8, 0x36, 0x2b, 0x95, 0x8e, 0x70, 0xbd,
-	0xe8, 0x47, 0x0e, 0xb4, 0x51, 0x78, 0x29, 0x44, 0x56, 0x63, 0xb7, 0x74, 0x78, 0xef, 0x97, 0xef,
-	0x27, 0x4b, 0x3f, 0xbe, 0xdf, 0x2c, 0xfd, 0xf4, 0x7e, 0xb3, 0xf4, 0xeb, 0xfb, 0xcd, 0xd2, 0x77,
-	0xbf, 0x6d, 0x5a, 0x60, 0xc1, 0x0f, 0x2e, 0xa9, 0xba, 0xd2, 0x8e, 0xe9, 0x4f, 0x2f, 0x6f, 0xc6,
-	0xe9, 0x9f, 0x07, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xbc, 0xcf, 0x8f, 0xf7, 0x19, 0x00,
-	0x00,
+func init() { proto.RegisterFile("tikvpb.proto", fileDescriptor_tikvpb_315f5852766b0b93) }
+
+var fileDescriptor_tikvpb_315f5852766b0b93 = []byte{
+	// 1961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x99, 0xdd, 0x72, 0xdc, 0x48,
+	0x15, 0xc7, 0x35, 0xf1, 0xc4, 0xb1, 0xdb, 0x71, 0x6c, 0x1f, 0xdb, 0x6b, 0xb9, 0x63, 0x8f, 0xbd,
+	0xda, 0xb0, 0xb8, 0xa0, 0x6a, 0xf0, 0x66, 0x17, 0x96, 0xdd, 0xc0, 0x92, 0x78, 0x1c, 0x1c, 0xef,
+	0x38, 0xc5, 0xa0, 0xf1, 0x42, 0xa8, 0xa2, 0xca, 0xa5, 0xcc, 0x74, 0x9c, 0xa9, 0xf9, 0xd0, 0x20,
+	0x69, 0xe4, 0xf8, 0x96, 0x0b, 0x9e, 0x81, 0x27, 0xa0, 0xb8, 0xe3, 0x35, 0xb8, 0xe4, 0x12, 0xee,
+	0xa8, 0xf0, 0x20, 0x50, 0xdd, 0x92, 0xfa, 0x4b, 0xdd, 0x9a, 0x70, 0x15, 0xe5, 0x9c, 0xf3, 0x3f,
+	0xfd, 0xa5, 0x3e, 0xf3, 0xd3, 0x31, 0xba, 0x9f, 0x0c, 0x86, 0xe9, 0xf4, 0x75, 0x73, 0x1a, 0x85,
+	0x49, 0x08, 0x8b, 0xd9, 0xff, 0xf0, 0x46, 0x2f, 0x9c, 0x46, 0x61, 0x8f, 0xc4, 0x71, 0x18, 0x65,
+	0x2e, 0xbc, 0x3a, 0x4c, 0xa3, 0x69, 0xaf, 0x88, 0xc4, 0x9b, 0x51, 0xf0, 0x26, 0xb9, 0x8a, 0x49,
+	0x94, 0x92, 0x88, 0x1b, 0xb7, 0xae, 0xc3, 0xeb, 0x90, 0x3d, 0xfe, 0x88, 0x3e, 0xe5, 0xd6, 0xb5,
+	0x68, 0x16, 0x27, 0xec, 0x31, 0x33, 0x78, 0x7f, 0x5c, 0x47, 0x5b, 0x27, 0x41, 0xd2, 0x7b, 0xdb,
+	0x0a, 0xc7, 0xe3, 0x60, 0xd2, 0x8f, 0x7d, 0xf2, 0x87, 0x19, 0x89, 0x13, 0x78, 0x8a, 0x96, 0xa2,
+	0xec, 0x31, 0x76, 0x6b, 0x87, 0x0b, 0x47, 0x2b, 0x8f, 0x1f, 0x35, 0xf3, 0xf9, 0x99, 0xe2, 0x9b,
+	0xf9, 0xbf, 0x3e, 0x57, 0xc1, 0x01, 0x5a, 0xc9, 0x9f, 0xaf, 0x06, 0xfd, 0xd8, 0xbd, 0x73, 0xb8,
+	0x70, 0x54, 0xf7, 0x51, 0x6e, 0x3a, 0xef, 0xc7, 0xf8, 0x4f, 0x6b, 0xe8, 0x5e, 0x31, 0xdc, 0xf7,
+	0xd1, 0xc2, 0x19, 0x49, 0xdc, 0xda, 0x61, 0xed, 0x68, 0xe5, 0xf1, 0x66, 0xb3, 0x58, 0xe0, 0x19,
+	0x49, 0xf2, 0x88, 0x17, 0x8e, 0x4f, 0x23, 0xe0, 0x07, 0xa8, 0xde, 0xed, 0x05, 0x13, 0xf7, 0x0e,
+	0x8b, 0xdc, 0xe2, 0x91, 0xd4, 0x28, 0x42, 0x59, 0x0c, 0xfc, 0x04, 0x2d, 0x75, 0x22, 0x72, 0x13,
+	0x0d, 0x12, 0xe2, 0x2e, 0xb0, 0x78, 0x97, 0xc7, 0x17, 0x0e, 0xa1, 0xe1, 0xb1, 0x70, 0x8c, 0x16,
+	0xe9, 0xf2, 0x06, 0x89, 0x5b, 0x67, 0xaa, 0x8f, 0xb8, 0x2a, 0x33, 0x0b, 0x4d, 0x1e, 0x47, 0x15,
+	0xe7, 0xe3, 0x69, 0x18, 0x25, 0xee, 0x5d, 0x4d, 0x91, 0x99, 0x25, 0x45, 0x66, 0x80, 0xcf, 0xd1,
+	0xbd, 0xd6, 0x88, 0x04, 0x93, 0xd9, 0xd4, 0x5d, 0x64, 0x92, 0x1d, 0x31, 0x48, 0x66, 0x17, 0x9a,
+	0x22, 0x92, 0x2e, 0x88, 0x6d, 0x3e, 0xdd, 0xaa, 0x7b, 0xda, 0x82, 0x0a, 0x87, 0xb4, 0xa0, 0xc2,
+	0x04, 0xcf, 0xd1, 0x2a, 0x7b, 0xf6, 0xc3, 0xd1, 0xe8, 0x75, 0xd0, 0x1b, 0xba, 0x4b, 0x4c, 0xbc,
+	0xaf, 0x8a, 0x0b, 0xaf, 0xc8, 0xa0, 0xaa, 0xe8, 0xf0, 0x74, 0x5f, 0x2f, 0xc2, 0xde, 0xd0, 0x5d,
+	0xd6, 0x86, 0x2f, 0x1c, 0xd2, 0xf0, 0x85, 0x09, 0x7e, 0x81, 0x56, 0x7c, 0x12, 0x87, 0xa3, 0x94,
+	0x30, 0x29, 0x62, 0xd2, 0x87, 0x5c, 0x2a, 0xf9, 0x84, 0x5a, 0x56, 0xc0, 0x23, 0x74, 0xe7, 0xac,
+	0xe5, 0xae, 0x30, 0x1d, 0x88, 0x97, 0xa3, 0x25, 0xc2, 0xef, 0x9c, 0xb5, 0xe8, 0x30, 0xa7, 0x64,
+	0x44, 0x12, 0xe2, 0x07, 0x93, 0x6b, 0xe2, 0xde, 0xd7, 0x86, 0x91, 0x7c, 0xd2, 0x30, 0x92, 0x95,
+	0x9e, 0xa2, 0x1f, 0xdc, 0xd0, 0xcd, 0x5d, 0xd5, 0x4e, 0x31, 0x33, 0x4b, 0xa7, 0x98, 0x19, 0xd8,
+	0xca, 0x82, 0x1b, 0x7e, 0x26, 0x0f, 0xf4, 0x95, 0x09, 0x9f, 0xbc, 0x32, 0x61, 0xcd, 0x87, 0xec,
+	0xcc, 0x12, 0x77, 0xad, 0x3c, 0x64, 0x67, 0xa6, 0x0d, 0xd9, 0x99, 0x29, 0x43, 0x52, 0xd9, 0xba,
+	0x65, 0x48, 0x45, 0x2b, 0x2b, 0xe0, 0x2b, 0xb4, 0xec, 0x07, 0x37, 0xd9, 0xba, 0xdd, 0x0d, 0x26,
+	0xdf, 0x95, 0xe5, 0x99, 0x47, 0x88, 0x45, 0x34, 0xbc, 0x40, 0x0f, 0x8a, 0x4c, 0xb9, 0x1e, 0x98,
+	0xbe, 0x51, 0x1a, 0x5e, 0x4f, 0xa2, 0xe9, 0xe8, 0xeb, 0xef, 0x07, 0x37, 0xec, 0x26, 0x6f, 0x6a,
+	0xaf, 0x7f, 0x6e, 0x97, 0x5e, 0xff, 0xdc, 0x92, 0x0f, 0x2f, 0x9f, 0xf1, 0x56, 0x79, 0x78, 0xe3,
+	0x31, 0x6b, 0x3a, 0x38, 0x41, 0xf7, 0x8b, 0x09, 0xb1, 0x39, 0x6c, 0xb3, 0x3c, 0x7b, 0xa5, 0x65,
+	0xa8, 0x13, 0x51, 0x34, 0xf0, 0x53, 0xb4, 0xd2, 0x12, 0xa5, 0xd9, 0xfd, 0x28, 0x2f, 0x48, 0x72,
+	0xb9, 0x96, 0x4e, 0x40, 0x0a, 0x85, 0x36, 0x5a, 0xeb, 0x90, 0x38, 0x1e, 0x8c, 0x07, 0x71, 0x32,
+	0xe8, 0xb1, 0x3b, 0xb1, 0xc3, 0xd4, 0x07, 0xa2, 0x3c, 0xa9, 0x7e, 0x91, 0x48, 0x57, 0xc2, 0x6f,
+	0xd1, 0xa6, 0x64, 0xe2, 0x37, 0xdc, 0x65, 0x09, 0x3f, 0x31, 0x25, 0x2c, 0xdf, 0x73, 0x53, 0x06,
+	0xba, 0xdb, 0xad, 0xb7, 0xa4, 0x37, 0xbc, 0x7c, 0x37, 0xe9, 0x26, 0x41, 0x32, 0x8b, 0xdd, 0x5d,
+	0x6d, 0xb7, 0x55, 0xb7, 0xb4, 0xdb, 0xaa, 0x83, 0xee, 0xf6, 0xe5, 0xbb, 0xc9, 0x0b, 0x12, 0x44,
+	0xc9, 0x09, 0x09, 0x12, 0x17, 0x6b, 0xbb, 0x2d, 0x3b, 0xa5, 0xdd, 0x96, 0xcd, 0xf0, 0x6b, 0xb4,
+	0xe1, 0x07, 0x37, 0xad, 0x70, 0x3c, 0x0d, 0x22, 0xf2, 0x6c, 0xd2, 0xef, 0xde, 0x04, 0x53, 0xf7,
+	0x21, 0x4b, 0xf4, 0xb1, 0x7c, 0x6c, 0x6a, 0x84, 0xc8, 0x56, 0x56, 0xe7, 0x2f, 0xc1, 0x19, 0x49,
+	0xda, 0xe4, 0xf6, 0xf2, 0xf2, 0xc2, 0xdd, 0x2b, 0xbf, 0x04, 0xdc, 0xa9, 0xbe, 0x04, 0xdc, 0x4c,
+	0x4b, 0x22, 0x5b, 0x6c, 0x3c, 0x1b, 0xbb, 0xfb, 0x5a, 0x49, 0x2c, 0x1c, 0x52, 0x49, 0x2c, 0x4c,
+	0x74, 0x73, 0x59, 0x51, 0xb8, 0x1e, 0x84, 0x6c, 0x97, 0x62, 0xb7, 0xa1, 0x6d, 0xae, 0xea, 0x96,
+	0x36, 0x57, 0x75, 0xc0, 0xd7, 0xe8, 0xee, 0xf3, 0xf1, 0x34, 0xb9, 0x75, 0xff, 0x5b, 0xcb, 0x77,
+	0xc3, 0xf4, 0x33, 0xcd, 0x42, 0x44, 0x92, 0x4c, 0x72, 0x72, 0x17, 0x2d, 0xf4, 0xc6, 0x7d, 0xef,
+	0x5f, 0xeb, 0x68, 0x5b, 0xfb, 0x51, 0x8f, 0xa7, 0xe1, 0x24, 0x26, 0x70, 0x8a, 0x96, 0xa3, 0xfc,
+	0xb9, 0xc0, 0x80, 0x4f, 0x2d, 0x18, 0x90, 0x45, 0x35, 0x8b, 0x07, 0x5f, 0x08, 0xe7, 0x92, 0x00,
+	0x1c, 0xa3, 0xad, 0x24, 0x0a, 0x26, 0x31, 0xfd, 0x65, 0xbc, 0x1a, 0x05, 0xb7, 0x24, 0xba, 0x1a,
+	0x85, 0x41, 0x9f, 0xfd, 0x68, 0xd7, 0x7d, 0xe0, 0xbe, 0x0b, 0xea, 0xba, 0x08, 0x83, 0x3e, 0xfe,
+	0xcb, 0x1a, 0x5a, 0xe2, 0xb3, 0x3c, 0x92, 0xe1, 0x61, 0x4b, 0xdd, 0xc1, 0x2c, 0xa4, 0xa0, 0x87,
+	0x1f, 0x2a, 0xf4, 0xb0, 0xad, 0xd1, 0x03, 0x8f, 0x65, 0x41, 0xf0, 0x65, 0x09, 0x1f, 0x76, 0x0d,
+	0xf8, 0xc0, 0x45, 0x3c, 0x18, 0x3e, 0xd3, 0xf8, 0x61, 0xa7, 0xc4, 0x0f, 0x5c, 0x94, 0x07, 0x52,
+	0x89, 0x02, 0x10, 0x3b, 0x25, 0x80, 0x10, 0x92, 0xcc, 0x02, 0x5f, 0xe8, 0x04, 0xe1, 0x96, 0x09,
+	0x82, 0x8b, 0x8a, 0x50, 0xba, 0x28, 0x0d, 0x21, 0x76, 0x0d, 0x08, 0x21, 0x16, 0x55, 0xd8, 0xe0,
+	0x97, 0x66, 0x86, 0x68, 0xd8, 0x18, 0x82, 0xa7, 0x50, 0x65, 0x74, 0x02, 0x1a, 0x44, 0xec, 0x1a,
+	0x20, 0x42, 0x4c, 0xa0, 0xb0, 0xc1, 0x53, 0x13, 0x45, 0xec, 0x99, 0x29, 0x82, 0xcb, 0x65, 0x09,
+	0x7c, 0x4f, 0xc2, 0x88, 0x4d, 0x05, 0x23, 0x78, 0x3c, 0xe5, 0x88, 0xa7, 0x26, 0x8e, 0xd8, 0x33,
+	0x73, 0x84, 0x18, 0x48, 0x32, 0xd3, 0xd3, 0x54, 0x40, 0x62, 0xa7, 0x04, 0x12, 0xe2, 0x34, 0x33,
+	0x0b, 0x5b, 0x5d, 0x89, 0x24, 0xf6, 0xcc, 0x24, 0x21, 0xad, 0x4e, 0x98, 0xf3, 0x41, 0x05, 0x4a,
+	0xec, 0x94, 0x50, 0x42, 0x19, 0xb4, 0x33, 0x53, 0x06, 0x15, 0x2c, 0xb1, 0x67, 0x66, 0x89, 0xf2,
+	0xa0, 0x34, 0xc3, 0xd7, 0x65, 0x98, 0xc0, 0x26, 0x98, 0xe0, 0x6a, 0x11, 0x0e, 0xe7, 0x16, 0x9a,
+	0x38, 0xb0, 0xd2, 0x04, 0xcf, 0xa2, 0x09, 0xe9, 0x5d, 0x50, 0x71, 0xc2, 0x2d, 0xe3, 0x84, 0xb8,
+	0x0b, 0xb9, 0x29, 0x9f, 0x40, 0x99, 0x27, 0x0e, 0xac, 0x3c, 0xa1, 0x4c, 0x40, 0x3e, 0xf1, 0x96,
+	0x11, 0x28, 0xf6, 0x2d, 0x40, 0xc1, 0xd3, 0x28, 0x22, 0xf8, 0xca, 0x44, 0x14, 0xdb, 0x1a, 0x51,
+	0x88, 0x73, 0x90, 0x62, 0xe1, 0xc2, 0x86, 0x14, 0x87, 0x76, 0xa4, 0xe0, 0x99, 0x74, 0x29, 0xbc,
+	0xaa, 0x62, 0x8a, 0x47, 0xd5, 0x4c, 0xc1, 0xb3, 0x1a, 0xa1, 0xe2, 0xdc, 0x02, 0x15, 0x07, 0x56,
+	0xa8, 0x10, 0x5b, 0xae, 0x7a, 0xe8, 0x96, 0x1b, 0xa8, 0x62, 0xdf, 0x42, 0x15, 0x62, 0xcb, 0x65,
+	0x3b, 0xf8, 0x76, 0xac, 0xf0, 0xaa, 0xb0, 0x82, 0xa7, 0x2b, 0xcb, 0xf3, 0x77, 0x41, 0xe7, 0x8a,
+	0x7d, 0x0b, 0x57, 0x28, 0xef, 0x02, 0xb7, 0xd3, 0x32, 0xa9, 0x81, 0xc5, 0xae, 0x01, 0x2c, 0x44,
+	0x99, 0x2c, 0x6c, 0x74, 0x87, 0x8d, 0x64, 0x71, 0x60, 0x25, 0x0b, 0xb1, 0xc3, 0xaa, 0x07, 0x9e,
+	0x68, 0x68, 0xe1, 0x55, 0xa1, 0x05, 0xcf, 0xa2, 0xb2, 0xc5, 0x09, 0x5a, 0x67, 0xd1, 0x7e, 0xf0,
+	0x26, 0x79, 0x49, 0xe2, 0x38, 0xb8, 0x26, 0xd0, 0x44, 0xf5, 0x71, 0x7c, 0x5d, 0x00, 0x05, 0x6e,
+	0xaa, 0xfd, 0x0b, 0x29, 0xd2, 0x67, 0x71, 0x5e, 0x17, 0xed, 0x5a, 0x61, 0x06, 0x76, 0xd0, 0xbd,
+	0x24, 0x23, 0x0b, 0x06, 0x00, 0x75, 0x7f, 0x31, 0x61, 0x54, 0x01, 0xfb, 0x08, 0xf5, 0xc9, 0x28,
+	0xb8, 0xbd, 0x4a, 0x06, 0x63, 0xc2, 0x7e, 0xf1, 0xeb, 0xfe, 0x32, 0xb3, 0x5c, 0x0e, 0xc6, 0xc4,
+	0xfb, 0x31, 0xc2, 0xf6, 0x65, 0x58, 0xb3, 0x3e, 0xfe, 0x9b, 0x8b, 0xea, 0x97, 0x83, 0x61, 0x0a,
+	0x5f, 0xa0, 0xbb, 0xed, 0x94, 0xd6, 0x5d, 0x53, 0xb7, 0x02, 0x1b, 0x29, 0xc4, 0x73, 0xe0, 0x4b,
+	0xb4, 0xd8, 0x4e, 0xd9, 0x65, 0x37, 0xb6, 0x2e, 0xb0, 0x19, 0x49, 0x3c, 0x07, 0x5a, 0x08, 0xb5,
+	0x53, 0x4e, 0x18, 0xd6, 0x3e, 0x06, 0xb6, 0x23, 0x8a, 0xe7, 0xc0, 0x2b, 0xb4, 0xd1, 0x4e, 0xf5,
+	0xcb, 0x3e, 0xef, 0xa3, 0x03, 0xcf, 0x2d, 0x21, 0x9e, 0x03, 0x7d, 0xb4, 0xdd, 0xfe, 0x8d, 0xe9,
+	0xc2, 0x7f, 0xc8, 0x17, 0x08, 0xfe, 0xa0, 0x92, 0xe2, 0x39, 0xf0, 0x2b, 0xf4, 0xa0, 0x9d, 0x2a,
+	0xf7, 0xb7, 0xf2, 0x23, 0x02, 0x57, 0x17, 0x03, 0xcf, 0x81, 0xef, 0xd0, 0x7a, 0x3b, 0xd5, 0xea,
+	0xca, 0x9c, 0xef, 0x1b, 0x3c, 0xaf, 0x54, 0x79, 0x0e, 0xfc, 0x1c, 0x2d, 0xb5, 0xd3, 0x9c, 0xec,
+	0x2c, 0xcd, 0x23, 0x6c, 0x83, 0xc2, 0x42, 0x9e, 0x53, 0x9e, 0xa5, 0x93, 0x84, 0x6d, 0x80, 0xe8,
+	0x39, 0xf0, 0x14, 0x2d, 0xb7, 0xd3, 0x82, 0xf7, 0x6c, 0x6d, 0x25, 0x6c, 0xa5, 0xc5, 0xe2, 0x65,
+	0xe3, 0x60, 0x61, 0xed, 0x31, 0x61, 0x3b, 0x3a, 0x7a, 0x0e, 0xf8, 0x68, 0x2d, 0x4f, 0xc2, 0x5f,
+	0x86, 0xea, 0x86, 0x13, 0x9e, 0xc3, 0x92, 0xc5, 0xc4, 0x38, 0x11, 0x5a, 0xbb, 0x4f, 0xd8, 0x8e,
+	0x94, 0x9e, 0x03, 0x17, 0x68, 0xb5, 0x9d, 0xca, 0x5c, 0x58, 0xd5, 0x8a, 0xc2, 0x95, 0x84, 0xe9,
+	0x39, 0xf0, 0x19, 0xaa, 0xb7, 0xd3, 0xb3, 0x16, 0x18, 0xfa, 0x52, 0xd8, 0x04, 0x99, 0xc5, 0x04,
+	0x64, 0x7a, 0xa8, 0x6a, 0x52, 0xe1, 0x4a, 0xf2, 0xf4, 0x1c, 0x78, 0x8e, 0xee, 0x67, 0x7b, 0xd2,
+	0x4d, 0x22, 0x12, 0x8c, 0x2d, 0x85, 0xe5, 0xa1, 0x62, 0xcd, 0x42, 0x45, 0x92, 0xe3, 0x1a, 0x3c,
+	0x13, 0x3f, 0x38, 0x60, 0xfd, 0x86, 0xc5, 0xf6, 0x1f, 0x21, 0xcf, 0x81, 0x27, 0x05, 0xf6, 0x82,
+	0xa5, 0x73, 0x86, 0x6d, 0x20, 0xec, 0x39, 0xf0, 0xad, 0x02, 0xc0, 0x50, 0xd5, 0x44, 0xc3, 0x95,
+	0x5c, 0xcc, 0x27, 0xd2, 0x99, 0x69, 0x13, 0xe9, 0xcc, 0xcc, 0x13, 0xe9, 0xcc, 0x2c, 0x13, 0xe9,
+	0xcc, 0x4c, 0x13, 0xe9, 0xcc, 0x2a, 0x26, 0xa2, 0xe6, 0x3a, 0x95, 0xf0, 0x18, 0xec, 0x5d, 0x36,
+	0x5c, 0xc1, 0xcc, 0x9e, 0x03, 0x5d, 0x1d, 0x94, 0x61, 0x4e, 0xc3, 0x0d, 0xcf, 0x43, 0x68, 0xcf,
+	0x81, 0x6f, 0x38, 0x32, 0x83, 0xad, 0xf7, 0x86, 0xad, 0x14, 0xcd, 0x27, 0x25, 0xbf, 0xc5, 0x73,
+	0xda, 0x70, 0x78, 0x1e, 0x56, 0x7b, 0x0e, 0xbc, 0x54, 0x31, 0x1a, 0x2a, 0x3b, 0x72, 0xb8, 0x1a,
+	0xaf, 0x3d, 0x07, 0x7e, 0x6f, 0xa0, 0x3b, 0x98, 0xdf, 0x2e, 0xc2, 0x1f, 0x80, 0x7e, 0x7c, 0xb2,
+	0x02, 0xd9, 0x2a, 0x3b, 0x47, 0xb8, 0x9a, 0xff, 0xb2, 0xaa, 0x90, 0xef, 0x72, 0x7e, 0x91, 0xad,
+	0xc7, 0xd2, 0xd0, 0x1d, 0x86, 0xeb, 0x7c, 0x85, 0xe0, 0xbb, 0x49, 0x1c, 0xbc, 0x21, 0xa7, 0x24,
+	0x4e, 0xa2, 0xf0, 0x36, 0x3b, 0x22, 0xb1, 0xb0, 0xb2, 0xb3, 0xc8, 0xfe, 0x49, 0x65, 0x0c, 0x9f,
+	0xee, 0xcf, 0x94, 0x8f, 0x15, 0x30, 0x36, 0x3e, 0xb1, 0xf9, 0xe3, 0x85, 0x5d, 0x8c, 0x0d, 0x49,
+	0xcd, 0x2b, 0xd7, 0xff, 0x93, 0xe3, 0xb8, 0x06, 0x4f, 0x50, 0x9d, 0xd2, 0x22, 0x54, 0x20, 0x24,
+	0xde, 0xd4, 0x7c, 0xa7, 0xe1, 0x84, 0x78, 0xce, 0x51, 0x0d, 0xbe, 0x41, 0xcb, 0x9c, 0x4c, 0xc1,
+	0x55, 0xd0, 0xf6, 0x83, 0xf4, 0xcf, 0xd0, 0x52, 0x77, 0x12, 0x4c, 0xe3, 0xb7, 0x21, 0xc5, 0x10,
+	0x35, 0xa8, 0x70, 0xb4, 0xde, 0xce, 0x26, 0x43, 0x7b, 0x8a, 0x6f, 0xd1, 0x4a, 0x77, 0x3a, 0x1a,
+	0xe4, 0xd0, 0x2d, 0x95, 0x1a, 0xc9, 0x5a, 0x2e, 0x35, 0x8a, 0x53, 0x29, 0x35, 0x24, 0xe8, 0x9f,
+	0x4f, 0xfa, 0xe4, 0x9d, 0x5c, 0x6a, 0x0a, 0x9b, 0xa1, 0xd4, 0x08, 0x97, 0x7c, 0xab, 0xb5, 0x8f,
+	0x80, 0x39, 0x1d, 0x49, 0x3c, 0xef, 0xbb, 0x22, 0xbb, 0x28, 0x2f, 0xd3, 0x5e, 0xef, 0x8c, 0x24,
+	0x27, 0xb7, 0x6d, 0x72, 0x2b, 0x5d, 0x14, 0xd9, 0x5c, 0xbe, 0x28, 0xaa, 0x97, 0xa7, 0xfb, 0x1d,
+	0x5a, 0xe7, 0x9e, 0x6e, 0x12, 0x44, 0xc9, 0x65, 0x0c, 0x87, 0x65, 0x51, 0xee, 0x2a, 0xd2, 0x7e,
+	0x5c, 0x11, 0x21, 0x31, 0xcb, 0xaa, 0xf2, 0x51, 0x00, 0x7b, 0x55, 0x7f, 0xf4, 0xc4, 0xfb, 0x95,
+	0xbd, 0x50, 0x7a, 0xc4, 0xc7, 0xb5, 0x93, 0x4f, 0xff, 0xf9, 0xd7, 0xa5, 0xda, 0xdf, 0xdf, 0x37,
+	0x6a, 0xff, 0x78, 0xdf, 0xa8, 0xfd, 0xfb, 0x7d, 0xa3, 0xf6, 0xe7, 0xff, 0x34, 0x1c, 0xb4, 0x1e,
+	0x46, 0xd7, 0x4c, 0xdd, 0x1c, 0xa6, 0xec, 0x4f, 0xb1, 0xaf, 0x17, 0xd9, 0x3f, 0x9f, 0xff, 0x6f,
+	0x00, 0x82, 0x29, 0xb9, 0x45, 0x07, 0x1e, 0x00, 0x00,
 }
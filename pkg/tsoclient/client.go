@@ -0,0 +1,368 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tsoclient fetches timestamps from the PD leader, batching
+// concurrent callers into one PD.Tso request.
+package tsoclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+)
+
+// ErrClientClosed is returned for timestamps requested after Close.
+var ErrClientClosed = errors.New("tsoclient: client is closed")
+
+// Options configures a Client.
+type Options struct {
+	// ClusterID is sent in every request header.
+	ClusterID uint64
+	// Dial connects to the PD member serving at a client URL. It is called
+	// at most once per URL.
+	Dial func(ctx context.Context, url string) (pdpb.PDClient, error)
+	// MaxBatchSize is the most timestamps fetched in one request.
+	MaxBatchSize int
+	// RetryInterval is the pause after a failed request before the leader
+	// is looked up again.
+	RetryInterval time.Duration
+	// Timeout bounds leader discovery and each Tso round trip.
+	Timeout time.Duration
+}
+
+const (
+	defaultMaxBatchSize  = 10000
+	defaultRetryInterval = 100 * time.Millisecond
+	defaultTimeout       = 3 * time.Second
+)
+
+// TSFuture is a timestamp being fetched.
+type TSFuture struct {
+	ctx    context.Context
+	closed <-chan struct{}
+	done   chan struct{}
+	ts     uint64
+	err    error
+}
+
+func (f *TSFuture) finish(ts uint64, err error) {
+	f.ts, f.err = ts, err
+	close(f.done)
+}
+
+// Wait blocks until the timestamp arrives, the context of the request is
+// done or the client is closed.
+func (f *TSFuture) Wait() (uint64, error) {
+	select {
+	case <-f.done:
+		return f.ts, f.err
+	case <-f.ctx.Done():
+		return 0, f.ctx.Err()
+	case <-f.closed:
+		select {
+		case <-f.done:
+			return f.ts, f.err
+		default:
+			return 0, ErrClientClosed
+		}
+	}
+}
+
+// Client hands out timestamps from the PD leader. Requests waiting while a
+// batch is in flight go together in the next one, as a single TsoRequest
+// whose count is sliced into consecutive timestamps.
+//
+// When the stream fails, the batch in flight fails with it, and the leader
+// is looked up again through GetMembers before the next batch.
+type Client struct {
+	opts Options
+
+	reqCh     chan *TSFuture
+	closed    chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+
+	mu      sync.Mutex
+	urls    []string
+	clients map[string]pdpb.PDClient
+	leader  string
+	stream  pdpb.PD_TsoClient
+	cancel  context.CancelFunc
+}
+
+// NewClient creates a Client that finds the PD leader from urls, the client
+// URLs of any PD members.
+func NewClient(urls []string, opts Options) *Client {
+	if opts.MaxBatchSize <= 0 {
+		opts.MaxBatchSize = defaultMaxBatchSize
+	}
+	if opts.RetryInterval <= 0 {
+		opts.RetryInterval = defaultRetryInterval
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	c := &Client{
+		opts:    opts,
+		reqCh:   make(chan *TSFuture, opts.MaxBatchSize),
+		closed:  make(chan struct{}),
+		urls:    append([]string(nil), urls...),
+		clients: make(map[string]pdpb.PDClient),
+	}
+	c.wg.Add(1)
+	go c.dispatchLoop()
+	return c
+}
+
+// GetTSAsync requests a timestamp and returns without waiting for it.
+func (c *Client) GetTSAsync(ctx context.Context) *TSFuture {
+	f := &TSFuture{ctx: ctx, closed: c.closed, done: make(chan struct{})}
+	select {
+	case c.reqCh <- f:
+	case <-ctx.Done():
+		f.finish(0, ctx.Err())
+	case <-c.closed:
+		f.finish(0, ErrClientClosed)
+	}
+	return f
+}
+
+// GetTS returns a timestamp from the PD leader.
+func (c *Client) GetTS(ctx context.Context) (uint64, error) {
+	return c.GetTSAsync(ctx).Wait()
+}
+
+// Leader returns the client URL of the last PD leader found, or "" if there
+// is none yet.
+func (c *Client) Leader() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.leader
+}
+
+// Close stops the client. Requests still waiting fail with ErrClientClosed.
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.wg.Wait()
+		c.resetStream()
+	})
+}
+
+func (c *Client) dispatchLoop() {
+	defer c.wg.Done()
+	for {
+		batch := c.collect()
+		if batch == nil {
+			return
+		}
+		if err := c.process(batch); err != nil {
+			for _, f := range batch {
+				f.finish(0, err)
+			}
+			c.resetStream()
+			select {
+			case <-time.After(c.opts.RetryInterval):
+			case <-c.closed:
+				return
+			}
+		}
+	}
+}
+
+// collect blocks for the first request and takes all others waiting, up to
+// MaxBatchSize. Requests whose context is done are dropped.
+func (c *Client) collect() []*TSFuture {
+	var batch []*TSFuture
+	add := func(f *TSFuture) {
+		if err := f.ctx.Err(); err != nil {
+			f.finish(0, err)
+			return
+		}
+		batch = append(batch, f)
+	}
+	for len(batch) == 0 {
+		select {
+		case f := <-c.reqCh:
+			add(f)
+		case <-c.closed:
+			return nil
+		}
+		for len(batch) > 0 && len(batch) < c.opts.MaxBatchSize {
+			select {
+			case f := <-c.reqCh:
+				add(f)
+				continue
+			default:
+			}
+			break
+		}
+	}
+	return batch
+}
+
+func (c *Client) process(batch []*TSFuture) error {
+	stream, err := c.getStream()
+	if err != nil {
+		return err
+	}
+	count := uint32(len(batch))
+	err = stream.Send(&pdpb.TsoRequest{
+		Header: &pdpb.RequestHeader{ClusterId: c.opts.ClusterID},
+		Count:  count,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := c.recv(stream)
+	if err != nil {
+		return err
+	}
+	if err = kverror.FromPDError(resp.GetHeader().GetError()); err != nil {
+		return err
+	}
+	if resp.GetCount() != count {
+		return fmt.Errorf("tsoclient: expect %d timestamps, got %d", count, resp.GetCount())
+	}
+	// The response carries the last of the count timestamps.
+	physical, last := resp.GetTimestamp().GetPhysical(), resp.GetTimestamp().GetLogical()
+	first := last - int64(count) + 1
+	for i, f := range batch {
+		f.finish(pdpb.ComposeTS(physical, first+int64(i)), nil)
+	}
+	return nil
+}
+
+// recv waits for a response for at most Timeout, so that a hung leader does
+// not block the client forever.
+func (c *Client) recv(stream pdpb.PD_TsoClient) (*pdpb.TsoResponse, error) {
+	type result struct {
+		resp *pdpb.TsoResponse
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		resp, err := stream.Recv()
+		ch <- result{resp, err}
+	}()
+	select {
+	case r := <-ch:
+		return r.resp, r.err
+	case <-time.After(c.opts.Timeout):
+		return nil, errors.New("tsoclient: tso request timed out")
+	case <-c.closed:
+		return nil, ErrClientClosed
+	}
+}
+
+func (c *Client) getStream() (pdpb.PD_TsoClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stream != nil {
+		return c.stream, nil
+	}
+	client, err := c.discoverLeader()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.Tso(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	c.stream, c.cancel = stream, cancel
+	return stream, nil
+}
+
+func (c *Client) resetStream() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.stream, c.cancel = nil, nil
+}
+
+// discoverLeader asks the known members for the leader, starting from the
+// last leader, and learns the URLs of all members on the way.
+func (c *Client) discoverLeader() (pdpb.PDClient, error) {
+	urls := c.urls
+	if c.leader != "" {
+		urls = append([]string{c.leader}, urls...)
+	}
+	var lastErr error
+	for _, url := range urls {
+		client, err := c.getClient(url)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), c.opts.Timeout)
+		resp, err := client.GetMembers(ctx, &pdpb.GetMembersRequest{Header: &pdpb.RequestHeader{ClusterId: c.opts.ClusterID}})
+		cancel()
+		if err == nil {
+			err = kverror.FromPDError(resp.GetHeader().GetError())
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(resp.GetLeader().GetClientUrls()) == 0 {
+			lastErr = errors.New("tsoclient: pd has no leader")
+			continue
+		}
+		c.updateURLs(resp.GetMembers())
+		leader := resp.GetLeader().GetClientUrls()[0]
+		client, err = c.getClient(leader)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		c.leader = leader
+		return client, nil
+	}
+	if lastErr == nil {
+		lastErr = errors.New("tsoclient: no pd url")
+	}
+	return nil, lastErr
+}
+
+func (c *Client) updateURLs(members []*pdpb.Member) {
+	var urls []string
+	for _, member := range members {
+		urls = append(urls, member.GetClientUrls()...)
+	}
+	if len(urls) > 0 {
+		c.urls = urls
+	}
+}
+
+func (c *Client) getClient(url string) (pdpb.PDClient, error) {
+	if client, ok := c.clients[url]; ok {
+		return client, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.opts.Timeout)
+	defer cancel()
+	client, err := c.opts.Dial(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[url] = client
+	return client, nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsoclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/mockpd"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testClusterID = 42
+
+// testCluster serves each member of a mock PD at the URL "pd<id>".
+type testCluster struct {
+	server    *mockpd.Server
+	listeners map[string]*bufconn.Listener
+	servers   []*grpc.Server
+	conns     []*grpc.ClientConn
+}
+
+func newTestCluster(members int) *testCluster {
+	c := &testCluster{server: mockpd.NewServer(testClusterID), listeners: make(map[string]*bufconn.Listener)}
+	var pdMembers []*pdpb.Member
+	for id := uint64(1); id <= uint64(members); id++ {
+		url := fmt.Sprintf("pd%d", id)
+		pdMembers = append(pdMembers, &pdpb.Member{Name: url, MemberId: id, ClientUrls: []string{url}})
+		lis := bufconn.Listen(1 << 20)
+		s := grpc.NewServer()
+		pdpb.RegisterPDServer(s, c.server.Member(id))
+		go s.Serve(lis)
+		c.listeners[url] = lis
+		c.servers = append(c.servers, s)
+	}
+	c.server.SetMembers(pdMembers, 1)
+	return c
+}
+
+func (c *testCluster) dial(ctx context.Context, url string) (pdpb.PDClient, error) {
+	lis, ok := c.listeners[url]
+	if !ok {
+		return nil, fmt.Errorf("unknown url %s", url)
+	}
+	conn, err := grpc.DialContext(ctx, url, grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		return nil, err
+	}
+	c.conns = append(c.conns, conn)
+	return pdpb.NewPDClient(conn), nil
+}
+
+func (c *testCluster) close() {
+	for _, conn := range c.conns {
+		conn.Close()
+	}
+	for _, s := range c.servers {
+		s.Stop()
+	}
+}
+
+func (c *testCluster) newClient(urls ...string) *Client {
+	return NewClient(urls, Options{ClusterID: testClusterID, Dial: c.dial, RetryInterval: time.Millisecond})
+}
+
+func TestConcurrentGetTS(t *testing.T) {
+	cluster := newTestCluster(1)
+	defer cluster.close()
+	client := cluster.newClient("pd1")
+	defer client.Close()
+
+	const n = 1000
+	var wg sync.WaitGroup
+	tss := make([]uint64, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tss[i], errs[i] = client.GetTS(context.Background())
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	sort.Slice(tss, func(i, j int) bool { return tss[i] < tss[j] })
+	for i := 1; i < n; i++ {
+		if tss[i] == tss[i-1] {
+			t.Fatalf("duplicated ts %d", tss[i])
+		}
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	cluster := newTestCluster(1)
+	defer cluster.close()
+	client := cluster.newClient("pd1")
+	defer client.Close()
+
+	ctx := context.Background()
+	futures := make([]*TSFuture, 100)
+	for i := range futures {
+		futures[i] = client.GetTSAsync(ctx)
+	}
+	var last uint64
+	for _, f := range futures {
+		ts, err := f.Wait()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ts <= last {
+			t.Fatalf("ts %d is not after %d", ts, last)
+		}
+		last = ts
+	}
+	if physical := pdpb.ExtractPhysical(last); time.Since(pdpb.TimeFromTS(last)) > time.Minute || physical <= 0 {
+		t.Fatalf("unexpected physical time %d", physical)
+	}
+}
+
+func TestLeaderChange(t *testing.T) {
+	cluster := newTestCluster(3)
+	defer cluster.close()
+	// Start from a follower only; the client must find the leader itself.
+	client := cluster.newClient("pd2")
+	defer client.Close()
+	ctx := context.Background()
+
+	before, err := client.GetTS(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Leader() != "pd1" {
+		t.Fatalf("expect leader pd1, got %q", client.Leader())
+	}
+
+	cluster.server.SetMembers([]*pdpb.Member{
+		{Name: "pd1", MemberId: 1, ClientUrls: []string{"pd1"}},
+		{Name: "pd2", MemberId: 2, ClientUrls: []string{"pd2"}},
+		{Name: "pd3", MemberId: 3, ClientUrls: []string{"pd3"}},
+	}, 3)
+	var after uint64
+	for i := 0; i < 10; i++ {
+		// The batch in flight on the old leader fails once.
+		if after, err = client.GetTS(ctx); err == nil {
+			break
+		}
+	}
+	if err != nil || after <= before {
+		t.Fatalf("expect ts after %d, got %d %v", before, after, err)
+	}
+	if client.Leader() != "pd3" {
+		t.Fatalf("expect leader pd3, got %q", client.Leader())
+	}
+}
+
+func TestCancelAndClose(t *testing.T) {
+	cluster := newTestCluster(1)
+	defer cluster.close()
+	client := cluster.newClient("pd1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.GetTS(ctx); err != context.Canceled {
+		t.Fatalf("expect canceled, got %v", err)
+	}
+	client.Close()
+	if _, err := client.GetTS(context.Background()); err != ErrClientClosed {
+		t.Fatalf("expect closed, got %v", err)
+	}
+}
+
+func TestNoLeader(t *testing.T) {
+	cluster := newTestCluster(1)
+	defer cluster.close()
+	client := cluster.newClient("unknown")
+	defer client.Close()
+
+	if _, err := client.GetTS(context.Background()); err == nil {
+		t.Fatal("expect error without a reachable member")
+	}
+}
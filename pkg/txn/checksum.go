@@ -0,0 +1,80 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/pingcap/kvproto/pkg/checksum"
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// Checksum returns the checksum of the keys in [startKey, endKey) at
+// version, asking the regions one after another. An empty endKey means
+// +inf. Locks are resolved, or waited for, as in Get.
+func (c *Client) Checksum(ctx context.Context, startKey, endKey []byte, version uint64) (checksum.Checksum, error) {
+	var (
+		total    checksum.Checksum
+		resolved []uint64
+	)
+	for len(endKey) == 0 || bytes.Compare(startKey, endKey) < 0 {
+		var (
+			regionEnd []byte
+			part      checksum.Checksum
+		)
+		err := c.sender.SendKey(ctx, startKey, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+			regionEnd = region.Meta.GetEndKey()
+			reqCtx := region.Context()
+			reqCtx.ResolvedLocks = resolved
+			resp, err := client.Checksum(ctx, &kvrpcpb.ChecksumRequest{
+				Context: reqCtx,
+				Version: version,
+				Range:   &kvrpcpb.KeyRange{StartKey: startKey, EndKey: endKey},
+			})
+			if err != nil || resp.GetRegionError() != nil {
+				return resp.GetRegionError(), err
+			}
+			part = checksum.Checksum{
+				Crc64Xor:   resp.GetCrc64Xor(),
+				TotalKvs:   resp.GetTotalKvs(),
+				TotalBytes: resp.GetTotalBytes(),
+			}
+			return nil, kverror.FromKeyError(resp.GetError())
+		})
+		var locked *kverror.LockedError
+		if errors.As(err, &locked) {
+			pushed, err := c.resolveLocks(ctx, version, []*kvrpcpb.LockInfo{locked.LockInfo})
+			if err != nil {
+				return checksum.Checksum{}, err
+			}
+			resolved = append(resolved, pushed...)
+			continue
+		}
+		if err != nil {
+			return checksum.Checksum{}, err
+		}
+		total.Merge(part)
+		if len(regionEnd) == 0 {
+			break
+		}
+		startKey = regionEnd
+	}
+	return total, nil
+}
@@ -0,0 +1,317 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// committer runs the two-phase commit of a transaction:
+//
+//  1. prewrite all mutations, grouped by region, with the smallest key as
+//     the primary lock unless a pessimistic lock already picked one;
+//  2. commit the primary key, which commits the transaction;
+//  3. commit the secondary keys.
+//
+// Mutations are sent in batches of at most txnCommitBatchSize bytes per
+// request, and the locks get a TTL that grows with the size of the
+// transaction.
+//
+// If anything fails before the primary is committed, all keys are rolled
+// back. Once it is committed, failures on secondaries are left to readers,
+// which resolve the locks by checking the primary.
+type committer struct {
+	txn       *Txn
+	keys      [][]byte
+	mutations map[string]*kvrpcpb.Mutation
+	primary   []byte
+	lockTTL   uint64
+	commitTS  uint64
+	// minCommitTS is the min_commit_ts of the locks of a large transaction.
+	minCommitTS uint64
+	// heartBeat starts the heartbeat once the primary lock is written.
+	heartBeat sync.Once
+}
+
+// txnCommitBatchSize is the size in bytes of the mutations sent in one
+// prewrite request.
+const txnCommitBatchSize = 16 * 1024
+
+func newCommitter(txn *Txn) *committer {
+	mutations := make(map[string]*kvrpcpb.Mutation, len(txn.mutations)+len(txn.locked))
+	for key, m := range txn.mutations {
+		mutations[key] = m
+	}
+	// Keys locked but not written are prewritten as Lock, which commits
+	// nothing but releases the pessimistic lock.
+	for key := range txn.locked {
+		if _, ok := mutations[key]; !ok {
+			mutations[key] = &kvrpcpb.Mutation{Op: kvrpcpb.Op_Lock, Key: []byte(key)}
+		}
+	}
+	keys := make([][]byte, 0, len(mutations))
+	size := 0
+	for _, m := range mutations {
+		keys = append(keys, m.GetKey())
+		size += len(m.GetKey()) + len(m.GetValue())
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	if txn.primary == nil {
+		txn.primary = keys[0]
+	}
+	lockTTL := txnLockTTL(txn.client.opts.LockTTL, size)
+	atomic.StoreUint64(&txn.lockTTL, lockTTL)
+	return &committer{
+		txn:       txn,
+		keys:      keys,
+		mutations: mutations,
+		primary:   txn.primary,
+		lockTTL:   lockTTL,
+	}
+}
+
+func (c *committer) sender() *regioncache.Sender {
+	return c.txn.client.sender
+}
+
+func (c *committer) opts() *Options {
+	return &c.txn.client.opts
+}
+
+func (c *committer) execute(ctx context.Context) (err error) {
+	defer c.txn.endHeartBeat()
+
+	undetermined := false
+	defer func() {
+		if err != nil && !undetermined {
+			c.rollback(ctx)
+		}
+	}()
+
+	// A reader with a start ts below this timestamp may already have read
+	// the keys, so a large transaction must not commit before it. TiKV
+	// raises it past the reads that come later, until the keys are locked.
+	if c.txn.large {
+		if c.minCommitTS, err = c.txn.client.oracle.GetTS(ctx); err != nil {
+			return err
+		}
+	}
+	if err = c.prewrite(ctx); err != nil {
+		return err
+	}
+	// A large transaction is committed at its min_commit_ts, which TiKV
+	// picks when the commit ts is 0.
+	if !c.txn.large {
+		if c.commitTS, err = c.txn.client.oracle.GetTS(ctx); err != nil {
+			return err
+		}
+	}
+	if err = c.commitPrimary(ctx); err != nil {
+		var (
+			keyErr    interface{ KeyError() *kvrpcpb.KeyError }
+			regionErr *kverror.RegionError
+		)
+		if !errors.As(err, &keyErr) && !errors.As(err, &regionErr) {
+			// The primary may have been committed without a response.
+			undetermined = true
+			return fmt.Errorf("%w: %v", ErrUndetermined, err)
+		}
+		return err
+	}
+	// The transaction is committed; a failure here only leaves locks for
+	// readers to resolve.
+	_ = c.commitKeys(ctx, c.secondaries())
+	return nil
+}
+
+func (c *committer) secondaries() [][]byte {
+	keys := make([][]byte, 0, len(c.keys)-1)
+	for _, key := range c.keys {
+		if !bytes.Equal(key, c.primary) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (c *committer) prewrite(ctx context.Context) error {
+	return c.sender().SendKeys(ctx, c.keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		for _, batch := range c.batches(keys) {
+			if regionErr, err := c.prewriteBatch(ctx, client, region, batch); regionErr != nil || err != nil {
+				// Batches already prewritten are prewritten again on retry,
+				// which is a no-op.
+				return regionErr, err
+			}
+		}
+		return nil, nil
+	})
+}
+
+// batches splits keys into batches of at most txnCommitBatchSize bytes of
+// mutations.
+func (c *committer) batches(keys [][]byte) [][][]byte {
+	var (
+		batches [][][]byte
+		start   int
+		size    int
+	)
+	for i, key := range keys {
+		m := c.mutations[string(key)]
+		size += len(m.GetKey()) + len(m.GetValue())
+		if size >= txnCommitBatchSize {
+			batches = append(batches, keys[start:i+1])
+			start, size = i+1, 0
+		}
+	}
+	if start < len(keys) {
+		batches = append(batches, keys[start:])
+	}
+	return batches
+}
+
+func (c *committer) prewriteBatch(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+	mutations := make([]*kvrpcpb.Mutation, 0, len(keys))
+	var isPessimisticLock []bool
+	for _, key := range keys {
+		mutations = append(mutations, c.mutations[string(key)])
+		if c.txn.pessimistic {
+			isPessimisticLock = append(isPessimisticLock, c.txn.locked[string(key)])
+		}
+	}
+	req := &kvrpcpb.PrewriteRequest{
+		Context:           region.Context(),
+		Mutations:         mutations,
+		PrimaryLock:       c.primary,
+		StartVersion:      c.txn.startTS,
+		LockTtl:           c.lockTTL,
+		IsPessimisticLock: isPessimisticLock,
+		TxnSize:           uint64(len(c.keys)),
+		ForUpdateTs:       c.txn.forUpdateTS,
+	}
+	if c.txn.large {
+		req.MinCommitTs = c.minCommitTS
+	}
+	// Two transactions waiting for each other's locks would wait forever
+	// while their heartbeats keep the locks alive, so a live lock is waited
+	// for at most LockTTL.
+	deadline := time.Now().Add(time.Duration(c.opts().LockTTL) * time.Millisecond)
+	for {
+		resp, err := client.KvPrewrite(ctx, req)
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		locks, err := extractLocks(resp.GetErrors())
+		if err != nil {
+			return nil, err
+		}
+		if len(locks) == 0 {
+			for _, key := range keys {
+				if bytes.Equal(key, c.primary) {
+					c.heartBeat.Do(c.txn.startHeartBeat)
+				}
+			}
+			return nil, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, &kverror.LockedError{LockInfo: locks[0]}
+		}
+		// Prewrite writes nothing if any key fails, so the whole request is
+		// sent again once the locks are gone.
+		if _, err = c.txn.client.resolveLocks(ctx, 0, locks); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// extractLocks returns the locks in errs, or the first error that is not a
+// lock.
+func extractLocks(errs []*kvrpcpb.KeyError) ([]*kvrpcpb.LockInfo, error) {
+	var locks []*kvrpcpb.LockInfo
+	for _, e := range errs {
+		if e.GetLocked() == nil {
+			return nil, kverror.FromKeyError(e)
+		}
+		locks = append(locks, e.GetLocked())
+	}
+	return locks, nil
+}
+
+// commitPrimary commits the primary key. If commitTS is 0, it is set to the
+// commit ts picked by TiKV.
+func (c *committer) commitPrimary(ctx context.Context) error {
+	return c.sender().SendKey(ctx, c.primary, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.KvCommit(ctx, &kvrpcpb.CommitRequest{
+			Context:       region.Context(),
+			StartVersion:  c.txn.startTS,
+			Keys:          [][]byte{c.primary},
+			CommitVersion: c.commitTS,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		if err := kverror.FromKeyError(resp.GetError()); err != nil {
+			return nil, err
+		}
+		if c.commitTS == 0 {
+			if c.commitTS = resp.GetCommitVersion(); c.commitTS == 0 {
+				return nil, errors.New("txn: no commit version in commit response")
+			}
+		}
+		return nil, nil
+	})
+}
+
+func (c *committer) commitKeys(ctx context.Context, keys [][]byte) error {
+	return c.sender().SendKeys(ctx, keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		resp, err := client.KvCommit(ctx, &kvrpcpb.CommitRequest{
+			Context:       region.Context(),
+			StartVersion:  c.txn.startTS,
+			Keys:          keys,
+			CommitVersion: c.commitTS,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, kverror.FromKeyError(resp.GetError())
+	})
+}
+
+// rollback removes the locks of the transaction. It is best effort: locks it
+// misses expire and are rolled back by readers.
+func (c *committer) rollback(ctx context.Context) {
+	_ = c.sender().SendKeys(ctx, c.keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		resp, err := client.KvBatchRollback(ctx, &kvrpcpb.BatchRollbackRequest{
+			Context:      region.Context(),
+			StartVersion: c.txn.startTS,
+			Keys:         keys,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, kverror.FromKeyError(resp.GetError())
+	})
+}
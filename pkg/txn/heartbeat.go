@@ -0,0 +1,106 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// startHeartBeat keeps the primary lock alive until endHeartBeat, by raising
+// its TTL to the age of the transaction plus the lock TTL on every
+// HeartBeatInterval, and reports TTL changes to onLockTTL. It does nothing
+// if the heartbeat is already running.
+func (t *Txn) startHeartBeat() {
+	if t.stopHeartBeat != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(t.client.opts.HeartBeatInterval)
+		defer ticker.Stop()
+		last := atomic.LoadUint64(&t.lockTTL)
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			ttl, err := t.heartBeat(ctx, t.adviseTTL())
+			if err != nil {
+				// The lock is gone or the store is unreachable; either way
+				// the commit itself will find out.
+				return
+			}
+			if ttl != last && t.onLockTTL != nil {
+				t.onLockTTL(ttl)
+			}
+			last = ttl
+		}
+	}()
+	t.stopHeartBeat = func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// endHeartBeat stops the heartbeat if it is running.
+func (t *Txn) endHeartBeat() {
+	if t.stopHeartBeat != nil {
+		t.stopHeartBeat()
+		t.stopHeartBeat = nil
+	}
+}
+
+// adviseTTL returns the TTL that keeps the primary lock alive for another
+// lock TTL from now.
+func (t *Txn) adviseTTL() uint64 {
+	age := time.Since(pdpb.TimeFromTS(t.startTS))
+	if age < 0 {
+		age = 0
+	}
+	return uint64(age/time.Millisecond) + atomic.LoadUint64(&t.lockTTL)
+}
+
+// heartBeat raises the TTL of the primary lock to ttl and returns the TTL
+// the lock has afterwards.
+func (t *Txn) heartBeat(ctx context.Context, ttl uint64) (uint64, error) {
+	var lockTTL uint64
+	err := t.client.sender.SendKey(ctx, t.primary, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.KvTxnHeartBeat(ctx, &kvrpcpb.TxnHeartBeatRequest{
+			Context:       region.Context(),
+			PrimaryLock:   t.primary,
+			StartVersion:  t.startTS,
+			AdviseLockTtl: ttl,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		lockTTL = resp.GetLockTtl()
+		return nil, kverror.FromKeyError(resp.GetError())
+	})
+	return lockTTL, err
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// Iterator walks the keys of a transaction in a range, merging the buffered
+// writes into the snapshot streamed by KvScanStream. It must be closed
+// unless Next returned false.
+type Iterator struct {
+	ctx     context.Context
+	txn     *Txn
+	reverse bool
+	scanner *regioncache.StreamScanner
+	// pairs is what is left of the last chunk of the snapshot, and
+	// mutations the buffered writes in the range not reached yet, both in
+	// scan order.
+	pairs     []*kvrpcpb.KvPair
+	mutations []*kvrpcpb.Mutation
+	key       []byte
+	value     []byte
+	err       error
+}
+
+// Iter returns an iterator over the keys of [startKey, endKey) as seen by
+// the transaction, in descending order if reverse is set. An empty endKey
+// means +inf. Locks are resolved, or waited for, as in Get. Writes buffered
+// after Iter are not seen.
+func (t *Txn) Iter(ctx context.Context, startKey, endKey []byte, reverse bool) *Iterator {
+	streamStart, streamEnd := startKey, endKey
+	if reverse {
+		// KvScanStream scans [end_key, start_key) backwards.
+		streamStart, streamEnd = endKey, startKey
+	}
+	open := func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, startKey []byte) (regioncache.RecvFunc, error) {
+		reqCtx := region.Context()
+		reqCtx.ResolvedLocks = t.resolvedLocks
+		stream, err := client.KvScanStream(ctx, &kvrpcpb.ScanRequest{
+			Context:  reqCtx,
+			StartKey: startKey,
+			EndKey:   streamEnd,
+			Version:  t.startTS,
+			Reverse:  reverse,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return func() (*regioncache.StreamChunk, error) {
+			resp, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return &regioncache.StreamChunk{
+				RegionError: resp.GetRegionError(),
+				Pairs:       resp.GetPairs(),
+				ResumeKey:   resp.GetResumeKey(),
+			}, nil
+		}, nil
+	}
+	it := &Iterator{
+		ctx:     ctx,
+		txn:     t,
+		reverse: reverse,
+		scanner: t.client.sender.NewStreamScanner(ctx, streamStart, reverse, open),
+	}
+	for _, m := range t.mutations {
+		key := m.GetKey()
+		if bytes.Compare(key, startKey) >= 0 && (len(endKey) == 0 || bytes.Compare(key, endKey) < 0) {
+			it.mutations = append(it.mutations, m)
+		}
+	}
+	sort.Slice(it.mutations, func(i, j int) bool {
+		return it.before(it.mutations[i].GetKey(), it.mutations[j].GetKey())
+	})
+	if len(endKey) > 0 && bytes.Compare(startKey, endKey) >= 0 {
+		it.scanner.Close()
+		it.mutations = nil
+	}
+	return it
+}
+
+// before reports whether key a comes before b in scan order.
+func (it *Iterator) before(a, b []byte) bool {
+	if it.reverse {
+		return bytes.Compare(a, b) > 0
+	}
+	return bytes.Compare(a, b) < 0
+}
+
+// Next moves to the next key, and returns false at the end of the range or
+// on an error.
+func (it *Iterator) Next() bool {
+	for {
+		pair, err := it.peek()
+		if err != nil {
+			it.err = err
+			it.Close()
+			return false
+		}
+		if len(it.mutations) > 0 {
+			m := it.mutations[0]
+			if pair == nil || !it.before(pair.GetKey(), m.GetKey()) {
+				it.mutations = it.mutations[1:]
+				if pair != nil && bytes.Equal(pair.GetKey(), m.GetKey()) {
+					it.pairs = it.pairs[1:]
+				}
+				if m.GetOp() == kvrpcpb.Op_Del {
+					continue
+				}
+				it.key, it.value = m.GetKey(), m.GetValue()
+				return true
+			}
+		}
+		if pair == nil {
+			it.Close()
+			return false
+		}
+		it.pairs = it.pairs[1:]
+		it.key, it.value = pair.GetKey(), pair.GetValue()
+		return true
+	}
+}
+
+// peek returns the next pair of the snapshot, or nil at its end. It
+// resolves the locks it runs into and scans again from the locked key.
+func (it *Iterator) peek() (*kvrpcpb.KvPair, error) {
+	for len(it.pairs) == 0 {
+		pairs, err := it.scanner.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		it.pairs = pairs
+	}
+	pair := it.pairs[0]
+	if pair.GetError() == nil {
+		return pair, nil
+	}
+	err := kverror.FromKeyError(pair.GetError())
+	var locked *kverror.LockedError
+	if !errors.As(err, &locked) {
+		return nil, err
+	}
+	pushed, err := it.txn.client.resolveLocks(it.ctx, it.txn.startTS, []*kvrpcpb.LockInfo{locked.LockInfo})
+	if err != nil {
+		return nil, err
+	}
+	it.txn.resolvedLocks = append(it.txn.resolvedLocks, pushed...)
+	restart := pair.GetKey()
+	if it.reverse {
+		// The start key of a reverse scan is exclusive.
+		restart = append(append([]byte{}, restart...), 0)
+	}
+	it.pairs = nil
+	it.scanner.Restart(restart)
+	return it.peek()
+}
+
+// Key returns the current key.
+func (it *Iterator) Key() []byte {
+	return it.key
+}
+
+// Value returns the value of the current key.
+func (it *Iterator) Value() []byte {
+	return it.value
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the scan.
+func (it *Iterator) Close() {
+	it.scanner.Close()
+	it.pairs, it.mutations = nil, nil
+	it.key, it.value = nil, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+	"math"
+)
+
+const (
+	// ttlFactor scales the lock TTL with the square root of the size of a
+	// transaction in MiB.
+	ttlFactor = 6000
+	// maxLockTTL bounds the TTL a transaction asks for because of its size.
+	maxLockTTL = 120000
+)
+
+// txnLockTTL returns the TTL in milliseconds for the locks of a transaction
+// writing size bytes: base for small transactions, growing with the square
+// root of the size up to maxLockTTL for large ones.
+func txnLockTTL(base uint64, size int) uint64 {
+	ttl := uint64(ttlFactor * math.Sqrt(float64(size)/(1<<20)))
+	if ttl > maxLockTTL {
+		ttl = maxLockTTL
+	}
+	if ttl < base {
+		ttl = base
+	}
+	return ttl
+}
+
+// BeginLarge starts an optimistic transaction meant for more data than can
+// be committed within LockTTL, such as an import.
+//
+// Its locks carry a min_commit_ts, which starts from a timestamp fetched
+// right before the prewrite. TiKV raises it past the highest ts it has
+// served reads at, so that a reader which read a key before it was locked
+// does not see the commit below its start ts. A reader that runs into a
+// lock pushes the min_commit_ts of the primary past its own start ts, and
+// then reads on past the locks of the transaction without waiting. TiKV
+// commits the primary at the final min_commit_ts, or past the highest read
+// ts if that is higher, for the reads of the keys locked later, and returns
+// the commit ts in CommitResponse.commit_version, so none is fetched from
+// the oracle. While the transaction commits, the heartbeat keeps raising
+// the TTL of the primary lock with the age of the transaction; onLockTTL,
+// if not nil, is called with every TTL the lock gets. The heartbeat stops
+// on commit or rollback.
+func (c *Client) BeginLarge(ctx context.Context, onLockTTL func(ttl uint64)) (*Txn, error) {
+	txn, err := c.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txn.large = true
+	txn.onLockTTL = onLockTTL
+	return txn, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolvedCacheSize is the number of final transaction statuses kept.
+const resolvedCacheSize = 2048
+
+// TxnStatus is the status of a transaction, read from its primary lock.
+type TxnStatus struct {
+	// TTL is the TTL of the primary lock if the transaction is alive.
+	TTL uint64
+	// CommitTS is the commit timestamp if the transaction is committed.
+	CommitTS uint64
+	// Pushed is set if the transaction is alive but will commit after the
+	// caller, so that the caller can read past its locks.
+	Pushed bool
+}
+
+// IsCommitted reports whether the transaction is committed.
+func (s TxnStatus) IsCommitted() bool {
+	return s.TTL == 0 && s.CommitTS > 0
+}
+
+// IsRolledBack reports whether the transaction is rolled back.
+func (s TxnStatus) IsRolledBack() bool {
+	return s.TTL == 0 && s.CommitTS == 0
+}
+
+// LockResolver resolves the locks that block readers and writers, from
+// KeyError.locked or ScanLockResponse.locks. It checks the status of each
+// transaction on its primary key with CheckTxnStatus, which also rolls back
+// an expired primary, and then commits or rolls back the locks with one
+// ResolveLock per region.
+//
+// Final statuses are cached, so that other locks of the same transaction do
+// not hit the primary again. Against a TiKV without CheckTxnStatus, the
+// status is read through Cleanup instead.
+type LockResolver struct {
+	sender *regioncache.Sender
+	oracle Oracle
+
+	mu       sync.Mutex
+	resolved map[uint64]TxnStatus
+	// recent holds the keys of resolved in insertion order, for eviction.
+	recent []uint64
+}
+
+// NewLockResolver creates a LockResolver.
+func NewLockResolver(sender *regioncache.Sender, oracle Oracle) *LockResolver {
+	return &LockResolver{
+		sender:   sender,
+		oracle:   oracle,
+		resolved: make(map[uint64]TxnStatus),
+	}
+}
+
+func (r *LockResolver) getResolved(txnID uint64) (TxnStatus, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.resolved[txnID]
+	return s, ok
+}
+
+func (r *LockResolver) saveResolved(txnID uint64, s TxnStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.resolved[txnID]; ok {
+		return
+	}
+	r.resolved[txnID] = s
+	r.recent = append(r.recent, txnID)
+	if len(r.recent) > resolvedCacheSize {
+		delete(r.resolved, r.recent[0])
+		r.recent = r.recent[1:]
+	}
+}
+
+// ResolveLocks resolves locks for a reader at callerStartTS, or for a
+// writer if callerStartTS is 0. Locks of transactions still alive are left
+// in place. The transactions pushed past callerStartTS are returned, so that
+// the reader can send them in Context.resolved_locks and read past their
+// locks right away; for the others, ResolveLocks returns how long until the
+// first of their locks expires, and 0 if there is none.
+func (r *LockResolver) ResolveLocks(ctx context.Context, callerStartTS uint64, locks []*kvrpcpb.LockInfo) (time.Duration, []uint64, error) {
+	var (
+		wait     time.Duration
+		pushed   []uint64
+		statuses = make(map[uint64]TxnStatus)
+		txnOf    = make(map[string]uint64)
+		keys     [][]byte
+	)
+	for _, lock := range locks {
+		txnID := lock.GetLockVersion()
+		s, ok := statuses[txnID]
+		if !ok {
+			var expireIn time.Duration
+			var err error
+			s, expireIn, err = r.getTxnStatus(ctx, lock.GetPrimaryLock(), txnID, callerStartTS)
+			if err != nil {
+				return 0, nil, err
+			}
+			statuses[txnID] = s
+			switch {
+			case s.Pushed:
+				pushed = append(pushed, txnID)
+			case s.TTL > 0 && (wait == 0 || expireIn < wait):
+				wait = expireIn
+			}
+		}
+		if s.TTL > 0 {
+			continue
+		}
+		if _, ok := txnOf[string(lock.GetKey())]; !ok {
+			txnOf[string(lock.GetKey())] = txnID
+			keys = append(keys, lock.GetKey())
+		}
+	}
+	if len(keys) > 0 {
+		if err := r.resolve(ctx, keys, txnOf, statuses); err != nil {
+			return 0, nil, err
+		}
+	}
+	return wait, pushed, nil
+}
+
+// GetTxnStatus returns the status of the transaction txnID whose primary
+// key is primary, rolling it back if its primary lock has expired.
+func (r *LockResolver) GetTxnStatus(ctx context.Context, primary []byte, txnID, callerStartTS uint64) (TxnStatus, error) {
+	s, _, err := r.getTxnStatus(ctx, primary, txnID, callerStartTS)
+	return s, err
+}
+
+// getTxnStatus also returns, for an alive transaction, how long until its
+// primary lock expires.
+func (r *LockResolver) getTxnStatus(ctx context.Context, primary []byte, txnID, callerStartTS uint64) (TxnStatus, time.Duration, error) {
+	if s, ok := r.getResolved(txnID); ok {
+		return s, 0, nil
+	}
+	currentTS, err := r.oracle.GetTS(ctx)
+	if err != nil {
+		return TxnStatus{}, 0, err
+	}
+	var s TxnStatus
+	err = r.sender.SendKey(ctx, primary, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		resp, err := client.KvCheckTxnStatus(ctx, &kvrpcpb.CheckTxnStatusRequest{
+			Context:       region.Context(),
+			PrimaryKey:    primary,
+			LockTs:        txnID,
+			CallerStartTs: callerStartTS,
+			CurrentTs:     currentTS,
+		})
+		if status.Code(err) == codes.Unimplemented {
+			return r.cleanup(ctx, client, region, primary, txnID, currentTS, &s)
+		}
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		s = TxnStatus{
+			TTL:      resp.GetLockTtl(),
+			CommitTS: resp.GetCommitVersion(),
+			Pushed:   resp.GetLockTtl() > 0 && resp.GetAction() == kvrpcpb.Action_MinCommitTSPushed,
+		}
+		return nil, kverror.FromKeyError(resp.GetError())
+	})
+	if err != nil {
+		return TxnStatus{}, 0, err
+	}
+	if s.TTL == 0 {
+		r.saveResolved(txnID, s)
+		return s, 0, nil
+	}
+	elapsed := time.Duration(pdpb.ExtractPhysical(currentTS)-pdpb.ExtractPhysical(txnID)) * time.Millisecond
+	expireIn := time.Duration(s.TTL)*time.Millisecond - elapsed
+	if expireIn <= 0 {
+		// The clocks disagree; check again soon.
+		expireIn = time.Millisecond
+	}
+	return s, expireIn, nil
+}
+
+// cleanup reads the status of a transaction from Cleanup, which rolls back
+// the primary lock if it has expired, or fails with the lock if it has not.
+func (r *LockResolver) cleanup(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, primary []byte, txnID, currentTS uint64, s *TxnStatus) (*errorpb.Error, error) {
+	resp, err := client.KvCleanup(ctx, &kvrpcpb.CleanupRequest{
+		Context:      region.Context(),
+		Key:          primary,
+		StartVersion: txnID,
+		CurrentTs:    currentTS,
+	})
+	if err != nil || resp.GetRegionError() != nil {
+		return resp.GetRegionError(), err
+	}
+	err = kverror.FromKeyError(resp.GetError())
+	var locked *kverror.LockedError
+	if errors.As(err, &locked) {
+		*s = TxnStatus{TTL: locked.GetLockTtl()}
+		return nil, nil
+	}
+	*s = TxnStatus{CommitTS: resp.GetCommitVersion()}
+	return nil, err
+}
+
+// resolve commits or rolls back the locks on keys, sending the statuses of
+// all their transactions in one ResolveLock per region.
+func (r *LockResolver) resolve(ctx context.Context, keys [][]byte, txnOf map[string]uint64, statuses map[uint64]TxnStatus) error {
+	return r.sender.SendKeys(ctx, keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		var infos []*kvrpcpb.TxnInfo
+		seen := make(map[uint64]bool)
+		for _, key := range keys {
+			txnID := txnOf[string(key)]
+			if !seen[txnID] {
+				seen[txnID] = true
+				infos = append(infos, &kvrpcpb.TxnInfo{Txn: txnID, Status: statuses[txnID].CommitTS})
+			}
+		}
+		resp, err := client.KvResolveLock(ctx, &kvrpcpb.ResolveLockRequest{
+			Context:  region.Context(),
+			TxnInfos: infos,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		return nil, kverror.FromKeyError(resp.GetError())
+	})
+}
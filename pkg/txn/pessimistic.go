@@ -0,0 +1,193 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+// ErrNotPessimistic is returned when locking keys in an optimistic
+// transaction.
+var ErrNotPessimistic = errors.New("txn: not a pessimistic transaction")
+
+// maxWriteConflictRetries bounds the retries of a pessimistic lock request
+// with a fresh for_update_ts.
+const maxWriteConflictRetries = 16
+
+// BeginPessimistic starts a pessimistic transaction at a fresh timestamp.
+// Keys are locked with LockKeys or GetForUpdate as the transaction goes, so
+// that its commit does not fail on write conflicts with them.
+func (c *Client) BeginPessimistic(ctx context.Context) (*Txn, error) {
+	txn, err := c.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txn.pessimistic = true
+	txn.forUpdateTS = txn.startTS
+	return txn, nil
+}
+
+// ForUpdateTS returns the for_update_ts of the last pessimistic lock, which
+// starts at the start timestamp and moves forward on write conflicts.
+func (t *Txn) ForUpdateTS() uint64 {
+	return t.forUpdateTS
+}
+
+// LockKeys acquires pessimistic locks on keys. The first key ever locked is
+// the primary key of the transaction, and is locked before the others.
+//
+// A write conflict means a newer version was committed after
+// for_update_ts; the request is sent again with a fresh for_update_ts. On
+// any other failure, such as a deadlock, the locks taken by this call are
+// released, but the transaction and its earlier locks stay valid.
+func (t *Txn) LockKeys(ctx context.Context, keys ...[]byte) error {
+	if t.done {
+		return ErrTxnDone
+	}
+	if !t.pessimistic {
+		return ErrNotPessimistic
+	}
+	var newKeys [][]byte
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if !t.locked[string(key)] && !seen[string(key)] {
+			seen[string(key)] = true
+			newKeys = append(newKeys, key)
+		}
+	}
+	if len(newKeys) == 0 {
+		return nil
+	}
+	if t.primary == nil {
+		t.primary = newKeys[0]
+		if err := t.lockWithRetry(ctx, newKeys[:1], true); err != nil {
+			t.primary = nil
+			return err
+		}
+		t.locked[string(newKeys[0])] = true
+		t.startHeartBeat()
+		newKeys = newKeys[1:]
+	}
+	if len(newKeys) == 0 {
+		return nil
+	}
+	if err := t.lockWithRetry(ctx, newKeys, false); err != nil {
+		// Roll back the statement: some regions may have locked their keys.
+		_ = t.pessimisticRollback(ctx, newKeys)
+		return err
+	}
+	for _, key := range newKeys {
+		t.locked[string(key)] = true
+	}
+	return nil
+}
+
+// GetForUpdate locks key and returns its latest value, which may be newer
+// than the start timestamp.
+func (t *Txn) GetForUpdate(ctx context.Context, key []byte) ([]byte, error) {
+	if err := t.LockKeys(ctx, key); err != nil {
+		return nil, err
+	}
+	if m, ok := t.mutations[string(key)]; ok {
+		if m.GetOp() == kvrpcpb.Op_Del {
+			return nil, nil
+		}
+		return m.GetValue(), nil
+	}
+	return t.getAt(ctx, key, t.forUpdateTS)
+}
+
+// lockWithRetry locks keys, moving for_update_ts forward on write
+// conflicts.
+func (t *Txn) lockWithRetry(ctx context.Context, keys [][]byte, isFirstLock bool) error {
+	for attempt := 0; ; attempt++ {
+		err := t.pessimisticLock(ctx, keys, isFirstLock)
+		var conflict *kverror.WriteConflictError
+		if !errors.As(err, &conflict) || attempt >= maxWriteConflictRetries {
+			return err
+		}
+		forUpdateTS, err := t.client.oracle.GetTS(ctx)
+		if err != nil {
+			return err
+		}
+		t.forUpdateTS = forUpdateTS
+	}
+}
+
+func (t *Txn) pessimisticLock(ctx context.Context, keys [][]byte, isFirstLock bool) error {
+	opts := &t.client.opts
+	return t.client.sender.SendKeys(ctx, keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		mutations := make([]*kvrpcpb.Mutation, 0, len(keys))
+		for _, key := range keys {
+			mutations = append(mutations, &kvrpcpb.Mutation{Op: kvrpcpb.Op_PessimisticLock, Key: key})
+		}
+		req := &kvrpcpb.PessimisticLockRequest{
+			Context:      region.Context(),
+			Mutations:    mutations,
+			PrimaryLock:  t.primary,
+			StartVersion: t.startTS,
+			LockTtl:      opts.LockTTL,
+			ForUpdateTs:  t.forUpdateTS,
+			// Only a transaction already holding locks can be part of a
+			// deadlock.
+			IsFirstLock: isFirstLock,
+			WaitTimeout: uint64(opts.LockWaitTimeout / time.Millisecond),
+		}
+		deadline := time.Now().Add(opts.LockWaitTimeout)
+		for {
+			resp, err := client.KvPessimisticLock(ctx, req)
+			if err != nil || resp.GetRegionError() != nil {
+				return resp.GetRegionError(), err
+			}
+			locks, err := extractLocks(resp.GetErrors())
+			if err != nil || len(locks) == 0 {
+				return nil, err
+			}
+			if time.Now().After(deadline) {
+				return nil, &kverror.LockedError{LockInfo: locks[0]}
+			}
+			if _, err = t.client.resolveLocks(ctx, 0, locks); err != nil {
+				return nil, err
+			}
+		}
+	})
+}
+
+// pessimisticRollback releases the pessimistic locks on keys which are not
+// prewritten yet.
+func (t *Txn) pessimisticRollback(ctx context.Context, keys [][]byte) error {
+	return t.client.sender.SendKeys(ctx, keys, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region, keys [][]byte) (*errorpb.Error, error) {
+		resp, err := client.KVPessimisticRollback(ctx, &kvrpcpb.PessimisticRollbackRequest{
+			Context:      region.Context(),
+			StartVersion: t.startTS,
+			ForUpdateTs:  t.forUpdateTS,
+			Keys:         keys,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		if errs := resp.GetErrors(); len(errs) > 0 {
+			return nil, kverror.FromKeyError(errs[0])
+		}
+		return nil, nil
+	})
+}
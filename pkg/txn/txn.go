@@ -0,0 +1,274 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txn is a small reference client for the transactional API of
+// TiKV, driving the Percolator two-phase commit with kvrpcpb messages.
+package txn
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/errorpb"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+)
+
+var (
+	// ErrTxnDone is returned when a committed or rolled back transaction is
+	// used again.
+	ErrTxnDone = errors.New("txn: transaction is already done")
+	// ErrUndetermined is returned when the primary key may or may not have
+	// been committed, usually because the commit request got no response.
+	ErrUndetermined = errors.New("txn: result of the commit is undetermined")
+)
+
+// Oracle hands out timestamps. A *tsoclient.Client is an Oracle.
+type Oracle interface {
+	GetTS(ctx context.Context) (uint64, error)
+}
+
+// Options configures a Client.
+type Options struct {
+	// LockTTL is the TTL of the locks written by prewrite, in milliseconds.
+	// Larger transactions ask for more, up to two minutes.
+	LockTTL uint64
+	// HeartBeatInterval is how often the TTL of the primary lock is renewed
+	// while a transaction commits, or while it holds pessimistic locks. It
+	// defaults to half of LockTTL.
+	HeartBeatInterval time.Duration
+	// LockWaitTimeout is how long a pessimistic lock request waits for the
+	// locks of other transactions. It defaults to LockTTL.
+	LockWaitTimeout time.Duration
+}
+
+const defaultLockTTL = 3000
+
+// Client begins transactions on a TiKV cluster.
+type Client struct {
+	sender   *regioncache.Sender
+	oracle   Oracle
+	resolver *LockResolver
+	opts     Options
+}
+
+// NewClient creates a Client sending requests through sender and taking
+// timestamps from oracle.
+func NewClient(sender *regioncache.Sender, oracle Oracle, opts Options) *Client {
+	if opts.LockTTL == 0 {
+		opts.LockTTL = defaultLockTTL
+	}
+	if opts.HeartBeatInterval <= 0 {
+		opts.HeartBeatInterval = time.Duration(opts.LockTTL) * time.Millisecond / 2
+	}
+	if opts.LockWaitTimeout <= 0 {
+		opts.LockWaitTimeout = time.Duration(opts.LockTTL) * time.Millisecond
+	}
+	return &Client{
+		sender:   sender,
+		oracle:   oracle,
+		resolver: NewLockResolver(sender, oracle),
+		opts:     opts,
+	}
+}
+
+// LockResolver returns the resolver the client uses for the locks its
+// transactions run into.
+func (c *Client) LockResolver() *LockResolver {
+	return c.resolver
+}
+
+// maxResolveWait bounds a wait for a lock to expire, so that a lock kept
+// alive by heartbeats is checked again from time to time.
+const maxResolveWait = time.Second
+
+// resolveLocks resolves locks for callerStartTS, and waits for the rest to
+// expire, so that the blocked request can be sent again. It returns the
+// transactions pushed past callerStartTS, which are not waited for: the
+// reader sends them in Context.resolved_locks instead.
+func (c *Client) resolveLocks(ctx context.Context, callerStartTS uint64, locks []*kvrpcpb.LockInfo) ([]uint64, error) {
+	wait, pushed, err := c.resolver.ResolveLocks(ctx, callerStartTS, locks)
+	if err != nil || wait == 0 {
+		return pushed, err
+	}
+	if wait > maxResolveWait {
+		wait = maxResolveWait
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return pushed, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Begin starts an optimistic transaction at a fresh timestamp.
+func (c *Client) Begin(ctx context.Context) (*Txn, error) {
+	startTS, err := c.oracle.GetTS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Txn{
+		client:    c,
+		startTS:   startTS,
+		mutations: make(map[string]*kvrpcpb.Mutation),
+		locked:    make(map[string]bool),
+		lockTTL:   c.opts.LockTTL,
+	}, nil
+}
+
+// Txn is a transaction. Writes are buffered until Commit. A Txn is not safe
+// for concurrent use.
+type Txn struct {
+	client    *Client
+	startTS   uint64
+	commitTS  uint64
+	mutations map[string]*kvrpcpb.Mutation
+	done      bool
+	// primary is the primary key, picked by the first pessimistic lock or
+	// at commit.
+	primary []byte
+
+	pessimistic bool
+	forUpdateTS uint64
+	// locked is the set of keys holding a pessimistic lock.
+	locked map[string]bool
+	// resolvedLocks are the transactions pushed past startTS, whose locks
+	// the reads at startTS skip.
+	resolvedLocks []uint64
+
+	large     bool
+	onLockTTL func(ttl uint64)
+	// lockTTL is the TTL the locks are written with, read atomically by
+	// the heartbeat.
+	lockTTL       uint64
+	stopHeartBeat func()
+}
+
+// StartTS returns the start timestamp of the transaction.
+func (t *Txn) StartTS() uint64 {
+	return t.startTS
+}
+
+// CommitTS returns the commit timestamp, or 0 if the transaction is not
+// committed.
+func (t *Txn) CommitTS() uint64 {
+	return t.commitTS
+}
+
+// Get returns the value of key as seen by the transaction, or nil if the key
+// does not exist. A lock on key is resolved, or waited for if its
+// transaction is alive.
+func (t *Txn) Get(ctx context.Context, key []byte) ([]byte, error) {
+	if m, ok := t.mutations[string(key)]; ok {
+		if m.GetOp() == kvrpcpb.Op_Del {
+			return nil, nil
+		}
+		return m.GetValue(), nil
+	}
+	return t.getAt(ctx, key, t.startTS)
+}
+
+// getAt reads key at version, resolving the locks it runs into.
+func (t *Txn) getAt(ctx context.Context, key []byte, version uint64) ([]byte, error) {
+	resolved := t.resolvedLocks
+	if version != t.startTS {
+		// A transaction pushed past the start ts may commit before version.
+		resolved = nil
+	}
+	for {
+		value, err := t.get(ctx, key, version, resolved)
+		var locked *kverror.LockedError
+		if !errors.As(err, &locked) {
+			return value, err
+		}
+		pushed, err := t.client.resolveLocks(ctx, version, []*kvrpcpb.LockInfo{locked.LockInfo})
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, pushed...)
+		if version == t.startTS {
+			t.resolvedLocks = resolved
+		}
+	}
+}
+
+func (t *Txn) get(ctx context.Context, key []byte, version uint64, resolved []uint64) ([]byte, error) {
+	var value []byte
+	err := t.client.sender.SendKey(ctx, key, func(ctx context.Context, client tikvpb.TikvClient, region *regioncache.Region) (*errorpb.Error, error) {
+		reqCtx := region.Context()
+		reqCtx.ResolvedLocks = resolved
+		resp, err := client.KvGet(ctx, &kvrpcpb.GetRequest{
+			Context: reqCtx,
+			Key:     key,
+			Version: version,
+		})
+		if err != nil || resp.GetRegionError() != nil {
+			return resp.GetRegionError(), err
+		}
+		value = resp.GetValue()
+		return nil, kverror.FromKeyError(resp.GetError())
+	})
+	return value, err
+}
+
+// Set buffers a write of value to key.
+func (t *Txn) Set(key, value []byte) {
+	t.mutations[string(key)] = &kvrpcpb.Mutation{Op: kvrpcpb.Op_Put, Key: key, Value: value}
+}
+
+// Delete buffers a deletion of key.
+func (t *Txn) Delete(key []byte) {
+	t.mutations[string(key)] = &kvrpcpb.Mutation{Op: kvrpcpb.Op_Del, Key: key}
+}
+
+// Commit commits the buffered writes. On failure the written locks are
+// rolled back, unless the error is ErrUndetermined.
+func (t *Txn) Commit(ctx context.Context) error {
+	if t.done {
+		return ErrTxnDone
+	}
+	t.done = true
+	if len(t.mutations) == 0 && len(t.locked) == 0 {
+		return nil
+	}
+	c := newCommitter(t)
+	if err := c.execute(ctx); err != nil {
+		return err
+	}
+	t.commitTS = c.commitTS
+	return nil
+}
+
+// Rollback discards the buffered writes and releases the pessimistic locks.
+func (t *Txn) Rollback(ctx context.Context) error {
+	if t.done {
+		return ErrTxnDone
+	}
+	t.done = true
+	t.mutations = nil
+	t.endHeartBeat()
+	if len(t.locked) == 0 {
+		return nil
+	}
+	keys := make([][]byte, 0, len(t.locked))
+	for key := range t.locked {
+		keys = append(keys, []byte(key))
+	}
+	return t.pessimisticRollback(ctx, keys)
+}
@@ -0,0 +1,773 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/checksum"
+	"github.com/pingcap/kvproto/pkg/kverror"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/kvproto/pkg/mocktikv/mocktikvtest"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/kvproto/pkg/regioncache"
+	"github.com/pingcap/kvproto/pkg/tikvpb"
+	"github.com/pingcap/kvproto/pkg/tsoclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testCluster struct {
+	*mocktikvtest.Cluster
+	// store talks to TiKV through the test hooks. The tests set up and
+	// check locks on the MVCCStore directly.
+	store  *testStore
+	sender *regioncache.Sender
+	oracle *tsoclient.Client
+}
+
+// newTestCluster starts a mock PD and a mock TiKV on store 1 sharing the
+// region 2, which covers the whole key space.
+func newTestCluster(t *testing.T) *testCluster {
+	c := &testCluster{Cluster: mocktikvtest.NewCluster(t, 1)}
+	c.PD.SetMembers([]*pdpb.Member{{Name: "pd", MemberId: 1, ClientUrls: []string{"pd"}}}, 1)
+	c.oracle = tsoclient.NewClient([]string{"pd"}, tsoclient.Options{
+		ClusterID: mocktikvtest.ClusterID,
+		Dial: func(context.Context, string) (pdpb.PDClient, error) {
+			return c.PDClient, nil
+		},
+	})
+	c.store = &testStore{TikvClient: c.TikvClient(1)}
+	c.sender = regioncache.NewSender(c.NewRegionCache(), func(context.Context, uint64) (tikvpb.TikvClient, error) {
+		return c.store, nil
+	}, nil)
+	return c
+}
+
+// testStore counts CheckTxnStatus and Prewrite requests, calls onPrewrite
+// before a prewrite if set, can pretend to be a TiKV without
+// CheckTxnStatus, reports a deadlock for any pessimistic lock on
+// deadlockKey which is not the first lock of its transaction, and holds
+// commits until holdCommit is closed.
+type testStore struct {
+	tikvpb.TikvClient
+	checkTxnStatus   int32
+	prewrites        int32
+	onPrewrite       func(req *kvrpcpb.PrewriteRequest)
+	noCheckTxnStatus bool
+	deadlockKey      string
+	holdCommit       chan struct{}
+}
+
+func (s *testStore) KvPrewrite(ctx context.Context, req *kvrpcpb.PrewriteRequest, opts ...grpc.CallOption) (*kvrpcpb.PrewriteResponse, error) {
+	atomic.AddInt32(&s.prewrites, 1)
+	if s.onPrewrite != nil {
+		s.onPrewrite(req)
+	}
+	return s.TikvClient.KvPrewrite(ctx, req, opts...)
+}
+
+func (s *testStore) KvCommit(ctx context.Context, req *kvrpcpb.CommitRequest, opts ...grpc.CallOption) (*kvrpcpb.CommitResponse, error) {
+	if s.holdCommit != nil {
+		<-s.holdCommit
+	}
+	return s.TikvClient.KvCommit(ctx, req, opts...)
+}
+
+func (s *testStore) KvPessimisticLock(ctx context.Context, req *kvrpcpb.PessimisticLockRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticLockResponse, error) {
+	for _, m := range req.GetMutations() {
+		if !req.GetIsFirstLock() && string(m.GetKey()) == s.deadlockKey {
+			return &kvrpcpb.PessimisticLockResponse{Errors: []*kvrpcpb.KeyError{{
+				Deadlock: &kvrpcpb.Deadlock{LockTs: req.GetStartVersion() + 1, LockKey: m.GetKey()},
+			}}}, nil
+		}
+	}
+	return s.TikvClient.KvPessimisticLock(ctx, req, opts...)
+}
+
+func (s *testStore) KvCheckTxnStatus(ctx context.Context, req *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error) {
+	atomic.AddInt32(&s.checkTxnStatus, 1)
+	if s.noCheckTxnStatus {
+		return nil, status.Error(codes.Unimplemented, "unknown method KvCheckTxnStatus")
+	}
+	return s.TikvClient.KvCheckTxnStatus(ctx, req, opts...)
+}
+
+func (c *testCluster) close() {
+	c.oracle.Close()
+	c.Close()
+}
+
+func (c *testCluster) locks(t *testing.T) []*kvrpcpb.LockInfo {
+	return c.MVCC.ScanLock(nil, nil, math.MaxUint64, 0)
+}
+
+// lock returns the lock on key.
+func (c *testCluster) lock(t *testing.T, key string) *kvrpcpb.LockInfo {
+	for _, lock := range c.locks(t) {
+		if string(lock.GetKey()) == key {
+			return lock
+		}
+	}
+	t.Fatalf("no lock on %s", key)
+	return nil
+}
+
+// prewrite leaves the locks of a transaction which never commits, unless
+// the caller commits it.
+func (c *testCluster) prewrite(t *testing.T, startTS, ttl uint64, keys ...string) {
+	var mutations []*kvrpcpb.Mutation
+	for _, key := range keys {
+		mutations = append(mutations, &kvrpcpb.Mutation{Op: kvrpcpb.Op_Put, Key: []byte(key), Value: []byte(key)})
+	}
+	errs := c.MVCC.Prewrite(&kvrpcpb.PrewriteRequest{
+		Mutations:    mutations,
+		PrimaryLock:  []byte(keys[0]),
+		StartVersion: startTS,
+		LockTtl:      ttl,
+	})
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+}
+
+func mustGet(t *testing.T, txn *Txn, key string, expect string) {
+	value, err := txn.Get(context.Background(), []byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != expect {
+		t.Fatalf("expect %s=%q, got %q", key, expect, value)
+	}
+}
+
+func TestCommit(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+
+	// Load the whole key space as one region, then split it under the cache
+	// so that the commit runs into EpochNotMatch.
+	if _, err := cluster.sender.Cache().LocateKey(ctx, []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	cluster.Split(t, "b", "m")
+
+	txn, err := client.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn.Set([]byte("a"), []byte("1"))
+	txn.Set([]byte("c"), []byte("2"))
+	txn.Set([]byte("n"), []byte("3"))
+	mustGet(t, txn, "c", "2")
+	if err = txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if txn.CommitTS() <= txn.StartTS() {
+		t.Fatalf("commit ts %d is not after start ts %d", txn.CommitTS(), txn.StartTS())
+	}
+	if err = txn.Commit(ctx); err != ErrTxnDone {
+		t.Fatalf("expect ErrTxnDone, got %v", err)
+	}
+
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "a", "1")
+	mustGet(t, txn, "n", "3")
+	txn.Delete([]byte("c"))
+	mustGet(t, txn, "c", "")
+	if err = txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "c", "")
+	if err = txn.Rollback(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if locks := cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+}
+
+func TestWriteConflict(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+	cluster.Split(t, "m")
+
+	txn1, _ := client.Begin(ctx)
+	txn2, _ := client.Begin(ctx)
+	txn2.Set([]byte("k"), []byte("2"))
+	if err := txn2.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	txn1.Set([]byte("k"), []byte("1"))
+	txn1.Set([]byte("z"), []byte("1"))
+	if err := txn1.Commit(ctx); !errors.Is(err, kverror.ErrWriteConflict) {
+		t.Fatalf("expect write conflict, got %v", err)
+	}
+	// The lock prewritten in the other region is rolled back.
+	if locks := cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+	txn, _ := client.Begin(ctx)
+	mustGet(t, txn, "k", "2")
+	mustGet(t, txn, "z", "")
+}
+
+// holdOracle blocks the second timestamp, the commit ts of the first
+// transaction, until hold is closed.
+type holdOracle struct {
+	Oracle
+	calls int32
+	hold  chan struct{}
+}
+
+func (o *holdOracle) GetTS(ctx context.Context) (uint64, error) {
+	if atomic.AddInt32(&o.calls, 1) == 2 {
+		<-o.hold
+	}
+	return o.Oracle.GetTS(ctx)
+}
+
+func TestHeartBeat(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	oracle := &holdOracle{Oracle: cluster.oracle, hold: make(chan struct{})}
+	client := NewClient(cluster.sender, oracle, Options{LockTTL: 100, HeartBeatInterval: 10 * time.Millisecond})
+	ctx := context.Background()
+
+	txn, _ := client.Begin(ctx)
+	txn.Set([]byte("a"), []byte("1"))
+	txn.Set([]byte("b"), []byte("2"))
+	done := make(chan error, 1)
+	go func() {
+		done <- txn.Commit(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var primaryTTL uint64
+		for _, lock := range cluster.locks(t) {
+			if string(lock.GetKey()) == "a" {
+				primaryTTL = lock.GetLockTtl()
+			}
+		}
+		if primaryTTL > 150 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("primary lock ttl is not renewed: %d", primaryTTL)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(oracle.hold)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "b", "2")
+}
+
+func TestResolveLocks(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	resolver := client.LockResolver()
+	ctx := context.Background()
+	cluster.Split(t, "m")
+
+	// An expired transaction is rolled back through its primary.
+	expired, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, expired, 0, "a", "b", "x")
+	time.Sleep(5 * time.Millisecond)
+	txn, _ := client.Begin(ctx)
+	mustGet(t, txn, "b", "")
+	if len(cluster.locks(t)) != 1 || cluster.store.checkTxnStatus != 1 {
+		t.Fatalf("expect one lock left after one check, got %v", cluster.locks(t))
+	}
+	// The lock in the other region reuses the cached status.
+	wait, pushed, err := resolver.ResolveLocks(ctx, txn.StartTS(), []*kvrpcpb.LockInfo{cluster.lock(t, "x")})
+	if err != nil || wait != 0 || len(pushed) != 0 || len(cluster.locks(t)) != 0 || cluster.store.checkTxnStatus != 1 {
+		t.Fatal(err, wait, cluster.locks(t))
+	}
+
+	// A committed transaction has its secondaries committed.
+	committed, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, committed, 10000, "c", "y")
+	commitTS, _ := cluster.oracle.GetTS(ctx)
+	if _, err = cluster.MVCC.Commit([][]byte{[]byte("c")}, committed, commitTS); err != nil {
+		t.Fatal(err)
+	}
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "y", "y")
+	if s, err := resolver.GetTxnStatus(ctx, []byte("c"), committed, 0); err != nil || !s.IsCommitted() || s.CommitTS != commitTS {
+		t.Fatal(err, s)
+	}
+
+	// An alive transaction is waited for.
+	alive, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, alive, 10000, "d")
+	txn, _ = client.Begin(ctx)
+	wait, pushed, err = resolver.ResolveLocks(ctx, txn.StartTS(), []*kvrpcpb.LockInfo{cluster.lock(t, "d")})
+	if err != nil || wait <= 0 || len(pushed) != 0 || wait > 10*time.Second || len(cluster.locks(t)) != 1 {
+		t.Fatal(err, wait, cluster.locks(t))
+	}
+	shortCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err = txn.Get(shortCtx, []byte("d")); err != context.DeadlineExceeded {
+		t.Fatalf("expect to wait for the lock, got %v", err)
+	}
+}
+
+func TestResolveLocksWithCleanup(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	cluster.store.noCheckTxnStatus = true
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+
+	expired, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, expired, 0, "a", "b")
+	alive, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, alive, 10000, "c")
+	time.Sleep(5 * time.Millisecond)
+
+	txn, _ := client.Begin(ctx)
+	mustGet(t, txn, "b", "")
+	s, err := client.LockResolver().GetTxnStatus(ctx, []byte("c"), alive, txn.StartTS())
+	if err != nil || s.TTL != 10000 {
+		t.Fatal(err, s)
+	}
+	if locks := cluster.locks(t); len(locks) != 1 || string(locks[0].GetKey()) != "c" {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+}
+
+func TestPrewriteResolveLocks(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{LockTTL: 100})
+	ctx := context.Background()
+
+	expired, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, expired, 0, "k")
+	time.Sleep(5 * time.Millisecond)
+	txn, _ := client.Begin(ctx)
+	txn.Set([]byte("k"), []byte("v"))
+	if err := txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// A live lock is waited for at most LockTTL.
+	alive, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, alive, 10000, "k")
+	txn, _ = client.Begin(ctx)
+	txn.Set([]byte("k"), []byte("w"))
+	if err := txn.Commit(ctx); !errors.Is(err, kverror.ErrLocked) {
+		t.Fatalf("expect locked, got %v", err)
+	}
+}
+
+func TestPessimistic(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{LockTTL: 100, HeartBeatInterval: 10 * time.Millisecond})
+	ctx := context.Background()
+	cluster.Split(t, "m")
+
+	txn, _ := client.BeginPessimistic(ctx)
+	other, _ := client.Begin(ctx)
+	other.Set([]byte("k"), []byte("1"))
+	if err := other.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// The write after start ts forces a retry with a newer for_update_ts.
+	value, err := txn.GetForUpdate(ctx, []byte("k"))
+	if err != nil || string(value) != "1" || txn.ForUpdateTS() <= other.CommitTS() {
+		t.Fatal(err, value, txn.ForUpdateTS())
+	}
+	if err = txn.LockKeys(ctx, []byte("a"), []byte("z")); err != nil {
+		t.Fatal(err)
+	}
+	locks := cluster.locks(t)
+	if len(locks) != 3 {
+		t.Fatalf("expect 3 locks, got %v", locks)
+	}
+	for _, lock := range locks {
+		if lock.GetLockType() != kvrpcpb.Op_PessimisticLock || string(lock.GetPrimaryLock()) != "k" {
+			t.Fatalf("unexpected lock %v", lock)
+		}
+	}
+
+	// The heartbeat keeps the locks alive past their TTL.
+	time.Sleep(200 * time.Millisecond)
+	other, _ = client.Begin(ctx)
+	other.Set([]byte("a"), []byte("2"))
+	if err = other.Commit(ctx); !errors.Is(err, kverror.ErrLocked) {
+		t.Fatalf("expect locked, got %v", err)
+	}
+
+	txn.Set([]byte("k"), []byte("3"))
+	txn.Set([]byte("b"), []byte("4"))
+	if err = txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if locks = cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+	check, _ := client.Begin(ctx)
+	mustGet(t, check, "k", "3")
+	mustGet(t, check, "b", "4")
+	mustGet(t, check, "a", "")
+	if err = txn.LockKeys(ctx, []byte("a")); err != ErrTxnDone {
+		t.Fatalf("expect ErrTxnDone, got %v", err)
+	}
+	if err = check.LockKeys(ctx, []byte("a")); err != ErrNotPessimistic {
+		t.Fatalf("expect ErrNotPessimistic, got %v", err)
+	}
+}
+
+func TestPessimisticDeadlock(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+	cluster.Split(t, "m")
+	cluster.store.deadlockKey = "z"
+
+	txn, _ := client.BeginPessimistic(ctx)
+	if err := txn.LockKeys(ctx, []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	err := txn.LockKeys(ctx, []byte("b"), []byte("z"))
+	var deadlock *kverror.DeadlockError
+	if !errors.As(err, &deadlock) || string(deadlock.GetLockKey()) != "z" {
+		t.Fatalf("expect deadlock, got %v", err)
+	}
+	// Only the failed statement is rolled back.
+	if locks := cluster.locks(t); len(locks) != 1 || string(locks[0].GetKey()) != "a" {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+	if err = txn.LockKeys(ctx, []byte("c")); err != nil {
+		t.Fatal(err)
+	}
+	if err = txn.Rollback(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if locks := cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+}
+
+func TestLargeTxn(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	cluster.store.holdCommit = make(chan struct{})
+	client := NewClient(cluster.sender, cluster.oracle, Options{LockTTL: 100, HeartBeatInterval: 10 * time.Millisecond})
+	ctx := context.Background()
+
+	var (
+		mu   sync.Mutex
+		ttls []uint64
+	)
+	txn, err := client.BeginLarge(ctx, func(ttl uint64) {
+		mu.Lock()
+		ttls = append(ttls, ttl)
+		mu.Unlock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := bytes.Repeat([]byte("v"), 1024)
+	for i := 0; i < 100; i++ {
+		txn.Set([]byte(fmt.Sprintf("k%03d", i)), value)
+	}
+	lockTTL := txnLockTTL(100, 100*(4+1024))
+	if lockTTL <= 100 {
+		t.Fatalf("lock ttl does not grow with the txn size: %d", lockTTL)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- txn.Commit(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mu.Lock()
+		n := len(ttls)
+		mu.Unlock()
+		if n >= 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("lock ttl changes are not reported")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	mu.Lock()
+	if ttls[0] <= lockTTL || ttls[1] <= ttls[0] {
+		t.Fatalf("lock ttl is not raised: %v", ttls)
+	}
+	mu.Unlock()
+	if n := atomic.LoadInt32(&cluster.store.prewrites); n < 7 {
+		t.Fatalf("expect the prewrite to be batched, got %d requests", n)
+	}
+
+	// A reader pushes the commit ts past its own start ts instead of
+	// waiting for the transaction, on the primary and on the secondaries.
+	early, _ := client.Begin(ctx)
+	reader, _ := client.Begin(ctx)
+	s, err := client.LockResolver().GetTxnStatus(ctx, []byte("k000"), txn.StartTS(), reader.StartTS())
+	if err != nil || s.TTL == 0 || !s.Pushed {
+		t.Fatal(err, s)
+	}
+	readCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	for _, key := range []string{"k000", "k050", "k099"} {
+		if value, err := reader.Get(readCtx, []byte(key)); err != nil || value != nil {
+			t.Fatalf("read of %s while the commit is held: %q, %v", key, value, err)
+		}
+	}
+	if len(reader.resolvedLocks) != 1 || len(cluster.locks(t)) != 100 {
+		t.Fatalf("expect the reader to skip the locks of one txn, got %v", reader.resolvedLocks)
+	}
+	// The pushed primary does not block older readers either.
+	checks := cluster.store.checkTxnStatus
+	if value, err := early.Get(readCtx, []byte("k000")); err != nil || value != nil || cluster.store.checkTxnStatus != checks {
+		t.Fatalf("read of the pushed primary: %q, %v", value, err)
+	}
+	close(cluster.store.holdCommit)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if txn.CommitTS() <= reader.StartTS() {
+		t.Fatalf("commit ts %d is not after the reader %d", txn.CommitTS(), reader.StartTS())
+	}
+	mustGet(t, reader, "k050", "")
+
+	mu.Lock()
+	n := len(ttls)
+	mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	if len(ttls) != n {
+		t.Fatal("heartbeat is not stopped on commit")
+	}
+	mu.Unlock()
+	if locks := cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("unexpected locks %v", locks)
+	}
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "k099", string(value))
+}
+
+func TestLargeTxnSnapshot(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+
+	// A reader and a writer start after the large transaction, and touch
+	// its keys before they are locked.
+	txn, err := client.BeginLarge(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Begin(ctx)
+	reader, _ := client.Begin(ctx)
+	writer, _ := client.Begin(ctx)
+	if reader.StartTS() <= txn.StartTS()+1 || writer.StartTS() <= txn.StartTS()+1 {
+		t.Fatalf("start ts %d and %d are not after %d", reader.StartTS(), writer.StartTS(), txn.StartTS()+1)
+	}
+	mustGet(t, reader, "r", "")
+	writer.Set([]byte("w"), []byte("2"))
+	if err := writer.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The write is a conflict, and the read must stay repeatable.
+	txn.Set([]byte("r"), []byte("1"))
+	txn.Set([]byte("w"), []byte("1"))
+	if err := txn.Commit(ctx); !errors.Is(err, kverror.ErrWriteConflict) {
+		t.Fatalf("expect write conflict, got %v", err)
+	}
+	txn, _ = client.BeginLarge(ctx, nil)
+	client.Begin(ctx)
+	reader, _ = client.Begin(ctx)
+	mustGet(t, reader, "r", "")
+	txn.Set([]byte("r"), []byte("1"))
+	if err := txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if txn.CommitTS() <= reader.StartTS() {
+		t.Fatalf("commit ts %d is not after the reader %d", txn.CommitTS(), reader.StartTS())
+	}
+	mustGet(t, reader, "r", "")
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "r", "1")
+	mustGet(t, txn, "w", "2")
+}
+
+func TestLargeTxnReadDuringPrewrite(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+	cluster.Split(t, "m")
+
+	// Readers start after the min_commit_ts is fetched, and read the keys
+	// of every region right before they are locked. The secondary is read
+	// once the primary is locked, so that only its lock goes past the read.
+	var (
+		mu      sync.Mutex
+		readers []*Txn
+	)
+	cluster.store.onPrewrite = func(req *kvrpcpb.PrewriteRequest) {
+		for string(req.GetMutations()[0].GetKey()) != "a" && len(cluster.locks(t)) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		reader, _ := client.Begin(ctx)
+		for _, m := range req.GetMutations() {
+			if value, err := reader.Get(ctx, m.GetKey()); err != nil || value != nil {
+				t.Errorf("read of %s before the prewrite: %q, %v", m.GetKey(), value, err)
+			}
+		}
+		mu.Lock()
+		readers = append(readers, reader)
+		mu.Unlock()
+	}
+	txn, err := client.BeginLarge(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn.Set([]byte("a"), []byte("1"))
+	txn.Set([]byte("z"), []byte("1"))
+	if err = txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cluster.store.onPrewrite = nil
+	if len(readers) != 2 {
+		t.Fatalf("expect a reader per region, got %d", len(readers))
+	}
+	for _, reader := range readers {
+		if txn.CommitTS() <= reader.StartTS() {
+			t.Fatalf("commit ts %d is not after the reader %d", txn.CommitTS(), reader.StartTS())
+		}
+		mustGet(t, reader, "a", "")
+		mustGet(t, reader, "z", "")
+	}
+	txn, _ = client.Begin(ctx)
+	mustGet(t, txn, "a", "1")
+	mustGet(t, txn, "z", "1")
+}
+
+func TestIter(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+	cluster.Split(t, "b", "m")
+
+	txn, _ := client.Begin(ctx)
+	for _, key := range []string{"a", "c", "n", "x"} {
+		txn.Set([]byte(key), []byte(key))
+	}
+	if err := txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	expired, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, expired, 0, "d", "y")
+	time.Sleep(5 * time.Millisecond)
+
+	txn, _ = client.Begin(ctx)
+	txn.Set([]byte("b"), []byte("b"))
+	txn.Set([]byte("n"), []byte("N"))
+	txn.Delete([]byte("c"))
+	for _, c := range []struct {
+		start, end string
+		reverse    bool
+		expect     string
+	}{
+		{"", "", false, "a=a b=b n=N x=x "},
+		{"b", "n", false, "b=b "},
+		{"", "", true, "x=x n=N b=b a=a "},
+		{"", "n", true, "b=b a=a "},
+		{"n", "", true, "x=x n=N "},
+		{"x", "a", false, ""},
+	} {
+		it := txn.Iter(ctx, []byte(c.start), []byte(c.end), c.reverse)
+		var b strings.Builder
+		for it.Next() {
+			fmt.Fprintf(&b, "%s=%s ", it.Key(), it.Value())
+		}
+		if it.Err() != nil || b.String() != c.expect {
+			t.Fatalf("iter %+v: expect %q, got %q %v", c, c.expect, b.String(), it.Err())
+		}
+	}
+	if locks := cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("expect the locks to be resolved, got %v", locks)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.close()
+	client := NewClient(cluster.sender, cluster.oracle, Options{})
+	ctx := context.Background()
+	cluster.Split(t, "b", "m")
+
+	txn, _ := client.Begin(ctx)
+	var all, middle checksum.Checksum
+	for _, key := range []string{"a", "c", "n", "x"} {
+		txn.Set([]byte(key), []byte(key+key))
+		all.Update([]byte(key), []byte(key+key))
+		if key == "c" {
+			middle.Update([]byte(key), []byte(key+key))
+		}
+	}
+	if err := txn.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	expired, _ := cluster.oracle.GetTS(ctx)
+	cluster.prewrite(t, expired, 0, "d")
+	time.Sleep(5 * time.Millisecond)
+
+	version, _ := cluster.oracle.GetTS(ctx)
+	if c, err := client.Checksum(ctx, nil, nil, version); err != nil || c != all {
+		t.Fatalf("expect %+v, got %+v %v", all, c, err)
+	}
+	if c, err := client.Checksum(ctx, []byte("b"), []byte("m"), version); err != nil || c != middle {
+		t.Fatalf("expect %+v, got %+v %v", middle, c, err)
+	}
+	if c, err := client.Checksum(ctx, nil, nil, txn.StartTS()); err != nil || c != (checksum.Checksum{}) {
+		t.Fatalf("expect nothing before the commit, got %+v %v", c, err)
+	}
+	if locks := cluster.locks(t); len(locks) != 0 {
+		t.Fatalf("expect the lock to be resolved, got %v", locks)
+	}
+}